@@ -95,6 +95,9 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v6/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	ibctransfer "github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -107,6 +110,9 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 	ibctestingtypes "github.com/cosmos/ibc-go/v6/testing/types"
+	packetforward "github.com/strangelove-ventures/packet-forward-middleware/v6/router"
+	packetforwardkeeper "github.com/strangelove-ventures/packet-forward-middleware/v6/router/keeper"
+	packetforwardtypes "github.com/strangelove-ventures/packet-forward-middleware/v6/router/types"
 
 	// wasm modules
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -126,6 +132,7 @@ import (
 
 	"github.com/mars-protocol/hub/v2/app/upgrades"
 	v2 "github.com/mars-protocol/hub/v2/app/upgrades/v2"
+	v3 "github.com/mars-protocol/hub/v2/app/upgrades/v3"
 
 	marswasm "github.com/mars-protocol/hub/v2/app/wasm"
 	marsdocs "github.com/mars-protocol/hub/v2/docs"
@@ -175,6 +182,8 @@ var (
 		upgrade.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
 		ica.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		incentives.AppModuleBasic{},
@@ -201,6 +210,7 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		wasm.ModuleName:                {authtypes.Burner},
 		incentivestypes.ModuleName:     nil,
@@ -209,7 +219,7 @@ var (
 	}
 
 	// scheduled upgrades and forks
-	Upgrades = []upgrades.Upgrade{v2.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade}
 	Forks    = []upgrades.Fork{}
 )
 
@@ -268,7 +278,9 @@ type MarsApp struct {
 	StakingKeeper       stakingkeeper.Keeper
 	UpgradeKeeper       upgradekeeper.Keeper
 	IBCKeeper           *ibckeeper.Keeper // must be a pointer, so we can `SetRouter` on it correctly
+	IBCFeeKeeper        ibcfeekeeper.Keeper
 	IBCTransferKeeper   ibctransferkeeper.Keeper
+	PacketForwardKeeper *packetforwardkeeper.Keeper
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
 	WasmKeeper          wasm.Keeper
@@ -324,10 +336,13 @@ func NewMarsApp(
 		upgradetypes.StoreKey,
 		ibchost.StoreKey,
 		ibctransfertypes.StoreKey,
+		ibcfeetypes.StoreKey,
+		packetforwardtypes.StoreKey,
 		icacontrollertypes.StoreKey,
 		icahosttypes.StoreKey,
 		wasm.StoreKey,
 		incentivestypes.StoreKey,
+		envoytypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -463,22 +478,49 @@ func NewMarsApp(
 		app.StakingKeeper,
 		app.UpgradeKeeper, app.ScopedIBCKeeper,
 	)
+
+	// the fee keeper wraps the channel keeper as the ICS-4 wrapper of the
+	// transfer and ICA controller keepers, so it must be created before them
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		codec,
+		keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+
+	// the packet forward keeper in turn wraps the fee keeper as the ICS-4
+	// wrapper of the transfer keeper. it needs the transfer keeper to forward
+	// packets, which is set once the transfer keeper is created
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		codec,
+		keys[packetforwardtypes.StoreKey],
+		getSubspace(app, packetforwardtypes.ModuleName),
+		nil,
+		app.IBCKeeper.ChannelKeeper,
+		app.DistrKeeper,
+		app.BankKeeper,
+		app.IBCFeeKeeper,
+	)
 	app.IBCTransferKeeper = ibctransferkeeper.NewKeeper(
 		codec,
 		keys[ibctransfertypes.StoreKey],
 		getSubspace(app, ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.PacketForwardKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.ScopedIBCTransferKeeper,
 	)
+	app.PacketForwardKeeper.SetTransferKeeper(app.IBCTransferKeeper)
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		codec,
 		keys[icacontrollertypes.StoreKey],
 		getSubspace(app, icacontrollertypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.ScopedICAControllerKeeper,
@@ -496,6 +538,22 @@ func NewMarsApp(
 		app.MsgServiceRouter(),
 	)
 
+	// the envoy keeper must be created before the IBC router, as the envoy
+	// module is part of the ICA controller stack
+	app.EnvoyKeeper = envoykeeper.NewKeeper(
+		app.Codec,
+		keys[envoytypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper,
+		app.ICAControllerKeeper,
+		app.ScopedICAControllerKeeper,
+		app.MsgServiceRouter(),
+		[]string{authority},
+	)
+
 	// create static IBC router, add transfer route, then set and seal it
 	app.IBCKeeper.SetRouter(initIBCRouter(app))
 
@@ -539,6 +597,7 @@ func NewMarsApp(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		app.WasmKeeper,
 		authority,
 	)
 	app.SafetyKeeper = safetykeeper.NewKeeper(
@@ -546,16 +605,6 @@ func NewMarsApp(
 		app.BankKeeper,
 		authority,
 	)
-	app.EnvoyKeeper = envoykeeper.NewKeeper(
-		app.Codec,
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.DistrKeeper,
-		app.IBCKeeper.ChannelKeeper,
-		app.ICAControllerKeeper,
-		app.MsgServiceRouter(),
-		[]string{authority},
-	)
 
 	// finally, create gov keeper
 	//
@@ -613,6 +662,8 @@ func NewMarsApp(
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctransfer.NewAppModule(app.IBCTransferKeeper),
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		wasm.NewAppModule(codec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		incentives.NewAppModule(app.IncentivesKeeper),
@@ -641,6 +692,8 @@ func NewMarsApp(
 		paramstypes.ModuleName,
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 		wasm.ModuleName,
 		incentivestypes.ModuleName,
@@ -665,6 +718,8 @@ func NewMarsApp(
 		upgradetypes.ModuleName,
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 		wasm.ModuleName,
 		incentivestypes.ModuleName,
@@ -694,6 +749,8 @@ func NewMarsApp(
 		feegrant.ModuleName,
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 		wasm.ModuleName,
 		incentivestypes.ModuleName,
@@ -1009,6 +1066,7 @@ func initParamsKeeper(codec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, k
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 	paramsKeeper.Subspace(icacontrollertypes.StoreKey)
 	paramsKeeper.Subspace(icahosttypes.StoreKey)
 	paramsKeeper.Subspace(wasm.ModuleName)
@@ -1032,16 +1090,40 @@ func initGovRouter(app *MarsApp) govv1beta1.Router {
 
 // initIBCRouter initialzies IBC router.
 //
-// NOTE: We cannot wrap modules in the fee middleware yet until channel
-// upgradability is implemented. See discussion here:
+// The transfer stack includes the packet forward middleware, so that inbound
+// transfers with a `forward` memo are forwarded to another chain through Mars
+// Hub. Together with MsgSendFunds.Route, this allows the envoy module to send
+// funds to chains it doesn't have a direct channel with.
+//
+// NOTE: The transfer and ICA controller stacks are wrapped in the ICS-29 fee
+// middleware. Existing channels are not upgraded, as channel upgradability is
+// not yet implemented. See discussion here:
 // https://discord.com/channels/955868717269516318/955877042883285023/1062113420712882278
+//
+// Fees can only be paid for packets on new, fee-enabled channels. For ICA
+// channels, governance can close the existing channel and reopen it with the
+// fee version, using the envoy module's MsgCloseAccountChannel and
+// MsgRegisterAccountWithVersion.
 func initIBCRouter(app *MarsApp) *ibcporttypes.Router {
 	var icaControllerStack ibcporttypes.IBCModule
 	icaControllerStack = envoy.NewIBCModule(app.EnvoyKeeper)
 	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
+	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+
+	var transferStack ibcporttypes.IBCModule
+	transferStack = ibctransfer.NewIBCModule(app.IBCTransferKeeper)
+	transferStack = envoy.NewTransferMiddleware(transferStack, app.EnvoyKeeper)
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		app.PacketForwardKeeper,
+		0, // retries on timeout
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+	)
+	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibctransfer.NewIBCModule(app.IBCTransferKeeper))
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.ICAHostKeeper))
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper))
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	packetforwardtypes "github.com/strangelove-ventures/packet-forward-middleware/v6/router/types"
+
+	"github.com/mars-protocol/hub/v2/app/upgrades"
+
+	envoytypes "github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          "v3",
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			// starting from v3, the envoy module keeps records of the packets
+			// it sends, so it needs a store
+			envoytypes.StoreKey,
+
+			// the ICS-29 fee middleware is wired into the transfer and ICA
+			// controller stacks, so that the envoy module can pay relayers
+			ibcfeetypes.StoreKey,
+
+			// the packet forward middleware is wired into the transfer stack,
+			// so that inbound transfers can be forwarded through the hub
+			packetforwardtypes.StoreKey,
+		},
+	},
+}
@@ -0,0 +1,28 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// CreateUpgradeHandler creates the upgrade handler for the v3 upgrade.
+//
+// In this upgrade, we mount a store for the envoy module. The envoy module's
+// params and id counters are initialized by its 1-to-2 through 6-to-7
+// migrations, which are run here. The 2-to-3 migration also enables the ICA
+// controller middleware for existing envoy-owned accounts.
+//
+// The incentives module's 1-to-2 migration sets the release curves of existing
+// incentives schedules to linear, its 2-to-3 migration initializes its params,
+// and its 3-to-4 migration sets the params of the release history.
+//
+// The packet forward middleware's store is added too. As it is a new module,
+// its default genesis, including its params, is initialized by RunMigrations.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("🚀 executing Mars Hub v3 upgrade 🚀")
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
@@ -0,0 +1,25 @@
+package envoy
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// EndBlocker attempts to reopen the channels of interchain accounts that have
+// been closed due to packet timeouts, prunes expired queued packets, executes
+// the scheduled operations that are due, and queries the balances of
+// interchain accounts and the ICA host params if due.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.AttemptRecoveries(ctx)
+	k.PruneQueuedPackets(ctx)
+	k.ExecuteScheduledOperations(ctx)
+	k.QueryAccountBalances(ctx)
+	k.QueryHostParams(ctx)
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,6 +26,16 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		getAccountCmd(),
 		getAccountsCmd(),
+		getPacketCmd(),
+		getPacketsCmd(),
+		getQueuedPacketsCmd(),
+		getAccountBalancesCmd(),
+		getSimulateMessagesCmd(),
+		getAuthorityPolicyCmd(),
+		getAuthorityPoliciesCmd(),
+		getScheduledOperationCmd(),
+		getScheduledOperationsCmd(),
+		getParamsCmd(),
 	)
 
 	return cmd
@@ -65,9 +79,124 @@ func getAccountsCmd() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Accounts(cmd.Context(), &types.QueryAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+
+	return cmd
+}
+
+func getPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet [channel-id] [sequence]",
+		Short: "Query the record of an ICS-27 packet sent by the envoy module",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Packet(cmd.Context(), &types.QueryPacketRequest{ChannelId: args[0], Sequence: sequence})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getPacketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packets",
+		Short: "Query the records of all ICS-27 packets sent by the envoy module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parsePacketStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Packets(cmd.Context(), &types.QueryPacketsRequest{Status: status, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "only return packets of this status (pending|success|error|timeout)")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets")
+
+	return cmd
+}
+
+func getQueuedPacketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-packets [connection-id]",
+		Short: "Query the timed out ICS-27 packets that can be resent, optionally of a given connection id",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := ""
+			if len(args) > 0 {
+				connectionID = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Accounts(cmd.Context(), &types.QueryAccountsRequest{})
+			res, err := queryClient.QueuedPackets(cmd.Context(), &types.QueryQueuedPacketsRequest{ConnectionId: connectionID, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -77,6 +206,274 @@ func getAccountsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued packets")
 
 	return cmd
 }
+
+func getAccountBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-balances [connection-id]",
+		Short: "Query the latest known balances and delegations of the interchain account on a given connection id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountBalances(cmd.Context(), &types.QueryAccountBalancesRequest{ConnectionId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSimulateMessagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-messages [proposal-file]",
+		Short: "Check the envoy messages in a governance proposal before submitting it",
+		Long: `Check the MsgSendMessages contained in a governance proposal file, in the
+same format as used by the submit-proposal command, by running the same checks
+that the envoy module runs when executing them, without sending any packet.
+
+Prints the interchain account that must be the messages' signer, and the size
+of the ICS-27 packet. If --host-node is provided, the messages are also
+simulated on the host chain by the node at that address.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseSendMessagesFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			hostNode, err := cmd.Flags().GetString(flagHostNode)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			for _, msg := range msgs {
+				res, err := queryClient.SimulateMessages(cmd.Context(), &types.QuerySimulateMessagesRequest{Msg: *msg})
+				if err != nil {
+					return err
+				}
+
+				if err = clientCtx.PrintProto(res); err != nil {
+					return err
+				}
+
+				if hostNode == "" {
+					continue
+				}
+
+				hostRes, err := simulateOnHost(cmd.Context(), clientCtx, hostNode, msg, res.InterchainAccount)
+				if err != nil {
+					return err
+				}
+
+				if err = clientCtx.PrintProto(hostRes); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagHostNode, "", "<host>:<port> to the Tendermint RPC interface of a host chain node, on which to simulate the messages")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getAuthorityPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authority-policy [address]",
+		Short: "Query the policy of an account that may send funds and messages within limits, and its spending in the current epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuthorityPolicy(cmd.Context(), &types.QueryAuthorityPolicyRequest{Authority: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getAuthorityPoliciesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authority-policies",
+		Short: "Query the policies of all accounts that may send funds and messages within limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuthorityPolicies(cmd.Context(), &types.QueryAuthorityPoliciesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "authority policies")
+
+	return cmd
+}
+
+func getScheduledOperationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-operation [id]",
+		Short: "Query an operation scheduled to be executed by the envoy module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledOperation(cmd.Context(), &types.QueryScheduledOperationRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getScheduledOperationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-operations",
+		Short: "Query all operations scheduled to be executed by the envoy module, and their total reserved funding",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledOperations(cmd.Context(), &types.QueryScheduledOperationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled operations")
+
+	return cmd
+}
+
+func getParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the envoy module's parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const flagStatus = "status"
+
+// parsePacketStatus parses the packet status flag, which can either be the
+// full enum name (e.g. PACKET_STATUS_PENDING) or the short form (e.g. pending).
+func parsePacketStatus(cmd *cobra.Command) (types.PacketStatus, error) {
+	statusStr, err := cmd.Flags().GetString(flagStatus)
+	if err != nil || statusStr == "" {
+		return types.PacketStatusUnspecified, err
+	}
+
+	statusStr = strings.ToUpper(statusStr)
+	if !strings.HasPrefix(statusStr, "PACKET_STATUS_") {
+		statusStr = "PACKET_STATUS_" + statusStr
+	}
+
+	status, found := types.PacketStatus_value[statusStr]
+	if !found {
+		return types.PacketStatusUnspecified, fmt.Errorf("invalid packet status: %s", statusStr)
+	}
+
+	return types.PacketStatus(status), nil
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+const flagHostNode = "host-node"
+
+// parseSendMessagesFile reads a governance proposal file, in the same format as
+// used by the submit-proposal command, and returns the MsgSendMessages in it.
+func parseSendMessagesFile(cdc codec.Codec, path string) ([]*types.MsgSendMessages, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposal struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if err = json.Unmarshal(bz, &proposal); err != nil {
+		return nil, err
+	}
+
+	msgs := []*types.MsgSendMessages{}
+	for _, rawMsg := range proposal.Messages {
+		var msg sdk.Msg
+		if err = cdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, err
+		}
+
+		if sendMessagesMsg, ok := msg.(*types.MsgSendMessages); ok {
+			msgs = append(msgs, sendMessagesMsg)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("proposal file %s contains no %s", path, sdk.MsgTypeURL(&types.MsgSendMessages{}))
+	}
+
+	return msgs, nil
+}
+
+// simulateOnHost simulates the execution of the given messages by the
+// interchain account on the host chain, by sending a simulate request to the
+// host chain node at the given address.
+//
+// The simulated tx has no signature, which the host chain's ante handler
+// accepts in simulation mode, but its sequence must match the interchain
+// account's, so the account is queried from the host chain first.
+func simulateOnHost(
+	ctx context.Context, clientCtx client.Context, hostNode string,
+	msg *types.MsgSendMessages, address string,
+) (*txtypes.SimulateResponse, error) {
+	hostClient, err := client.NewClientFromNode(hostNode)
+	if err != nil {
+		return nil, err
+	}
+
+	hostCtx := clientCtx.WithNodeURI(hostNode).WithClient(hostClient)
+
+	accountRes, err := authtypes.NewQueryClient(hostCtx).Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query interchain account %s on host chain: %w", address, err)
+	}
+
+	var account authtypes.AccountI
+	if err = clientCtx.InterfaceRegistry.UnpackAny(accountRes.Account, &account); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.Codec.Marshal(&txtypes.Tx{
+		Body: &txtypes.TxBody{Messages: msg.Messages},
+		AuthInfo: &txtypes.AuthInfo{
+			SignerInfos: []*txtypes.SignerInfo{
+				{
+					ModeInfo: &txtypes.ModeInfo{
+						Sum: &txtypes.ModeInfo_Single_{
+							Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+						},
+					},
+					Sequence: account.GetSequence(),
+				},
+			},
+			Fee: &txtypes.Fee{},
+		},
+		Signatures: [][]byte{{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txtypes.NewServiceClient(hostCtx).Simulate(ctx, &txtypes.SimulateRequest{TxBytes: txBytes})
+}
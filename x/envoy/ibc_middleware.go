@@ -0,0 +1,78 @@
+package envoy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcporttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+)
+
+// TransferMiddleware wraps the ICS-20 transfer module, so that when an ICS-20
+// transfer sent by the envoy module fails or times out, the part of it drawn
+// from the community pool is returned to the community pool, instead of being
+// left in the envoy module account. The same goes for the unused part of the
+// transfer's relayer fee.
+type TransferMiddleware struct {
+	ibcporttypes.IBCModule
+
+	k keeper.Keeper
+}
+
+// NewTransferMiddleware creates a new TransferMiddleware given the underlying
+// ICS-20 transfer module and the keeper.
+func NewTransferMiddleware(app ibcporttypes.IBCModule, k keeper.Keeper) ibcporttypes.IBCModule {
+	return TransferMiddleware{app, k}
+}
+
+// OnAcknowledgementPacket first lets the transfer module handle the ack, which
+// refunds the envoy module account if the ack is an error. In this case, the
+// part of the transfer drawn from the community pool is returned to it.
+// Otherwise, the transfer's record is deleted. In either case, the refunded
+// timeout fee is returned to the community pool, up to the part drawn from it.
+func (im TransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet ibcchanneltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack ibcchanneltypes.Acknowledgement
+	if err := ibcchanneltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ICS-20 packet acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		if err := im.k.RefundFundedTransfer(ctx, packet.SourceChannel, packet.Sequence); err != nil {
+			return err
+		}
+	} else {
+		im.k.DeleteFundedTransfer(ctx, packet.SourceChannel, packet.Sequence)
+	}
+
+	return im.k.RefundFundedFee(ctx, packet.SourceChannel, packet.Sequence, false)
+}
+
+// OnTimeoutPacket first lets the transfer module refund the envoy module
+// account, then returns the parts of the transfer and of the refunded recv and
+// ack fees drawn from the community pool to it.
+func (im TransferMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet ibcchanneltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	if err := im.k.RefundFundedTransfer(ctx, packet.SourceChannel, packet.Sequence); err != nil {
+		return err
+	}
+
+	return im.k.RefundFundedFee(ctx, packet.SourceChannel, packet.Sequence, true)
+}
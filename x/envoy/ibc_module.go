@@ -1,24 +1,20 @@
 package envoy
 
 import (
-	"encoding/hex"
+	"strconv"
 
 	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibcporttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 
-	"github.com/CosmWasm/wasmd/x/wasm"
-
 	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
 )
 
 // IBCModule implements the ICS26 interface for the envoy module.
@@ -29,19 +25,23 @@ func NewIBCModule(k keeper.Keeper) ibcporttypes.IBCModule {
 	return IBCModule{k}
 }
 
+// OnChanOpenInit validates the channel's ordering and version. The version
+// string received here has already been filled in and validated by the ICA
+// controller middleware, which discards the version we return.
 func (im IBCModule) OnChanOpenInit(
 	_ sdk.Context,
-	_ ibcchanneltypes.Order,
+	order ibcchanneltypes.Order,
 	_ []string,
-	_ string,
+	portID string,
 	_ string,
 	_ *capabilitytypes.Capability,
 	_ ibcchanneltypes.Counterparty,
 	version string,
 ) (string, error) {
-	// the module is supposed to validate the version string here.
-	// however, the version string is provided by the module's msgServer as an
-	// empty string. therefore we skip the validation here.
+	if err := im.k.ValidateChannelOpenInit(order, portID, version); err != nil {
+		return "", err
+	}
+
 	return version, nil
 }
 
@@ -62,16 +62,18 @@ func (im IBCModule) OnChanOpenTry(
 	panic("UNREACHABLE: envoy module OnChanOpenTry")
 }
 
+// OnChanOpenAck deletes the recovery record of the connection, if there is one,
+// as the interchain account is reachable again.
 func (im IBCModule) OnChanOpenAck(
-	_ sdk.Context,
-	_,
-	_ string,
+	ctx sdk.Context,
+	portID,
+	channelID string,
 	_ string,
 	_ string,
 ) error {
 	// counterpartyVersion is already validated by the controller middleware.
 	// we assume it's valid and don't validate again here.
-	return nil
+	return im.k.CompleteRecovery(ctx, portID, channelID)
 }
 
 func (im IBCModule) OnChanOpenConfirm(
@@ -109,11 +111,13 @@ func (im IBCModule) OnRecvPacket(
 	panic("UNREACHABLE: envoy module OnRecvPacket")
 }
 
-// OnAcknowledgementPacket parses the acknowledgement and prints log messages.
+// OnAcknowledgementPacket parses the acknowledgement, updates the packet's
+// record in the module store, and emits events containing the outcome and the
+// decoded message responses, for indexers to consume.
 //
 // Although the envoy module can send both ICS-20 and ICS-27 packets, only
 // ICS-27 acknowledgements are routed here. ICS-20 packets are handled by the
-// ibctransfer module alone.
+// ibctransfer module, wrapped in the envoy module's TransferMiddleware.
 //
 // This function is mostly copied from interchain-account-demo:
 // https://github.com/cosmos/interchain-accounts-demo/blob/v0.4.3/x/inter-tx/ibc_module.go#L108
@@ -123,8 +127,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	_ sdk.AccAddress,
 ) error {
-	logger := im.k.Logger(ctx)
-	logger.Info(
+	im.k.Logger(ctx).Info(
 		"received ICS-27 packet acknowledgement",
 		"channel", packet.DestinationChannel,
 		"sequence", packet.Sequence,
@@ -135,93 +138,155 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ICS-27 packet acknowledgement: %v", err)
 	}
 
+	// the fee middleware has already refunded the unused timeout fee, if any;
+	// return the part of it drawn from the community pool
+	if err := im.k.RefundFundedFee(ctx, packet.SourceChannel, packet.Sequence, false); err != nil {
+		return err
+	}
+
+	// the packet is a balances query sent by the module itself, rather than
+	// messages sent by a proposal
+	if query, found := im.k.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence); found {
+		im.k.HandleBalancesQueryAcknowledged(ctx, query, ack)
+		return nil
+	}
+
+	if query, found := im.k.GetHostParamsQuery(ctx, packet.SourceChannel, packet.Sequence); found {
+		im.k.HandleHostParamsQueryAcknowledged(ctx, query, ack)
+		return nil
+	}
+
+	// the host chain failed to execute the messages
+	//
+	// note that the packet is sent from our side of the channel, so we identify
+	// the record with the source channel, not the destination channel
+	if !ack.Success() {
+		im.k.RecordPacketAcknowledged(ctx, packet.SourceChannel, packet.Sequence, nil, ack.GetError())
+
+		ctx.EventManager().EmitEvent(newPacketAcknowledgedEvent(packet, false, ack.GetError()))
+
+		return nil
+	}
+
 	var txMsgData sdk.TxMsgData
 	if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
 		return sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ICS-27 tx message data: %v", err)
 	}
 
+	responses := []types.MsgResponse{}
+
 	switch len(txMsgData.Data) {
 	// sdk 0.46
 	case 0:
 		for _, msgResp := range txMsgData.GetMsgResponses() {
-			logger.Info(
-				"message response in ICS-27 packet",
-				"msgType", msgResp.TypeUrl,
-				"response", msgResp.GoString(),
-			)
+			responses = append(responses, types.MsgResponse{
+				TypeUrl: msgResp.TypeUrl,
+				Data:    im.k.DecodeMsgResponse(msgResp.TypeUrl, msgResp.Value),
+			})
 		}
-		return nil
 
 	// sdk 0.45 or below
+	//
+	// the type URL is the one of the message, not the response. by convention,
+	// the response's type URL is the message's suffixed with "Response"
 	default:
-		for _, msgData := range txMsgData.Data {
-			response, err := handleMsgData(msgData)
-			if err != nil {
-				return err
-			}
-
-			logger.Info(
-				"message response in ICS-27 packet response",
-				"msgType", msgData.MsgType,
-				"response", response,
-			)
+		for _, msgData := range txMsgData.Data { //nolint:staticcheck // This parses data from sdk 0.45 chains, so of course it contains deprecated stuff.
+			responses = append(responses, types.MsgResponse{
+				TypeUrl: msgData.MsgType,
+				Data:    im.k.DecodeMsgResponse(msgData.MsgType+"Response", msgData.Data),
+			})
 		}
-		return nil
 	}
-}
 
-// handleMsgData parses the message response and return a human readable string
-// representing the response data, for use in logging.
-//
-// We can't support every existing message types out there. Instead we only
-// support a selected few:
-//
-//   - bank: MsgSend
-//   - staking: MsgDelegate, MsgUndelegate
-//   - distribution: MsgWithdrawRewards
-//   - wasm: MsgExecuteContract, MsgMigrateContract
-//
-// For the others, we simply return the hex encoded bytes.
-func handleMsgData(msgData *sdk.MsgData) (string, error) { //nolint:staticcheck // This function parses data from sdk 0.45 chains, so of course it contains deprecated stuff. Not my problem lol
-	switch msgData.MsgType {
-	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
-		return handleProtoMsg[*banktypes.MsgSendResponse](msgData.Data, "bank/MsgSend")
-	case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):
-		return handleProtoMsg[*stakingtypes.MsgDelegateResponse](msgData.Data, "staking/MsgDelegate")
-	case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
-		return handleProtoMsg[*stakingtypes.MsgUndelegateResponse](msgData.Data, "staking/MsgUndelegate")
-	case sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}):
-		return handleProtoMsg[*distrtypes.MsgWithdrawDelegatorRewardResponse](msgData.Data, "distr/MsgWithdrawDelegatorReward")
-	case sdk.MsgTypeURL(&wasm.MsgExecuteContract{}):
-		return handleProtoMsg[*wasm.MsgExecuteContractResponse](msgData.Data, "wasm/MsgExecuteContract")
-	case sdk.MsgTypeURL(&wasm.MsgMigrateContract{}):
-		return handleProtoMsg[*wasm.MsgMigrateContractResponse](msgData.Data, "wasm/MsgMigrateContract")
-	default:
-		return hex.EncodeToString(msgData.Data), nil
-	}
-}
+	im.k.RecordPacketAcknowledged(ctx, packet.SourceChannel, packet.Sequence, responses, "")
 
-// handleProtoMsg unmarshals bytes into a given proto message and stringifies it.
-func handleProtoMsg[T proto.Message](bz []byte, tyName string) (string, error) {
-	var msg T
-	if err := proto.Unmarshal(bz, msg); err != nil {
-		return "", sdkerrors.ErrJSONUnmarshal.Wrapf("cannot unmarshal %s response: %v", tyName, err)
+	events := sdk.Events{newPacketAcknowledgedEvent(packet, true, "")}
+	for i, response := range responses {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeMsgResponse,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.Itoa(i)),
+			sdk.NewAttribute(types.AttributeKeyTypeURL, response.TypeUrl),
+			sdk.NewAttribute(types.AttributeKeyData, response.Data),
+		))
 	}
 
-	return msg.String(), nil
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// newPacketAcknowledgedEvent creates the event emitted when an ICS-27 packet
+// sent by a proposal is acknowledged.
+func newPacketAcknowledgedEvent(packet ibcchanneltypes.Packet, success bool, errMsg string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypePacketAcknowledged,
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+		sdk.NewAttribute(types.AttributeKeyError, errMsg),
+	)
 }
 
-// OnTimeoutPacket prints a log message indicating the packet has timed out.
+// OnTimeoutPacket marks the packet's record as timed out, queues the packet's
+// payload so that it can be resent, marks the interchain account for recovery,
+// and prints a log message.
+//
+// As ICA channels are ordered, the channel is closed after this callback
+// returns. The EndBlocker will then attempt to reopen it.
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet ibcchanneltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
-	im.k.Logger(ctx).Info(
+	logger := im.k.Logger(ctx)
+	logger.Info(
 		"ICS-27 packet timed out",
 		"channel", packet.DestinationChannel,
 		"sequence", packet.Sequence,
 	)
 
+	// the fee middleware has already refunded the unused recv and ack fees, if
+	// any; return the part of them drawn from the community pool
+	if err := im.k.RefundFundedFee(ctx, packet.SourceChannel, packet.Sequence, true); err != nil {
+		logger.Error(
+			"failed to return unused relayer fee to community pool",
+			"channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", err,
+		)
+	}
+
+	// queries are not recorded as packets, and don't need to be resent, as the
+	// next query will be sent after the channel has been reopened
+	if query, found := im.k.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence); found {
+		im.k.HandleBalancesQueryTimedOut(ctx, query)
+	} else if query, found := im.k.GetHostParamsQuery(ctx, packet.SourceChannel, packet.Sequence); found {
+		im.k.HandleHostParamsQueryTimedOut(ctx, query)
+	} else {
+		im.k.RecordPacketTimedOut(ctx, packet.SourceChannel, packet.Sequence)
+
+		// we don't return the errors here, as doing so would revert the timeout
+		// and leave the packet stuck. the messages can still be sent in a new
+		// proposal, and the channel can still be reopened manually.
+		if err := im.k.QueuePacket(ctx, packet); err != nil {
+			logger.Error(
+				"failed to queue timed out ICS-27 packet",
+				"channel", packet.SourceChannel,
+				"sequence", packet.Sequence,
+				"error", err,
+			)
+		}
+	}
+
+	if err := im.k.MarkForRecovery(ctx, packet.SourcePort, packet.SourceChannel); err != nil {
+		logger.Error(
+			"failed to mark interchain account for recovery",
+			"channel", packet.SourceChannel,
+			"error", err,
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// isAuthority returns whether the given address is one of the module's
+// authorities, which have full power over the module's messages.
+func (k Keeper) isAuthority(address string) bool {
+	return marsutils.Contains(k.authorities, address)
+}
+
+// isGovernance returns whether the given address is the gov module account,
+// which is the only authority that may draw funds from the community pool.
+func (k Keeper) isGovernance(address string) bool {
+	return address == k.accountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+}
+
+// authorizeSendFunds asserts that the given authority may send the given
+// amount of coins to the interchain account on the given connection, or spend
+// them on relayer fees for packets sent on the connection.
+//
+// The module's authorities may send any amount. Other accounts must have a
+// policy allowing the connection, and the amount must fit within what is left
+// of the policy's spend limit in the current epoch. In this case, the amount is
+// added to the account's spending.
+func (k Keeper) authorizeSendFunds(ctx sdk.Context, authority, connectionID string, amount sdk.Coins) error {
+	if k.isAuthority(authority) {
+		return nil
+	}
+
+	policy, err := k.getAuthorityPolicyForConnection(ctx, authority, connectionID)
+	if err != nil {
+		return err
+	}
+
+	spending := k.getCurrentAuthoritySpending(ctx, policy)
+	spending.Spent = spending.Spent.Add(amount...)
+
+	if !spending.Spent.IsAllLTE(policy.SpendLimit) {
+		return types.ErrSpendLimitExceeded.Wrapf(
+			"address `%s` has spent %s of %s in the current epoch, can't send %s more",
+			authority, spending.Spent.Sub(amount...), policy.SpendLimit, amount,
+		)
+	}
+
+	k.SetAuthoritySpending(ctx, spending)
+
+	return nil
+}
+
+// authorizeSendMessages asserts that the given authority may send messages of
+// the given type URLs to the interchain account on the given connection.
+//
+// The module's authorities may send any message. Other accounts must have a
+// policy allowing the connection and each of the messages.
+func (k Keeper) authorizeSendMessages(ctx sdk.Context, authority, connectionID string, msgTypeURLs []string) error {
+	if k.isAuthority(authority) {
+		return nil
+	}
+
+	policy, err := k.getAuthorityPolicyForConnection(ctx, authority, connectionID)
+	if err != nil {
+		return err
+	}
+
+	for _, typeURL := range msgTypeURLs {
+		if !marsutils.Contains(policy.AllowedMsgTypeUrls, typeURL) {
+			return types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send %s", authority, typeURL)
+		}
+	}
+
+	return nil
+}
+
+// getAuthorityPolicyForConnection loads the policy of the given authority, and
+// asserts that it allows the given connection.
+func (k Keeper) getAuthorityPolicyForConnection(ctx sdk.Context, authority, connectionID string) (types.AuthorityPolicy, error) {
+	policy, found := k.GetAuthorityPolicy(ctx, authority)
+	if !found {
+		return policy, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", authority)
+	}
+
+	if !marsutils.Contains(policy.AllowedConnections, connectionID) {
+		return policy, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send to %s", authority, connectionID)
+	}
+
+	return policy, nil
+}
+
+// getCurrentAuthoritySpending loads the spending of the given policy's
+// authority. If the authority hasn't spent anything yet, or its last epoch is
+// over, returns an empty spending starting a new epoch at the current block
+// time.
+func (k Keeper) getCurrentAuthoritySpending(ctx sdk.Context, policy types.AuthorityPolicy) types.AuthoritySpending {
+	spending, found := k.GetAuthoritySpending(ctx, policy.Authority)
+	if found && ctx.BlockTime().Before(spending.EpochStartTime.Add(policy.EpochDuration)) {
+		return spending
+	}
+
+	return types.AuthoritySpending{
+		Authority:      policy.Authority,
+		EpochStartTime: ctx.BlockTime(),
+		Spent:          sdk.NewCoins(),
+	}
+}
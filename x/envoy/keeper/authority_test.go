@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// setMockAuthorityPolicy gives the sender a policy allowing it to send up to
+// 100 umars per day, and votes, to outpost 1.
+func (suite *KeeperTestSuite) setMockAuthorityPolicy(ctx sdk.Context) types.AuthorityPolicy {
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	policy := types.AuthorityPolicy{
+		Authority:          sender,
+		AllowedConnections: []string{suite.path1.EndpointA.ConnectionID},
+		AllowedMsgTypeUrls: []string{"/cosmos.gov.v1.MsgVote"},
+		SpendLimit:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+		EpochDuration:      24 * time.Hour,
+	}
+
+	_, err := msgServer.SetAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetAuthorityPolicy{
+		Authority: authority.String(),
+		Policy:    policy,
+	})
+	suite.Require().NoError(err)
+
+	return policy
+}
+
+func (suite *KeeperTestSuite) TestSetAuthorityPolicy() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	policy := types.AuthorityPolicy{
+		Authority:          sender,
+		AllowedConnections: []string{suite.path1.EndpointA.ConnectionID},
+		EpochDuration:      time.Hour,
+	}
+
+	// only the module's authority can set policies
+	_, err := msgServer.SetAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetAuthorityPolicy{
+		Authority: sender,
+		Policy:    policy,
+	})
+	suite.Require().Error(err)
+
+	// the module's authority itself can't be given a policy
+	_, err = msgServer.SetAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetAuthorityPolicy{
+		Authority: authority.String(),
+		Policy: types.AuthorityPolicy{
+			Authority:          authority.String(),
+			AllowedConnections: []string{suite.path1.EndpointA.ConnectionID},
+			EpochDuration:      time.Hour,
+		},
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.SetAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetAuthorityPolicy{
+		Authority: authority.String(),
+		Policy:    policy,
+	})
+	suite.Require().NoError(err)
+
+	stored, found := app.EnvoyKeeper.GetAuthorityPolicy(ctx, sender)
+	suite.Require().True(found)
+	suite.Require().Equal(policy.AllowedConnections, stored.AllowedConnections)
+	suite.Require().Equal(policy.EpochDuration, stored.EpochDuration)
+}
+
+func (suite *KeeperTestSuite) TestRemoveAuthorityPolicy() {
+	suite.SetupTest()
+
+	// registering the ICA changes the path's channel ID, so get the transfer
+	// channel ID first
+	channelID := suite.path1.EndpointA.ChannelID
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	// removing a policy that doesn't exist should fail
+	_, err := msgServer.RemoveAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorityPolicy{
+		Authority: authority.String(),
+		Address:   sender,
+	})
+	suite.Require().Error(err)
+
+	suite.setMockAuthorityPolicy(ctx)
+
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: sender,
+		ChannelId: channelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(50))),
+	})
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetAuthoritySpending(ctx, sender)
+	suite.Require().True(found)
+
+	// only the module's authority can remove policies
+	_, err = msgServer.RemoveAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorityPolicy{
+		Authority: sender,
+		Address:   sender,
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.RemoveAuthorityPolicy(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorityPolicy{
+		Authority: authority.String(),
+		Address:   sender,
+	})
+	suite.Require().NoError(err)
+
+	// both the policy and the spending should have been deleted
+	_, found = app.EnvoyKeeper.GetAuthorityPolicy(ctx, sender)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetAuthoritySpending(ctx, sender)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSendFundsWithAuthorityPolicy() {
+	suite.SetupTest()
+
+	// registering the ICA changes the path's channel ID, so get the transfer
+	// channel IDs first
+	channelID1 := suite.path1.EndpointA.ChannelID
+	channelID2 := suite.path2.EndpointA.ChannelID
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	sendFunds := func(ctx sdk.Context, channelID string, amount sdk.Coins) error {
+		_, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+			Authority: sender,
+			ChannelId: channelID,
+			Amount:    amount,
+		})
+		return err
+	}
+
+	// without a policy, the sender can't send anything
+	err := sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	policy := suite.setMockAuthorityPolicy(ctx)
+
+	// within the spend limit
+	err = sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(60))))
+	suite.Require().NoError(err)
+
+	// the connection is not allowed by the policy
+	err = sendFunds(ctx, channelID2, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// a forwarding route could lead to a chain the policy doesn't allow, so
+	// only the module's authorities may provide one
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority:    sender,
+		ChannelId:    channelID2,
+		Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))),
+		Route:        []types.ForwardHop{{Receiver: "cosmos1pfm", ChannelId: "channel-7"}},
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the denom is not covered by the spend limit
+	err = sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1))))
+	suite.Require().ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	// exceeds what is left of the spend limit
+	err = sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(41))))
+	suite.Require().ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	// exactly what is left of the spend limit
+	err = sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(40))))
+	suite.Require().NoError(err)
+
+	spending, found := app.EnvoyKeeper.GetAuthoritySpending(ctx, sender)
+	suite.Require().True(found)
+	suite.Require().Equal(policy.SpendLimit, spending.Spent)
+
+	// once the epoch is over, the spending is reset
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(policy.EpochDuration))
+
+	err = sendFunds(ctx, channelID1, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(30))))
+	suite.Require().NoError(err)
+
+	spending, found = app.EnvoyKeeper.GetAuthoritySpending(ctx, sender)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime(), spending.EpochStartTime)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(30))), spending.Spent)
+}
+
+func (suite *KeeperTestSuite) TestSendFundsWithAuthorityPolicyShortfall() {
+	suite.SetupTest()
+
+	channelID := suite.path1.EndpointA.ChannelID
+
+	envoyBalance := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(50)))
+	setTokenBalances(suite.hub, envoyBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	suite.setMockAuthorityPolicy(ctx)
+
+	sendFunds := func(amount sdk.Coins) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, err := msgServer.SendFunds(sdk.WrapSDKContext(cacheCtx), &types.MsgSendFunds{
+			Authority: sender,
+			ChannelId: channelID,
+			Amount:    amount,
+		})
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+
+	// within the spend limit, but more than the envoy module account holds;
+	// only governance may draw the difference from the community pool
+	err := sendFunds(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(60))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	communityPoolBalance := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName))
+	suite.Require().True(communityPoolBalance.IsEqual(communityPoolInitBalance))
+
+	// exactly what the envoy module account holds
+	err = sendFunds(envoyBalance)
+	suite.Require().NoError(err)
+
+	communityPoolBalance = app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName))
+	suite.Require().True(communityPoolBalance.IsEqual(communityPoolInitBalance))
+}
+
+func (suite *KeeperTestSuite) TestSendMessagesWithAuthorityPolicy() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+	registerInterchainAccount(suite.path2, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	suite.setMockAuthorityPolicy(ctx)
+
+	icaAddress := suite.interchainAccountAddress(ctx)
+	mockMessages := getMockMessages(icaAddress)
+
+	sendMessages := func(connectionID string, msgs ...proto.Message) error {
+		anys := []*codectypes.Any{}
+		for _, msg := range msgs {
+			any, err := codectypes.NewAnyWithValue(msg)
+			suite.Require().NoError(err)
+
+			anys = append(anys, any)
+		}
+
+		_, err := msgServer.SendMessages(sdk.WrapSDKContext(ctx), &types.MsgSendMessages{
+			Authority:    sender,
+			ConnectionId: connectionID,
+			Messages:     anys,
+		})
+		return err
+	}
+
+	// the vote message is allowed
+	err := sendMessages(suite.path1.EndpointA.ConnectionID, mockMessages[0])
+	suite.Require().NoError(err)
+
+	// the wasm message is not
+	err = sendMessages(suite.path1.EndpointA.ConnectionID, mockMessages...)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the connection to outpost 2 is not allowed
+	icaAddress2, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, suite.path2.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+
+	err = sendMessages(suite.path2.EndpointA.ConnectionID, getMockMessages(icaAddress2)[0])
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
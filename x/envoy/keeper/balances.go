@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// QueryAccountBalances sends an ICS-27 packet to each interchain account with
+// an open channel, querying its balances and delegations, if the
+// BalancesQueryInterval param is non-zero and at least this long has passed
+// since the last queries.
+//
+// Accounts that already have a query in flight are skipped. A failure to send
+// the query to one account doesn't prevent the queries to the others.
+func (k Keeper) QueryAccountBalances(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.BalancesQueryInterval == 0 {
+		return
+	}
+
+	if ctx.BlockTime().Before(k.GetLastBalancesQueryTime(ctx).Add(params.BalancesQueryInterval)) {
+		return
+	}
+
+	k.SetLastBalancesQueryTime(ctx, ctx.BlockTime())
+
+	_, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the ICA controller port ID", "error", err)
+		return
+	}
+
+	inFlight := make(map[string]bool)
+	k.IterateBalancesQueries(ctx, func(query types.BalancesQuery) bool {
+		inFlight[query.ConnectionId] = true
+		return false
+	})
+
+	for _, activeChannel := range k.icaControllerKeeper.GetAllActiveChannels(ctx) {
+		if activeChannel.PortId != portID || inFlight[activeChannel.ConnectionId] {
+			continue
+		}
+
+		// a packet sent on a closed channel would fail anyway
+		if _, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, activeChannel.ConnectionId, portID); !found {
+			continue
+		}
+
+		// use a cached context, so that a failed query doesn't leave any state
+		// changes behind
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		if err := k.sendBalancesQuery(cacheCtx, activeChannel.ConnectionId, portID, params); err != nil {
+			k.Logger(ctx).Error(
+				"failed to send interchain account balances query",
+				"connectionID", activeChannel.ConnectionId,
+				"error", err,
+			)
+
+			continue
+		}
+
+		// this also emits the events, which the IBC relayer listens to
+		writeCache()
+	}
+}
+
+// sendBalancesQuery sends an ICS-27 packet querying the balances and
+// delegations of the interchain account on the given connection, and records
+// it, so that its acknowledgement can be parsed as a query response.
+func (k Keeper) sendBalancesQuery(ctx sdk.Context, connectionID, portID string, params types.Params) error {
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.ErrNotFound.Wrapf("no interchain account exists on %s", connectionID)
+	}
+
+	balancesReq, err := k.cdc.Marshal(&banktypes.QueryAllBalancesRequest{Address: address})
+	if err != nil {
+		return err
+	}
+
+	delegationsReq, err := k.cdc.Marshal(&stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: address})
+	if err != nil {
+		return err
+	}
+
+	channelID, sequence, err := k.sendInterchainQuery(ctx, connectionID, address, []*types.QueryRequest{
+		{Path: types.QueryPathAllBalances, Data: balancesReq},
+		{Path: types.QueryPathDelegatorDelegations, Data: delegationsReq},
+	}, params.MessagesTimeout)
+	if err != nil {
+		return err
+	}
+
+	k.SetBalancesQuery(ctx, types.BalancesQuery{
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		ConnectionId: connectionID,
+	})
+
+	k.Logger(ctx).Info(
+		"initiated interchain account balances query",
+		"connectionID", connectionID,
+		"channelID", channelID,
+		"sequence", sequence,
+	)
+
+	return nil
+}
+
+// HandleBalancesQueryAcknowledged parses the acknowledgement of a balances
+// query, saves the balances and delegations, and deletes the query's record.
+//
+// Errors are logged instead of returned, as failing the acknowledgement would
+// leave the packet stuck and block the ordered ICA channel. The previously
+// known balances are kept in this case.
+func (k Keeper) HandleBalancesQueryAcknowledged(ctx sdk.Context, query types.BalancesQuery, ack ibcchanneltypes.Acknowledgement) {
+	k.DeleteBalancesQuery(ctx, query.ChannelId, query.Sequence)
+
+	logger := k.Logger(ctx)
+
+	if !ack.Success() {
+		logger.Info(
+			"interchain account balances query acknowledged with error",
+			"connectionID", query.ConnectionId,
+			"error", ack.GetError(),
+		)
+
+		return
+	}
+
+	accountBalances, err := k.parseBalancesQueryResult(ctx, query.ConnectionId, ack.GetResult())
+	if err != nil {
+		logger.Error(
+			"failed to parse interchain account balances query result",
+			"connectionID", query.ConnectionId,
+			"error", err,
+		)
+
+		return
+	}
+
+	k.SetAccountBalances(ctx, accountBalances)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccountBalances,
+			sdk.NewAttribute(types.AttributeKeyConnection, accountBalances.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyInterchainAccount, accountBalances.Address),
+			sdk.NewAttribute(types.AttributeKeyHeight, sdk.NewIntFromUint64(accountBalances.Height).String()),
+		),
+	)
+
+	logger.Info(
+		"received interchain account balances",
+		"connectionID", accountBalances.ConnectionId,
+		"height", accountBalances.Height,
+		"balances", accountBalances.Balances.String(),
+		"numDelegations", len(accountBalances.Delegations),
+	)
+}
+
+// HandleBalancesQueryTimedOut deletes the record of a balances query that has
+// timed out. As the channel is closed by the timeout, the next query is sent
+// once the channel has been reopened.
+func (k Keeper) HandleBalancesQueryTimedOut(ctx sdk.Context, query types.BalancesQuery) {
+	k.DeleteBalancesQuery(ctx, query.ChannelId, query.Sequence)
+
+	k.Logger(ctx).Info(
+		"interchain account balances query timed out",
+		"connectionID", query.ConnectionId,
+	)
+}
+
+// parseBalancesQueryResult decodes the result of a successful balances query.
+func (k Keeper) parseBalancesQueryResult(ctx sdk.Context, connectionID string, result []byte) (types.AccountBalances, error) {
+	queryRes, err := k.parseInterchainQueryResult(result, 2)
+	if err != nil {
+		return types.AccountBalances{}, err
+	}
+
+	var balancesRes banktypes.QueryAllBalancesResponse
+	if err := k.cdc.Unmarshal(queryRes.Responses[0], &balancesRes); err != nil {
+		return types.AccountBalances{}, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal AllBalances response: %v", err)
+	}
+
+	var delegationsRes stakingtypes.QueryDelegatorDelegationsResponse
+	if err := k.cdc.Unmarshal(queryRes.Responses[1], &delegationsRes); err != nil {
+		return types.AccountBalances{}, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal DelegatorDelegations response: %v", err)
+	}
+
+	delegations := []types.DelegationBalance{}
+	for _, delegation := range delegationsRes.DelegationResponses {
+		delegations = append(delegations, types.DelegationBalance{
+			ValidatorAddress: delegation.Delegation.ValidatorAddress,
+			Balance:          delegation.Balance,
+		})
+	}
+
+	_, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		return types.AccountBalances{}, err
+	}
+
+	address, _ := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return types.AccountBalances{
+		ConnectionId: connectionID,
+		Address:      address,
+		Balances:     balancesRes.Balances,
+		Delegations:  delegations,
+		Height:       queryRes.Height,
+		Time:         ctx.BlockTime(),
+	}, nil
+}
+
+// sendInterchainQuery sends an ICS-27 packet executing the given queries with
+// the interchain account on the given connection, which is the signer of the
+// MsgModuleQuerySafe.
+//
+// Returns the channel id and sequence of the sent packet.
+func (k Keeper) sendInterchainQuery(
+	ctx sdk.Context, connectionID, address string, requests []*types.QueryRequest,
+	timeout time.Duration,
+) (string, uint64, error) {
+	msg, err := k.cdc.Marshal(&types.MsgModuleQuerySafe{
+		Signer:   address,
+		Requests: requests,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	// our MsgModuleQuerySafe is only a copy of the ICA host module's, so we
+	// need to pack it into an Any with the host module's type URL ourselves,
+	// instead of using icatypes.SerializeCosmosTx
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{
+		Messages: []*codectypes.Any{{TypeUrl: types.TypeURLMsgModuleQuerySafe, Value: msg}},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	return k.sendCosmosTx(ctx, connectionID, data, timeout)
+}
+
+// parseInterchainQueryResult decodes the result of a successful interchain
+// query, which is the TxMsgData containing a single MsgModuleQuerySafeResponse,
+// and checks that it contains the expected number of query responses.
+func (k Keeper) parseInterchainQueryResult(result []byte, numResponses int) (types.MsgModuleQuerySafeResponse, error) {
+	var queryRes types.MsgModuleQuerySafeResponse
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(result, &txMsgData); err != nil {
+		return queryRes, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ICS-27 tx message data: %v", err)
+	}
+
+	if len(txMsgData.MsgResponses) != 1 || txMsgData.MsgResponses[0].TypeUrl != types.TypeURLMsgModuleQuerySafeResponse {
+		return queryRes, sdkerrors.ErrUnknownRequest.Wrap("expecting a single MsgModuleQuerySafeResponse")
+	}
+
+	if err := k.cdc.Unmarshal(txMsgData.MsgResponses[0].Value, &queryRes); err != nil {
+		return queryRes, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal MsgModuleQuerySafeResponse: %v", err)
+	}
+
+	if len(queryRes.Responses) != numResponses {
+		return queryRes, sdkerrors.ErrUnknownRequest.Wrapf("expecting %d query responses, found %d", numResponses, len(queryRes.Responses))
+	}
+
+	return queryRes, nil
+}
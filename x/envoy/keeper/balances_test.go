@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy"
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+var (
+	mockBalances = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(420)))
+
+	mockDelegations = []types.DelegationBalance{
+		{
+			ValidatorAddress: "cosmosvaloper1abc",
+			Balance:          sdk.NewCoin("uosmo", sdk.NewInt(69)),
+		},
+	}
+)
+
+// sendBalancesQuery enables the balances queries and runs the EndBlocker, which
+// sends a query to the ICA on outpost 1. It returns the sent packet. It assumes
+// an ICA has already been registered on outpost 1.
+func (suite *KeeperTestSuite) sendBalancesQuery(ctx sdk.Context) ibcchanneltypes.Packet {
+	app := getMarsApp(suite.hub)
+
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.BalancesQueryInterval = time.Hour
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	return suite.lastSentPacket(ctx)
+}
+
+// mockBalancesQueryAck creates the acknowledgement that a host chain supporting
+// ICS-27 queries responds to a balances query with.
+func (suite *KeeperTestSuite) mockBalancesQueryAck() ibcchanneltypes.Acknowledgement {
+	app := getMarsApp(suite.hub)
+
+	balancesRes, err := app.AppCodec().Marshal(&banktypes.QueryAllBalancesResponse{Balances: mockBalances})
+	suite.Require().NoError(err)
+
+	delegationsRes, err := app.AppCodec().Marshal(&stakingtypes.QueryDelegatorDelegationsResponse{
+		DelegationResponses: stakingtypes.DelegationResponses{
+			{
+				Delegation: stakingtypes.Delegation{ValidatorAddress: mockDelegations[0].ValidatorAddress},
+				Balance:    mockDelegations[0].Balance,
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	queryRes, err := app.AppCodec().Marshal(&types.MsgModuleQuerySafeResponse{
+		Height:    12345,
+		Responses: [][]byte{balancesRes, delegationsRes},
+	})
+	suite.Require().NoError(err)
+
+	result, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{{TypeUrl: types.TypeURLMsgModuleQuerySafeResponse, Value: queryRes}},
+	})
+	suite.Require().NoError(err)
+
+	return ibcchanneltypes.NewResultAcknowledgement(result)
+}
+
+func (suite *KeeperTestSuite) TestBalancesQuerySent() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	// no query should be sent while the queries are disabled
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	packet := suite.sendBalancesQuery(ctx)
+
+	query, found := app.EnvoyKeeper.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(connectionID, query.ConnectionId)
+
+	// the packet should contain a MsgModuleQuerySafe with the ICA host module's
+	// type URL, querying the balances and delegations
+	var packetData icatypes.InterchainAccountPacketData
+	err := icatypes.ModuleCdc.UnmarshalJSON(packet.Data, &packetData)
+	suite.Require().NoError(err)
+
+	// unmarshal without unpacking the Any, as our MsgModuleQuerySafe is not
+	// registered with the ICA host module's type URL
+	var cosmosTx icatypes.CosmosTx
+	err = proto.Unmarshal(packetData.Data, &cosmosTx)
+	suite.Require().NoError(err)
+	suite.Require().Len(cosmosTx.Messages, 1)
+	suite.Require().Equal(types.TypeURLMsgModuleQuerySafe, cosmosTx.Messages[0].TypeUrl)
+
+	var msg types.MsgModuleQuerySafe
+	err = app.AppCodec().Unmarshal(cosmosTx.Messages[0].Value, &msg)
+	suite.Require().NoError(err)
+
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(address, msg.Signer)
+	suite.Require().Len(msg.Requests, 2)
+	suite.Require().Equal(types.QueryPathAllBalances, msg.Requests[0].Path)
+	suite.Require().Equal(types.QueryPathDelegatorDelegations, msg.Requests[1].Path)
+
+	// no other query should be sent before the interval has passed, or while the
+	// query is in flight
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	// once the query is no longer in flight, the next one should be sent
+	app.EnvoyKeeper.DeleteBalancesQuery(ctx, packet.SourceChannel, packet.Sequence)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+	suite.Require().Len(suite.sentPackets(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestBalancesQueryAcknowledged() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	packet := suite.sendBalancesQuery(ctx)
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+
+	err := ibcModule.OnAcknowledgementPacket(ctx, packet, suite.mockBalancesQueryAck().Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	// the query's record should have been deleted, and no packet record should
+	// have been created for it
+	_, found := app.EnvoyKeeper.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	suite.Require().True(found)
+
+	res, err := queryServer.AccountBalances(ctx, &types.QueryAccountBalancesRequest{ConnectionId: connectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AccountBalances{
+		ConnectionId: connectionID,
+		Address:      address,
+		Balances:     mockBalances,
+		Delegations:  mockDelegations,
+		Height:       12345,
+		Time:         ctx.BlockTime(),
+	}, res.Balances)
+
+	// the balances of accounts that have never been queried are not found
+	_, err = queryServer.AccountBalances(ctx, &types.QueryAccountBalancesRequest{ConnectionId: suite.path2.EndpointA.ConnectionID})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBalancesQueryAcknowledgedWithError() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendBalancesQuery(ctx)
+
+	// e.g. the host chain doesn't support ICS-27 queries
+	ack := ibcchanneltypes.NewErrorAcknowledgement(errors.New("mock error"))
+	err := ibcModule.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetAccountBalances(ctx, suite.path1.EndpointA.ConnectionID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBalancesQueryTimedOut() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	packet := suite.sendBalancesQuery(ctx)
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetBalancesQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	// the query should not have been queued to be resent, but the account
+	// should have been marked for recovery
+	queuedPackets := []types.QueuedPacket{}
+	app.EnvoyKeeper.IterateQueuedPackets(ctx, "", func(queuedPacket types.QueuedPacket) bool {
+		queuedPackets = append(queuedPackets, queuedPacket)
+		return false
+	})
+	suite.Require().Empty(queuedPackets)
+
+	_, found = app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+}
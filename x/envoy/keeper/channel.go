@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+)
+
+// getAccountChannelVersion returns the version with which to open a channel
+// for the interchain account on the given connection, when governance hasn't
+// chosen one with MsgRegisterAccountWithVersion.
+//
+// If the account already has a channel, which must have been closed, its
+// version is reused, so that a reopened channel keeps the fee middleware
+// wrapper, if any. Otherwise, the default ICS-27 version is used, without the
+// fee middleware wrapper.
+//
+// We can't simply pass an empty version to the ICA controller module, as the
+// fee middleware would then default to wrapping it, and the handshake would
+// fail on host chains without the fee middleware.
+func (k Keeper) getAccountChannelVersion(ctx sdk.Context, connectionID, portID string) (string, error) {
+	if channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID); found {
+		if channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+			return channel.Version, nil
+		}
+	}
+
+	connection, err := k.channelKeeper.GetConnection(ctx, connectionID)
+	if err != nil {
+		return "", err
+	}
+
+	return icatypes.NewDefaultMetadataString(connectionID, connection.GetCounterparty().GetConnectionID()), nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// drawShortfall ensures the envoy module account holds at least the given
+// amount of coins, by drawing the difference from the community pool if its
+// balance is not sufficient. Returns the amount drawn.
+//
+// Only governance may draw from the community pool. Other authorities are
+// limited to the envoy module account's balance.
+func (k Keeper) drawShortfall(ctx sdk.Context, authority string, amount sdk.Coins) (sdk.Coins, error) {
+	owner := k.GetModuleAddress()
+	balance := k.bankKeeper.GetAllBalances(ctx, owner)
+
+	shortfall := marsutils.SaturateSub(amount, balance)
+	if shortfall.Empty() {
+		return shortfall, nil
+	}
+
+	if !k.isGovernance(authority) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"envoy module account holds %s, %s short of %s; only governance may draw from the community pool",
+			balance, shortfall, amount,
+		)
+	}
+
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, shortfall, owner); err != nil {
+		return nil, err
+	}
+
+	return shortfall, nil
+}
+
+// payRelayerFees escrows the given ICS-29 fee for each of the given packets,
+// which must have just been sent on a fee-enabled channel.
+//
+// The fees are paid from the envoy module account, with any shortfall drawn
+// from the community pool if the authority is governance. Unused fees are
+// refunded to the envoy module account, so for each packet whose fee was
+// partly drawn from the community pool, a record is kept in order to return
+// that part to the community pool once refunded. See RefundFundedFee.
+func (k Keeper) payRelayerFees(ctx sdk.Context, authority, portID, channelID string, sequences []uint64, fee types.RelayerFee) error {
+	shortfall, err := k.drawShortfall(ctx, authority, types.TotalRelayerFees(&fee, len(sequences)))
+	if err != nil {
+		return err
+	}
+
+	packetFee := fee.ToPacketFee(k.GetModuleAddress().String())
+
+	for _, sequence := range sequences {
+		packetID := ibcchanneltypes.NewPacketID(portID, channelID, sequence)
+
+		// the fee keeper emits the events, which the relayers listen to, to our
+		// context
+		if _, err := k.feeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), ibcfeetypes.NewMsgPayPacketFeeAsync(packetID, packetFee)); err != nil {
+			return err
+		}
+
+		// the shortfall is attributed to the packets in order. whichever part
+		// of this packet's fee ends up refunded, it is returned to the
+		// community pool first, up to what was drawn from it
+		fromPool := fee.Total().Min(shortfall)
+		if fromPool.IsZero() {
+			continue
+		}
+
+		shortfall = shortfall.Sub(fromPool...)
+
+		k.SetFundedFee(ctx, types.FundedFee{
+			PortId:        portID,
+			ChannelId:     channelID,
+			Sequence:      sequence,
+			AckRefund:     fromPool.Min(fee.TimeoutFee),
+			TimeoutRefund: fromPool.Min(fee.RecvFee.Add(fee.AckFee...)),
+		})
+	}
+
+	return nil
+}
+
+// RefundFundedFee returns the part of a packet's unused relayer fee that was
+// drawn from the community pool back to the community pool, and deletes the
+// fee's record.
+//
+// This function must be called after the fee middleware has refunded the
+// unused fee to the envoy module account, i.e. the timeout fee if the packet
+// was acknowledged, or the recv and ack fees if it timed out.
+//
+// Packets whose fee was not drawn from the community pool don't have a record,
+// in which case this function does nothing.
+func (k Keeper) RefundFundedFee(ctx sdk.Context, channelID string, sequence uint64, timedOut bool) error {
+	fee, found := k.GetFundedFee(ctx, channelID, sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteFundedFee(ctx, channelID, sequence)
+
+	amount := fee.AckRefund
+	if timedOut {
+		amount = fee.TimeoutRefund
+	}
+
+	if amount.Empty() {
+		return nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, amount, k.GetModuleAddress()); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolRefunded,
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"returned unused relayer fee to community pool",
+		"channelID", channelID,
+		"sequence", sequence,
+		"amount", amount.String(),
+	)
+
+	return nil
+}
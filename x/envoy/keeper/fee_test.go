@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcporttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// mockRelayerFee is a relayer fee of 17 umars in total
+var mockRelayerFee = types.RelayerFee{
+	RecvFee:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))),
+	AckFee:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5))),
+	TimeoutFee: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2))),
+}
+
+// requireFeeInEscrow asserts that the mock relayer fee is escrowed for the
+// given packet, refundable to the envoy module account.
+func (suite *KeeperTestSuite) requireFeeInEscrow(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	app := getMarsApp(suite.hub)
+
+	fees, found := app.IBCFeeKeeper.GetFeesInEscrow(ctx, ibcchanneltypes.NewPacketID(portID, channelID, sequence))
+	suite.Require().True(found)
+	suite.Require().Len(fees.PacketFees, 1)
+	suite.Require().Equal(mockRelayerFee.ToPacketFee(owner.String()), fees.PacketFees[0])
+}
+
+func (suite *KeeperTestSuite) TestSendFundsWithRelayerFee() {
+	suite.SetupTest()
+
+	feePath := suite.newFeeTransferPath(suite.path1)
+
+	// registering the ICA changes the path's channel ID, so get the transfer
+	// channel ID first
+	channelID := suite.path1.EndpointA.ChannelID
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	// the fee is paid once per packet, i.e. once per coin
+	res, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: authority.String(),
+		ChannelId: feePath.EndpointA.ChannelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(50)), sdk.NewCoin("umars", sdk.NewInt(100))),
+		Fee:       &mockRelayerFee,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Sequences, 2)
+
+	for _, sequence := range res.Sequences {
+		suite.requireFeeInEscrow(ctx, ibctransfertypes.PortID, feePath.EndpointA.ChannelID, sequence)
+	}
+
+	// 200 - 100 sent - 2 * 17 fees
+	balance := app.BankKeeper.GetBalance(ctx, owner, "umars")
+	suite.Require().Equal(sdk.NewInt(66), balance.Amount)
+
+	// the shortfall of the fees is drawn from the community pool
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: authority.String(),
+		ChannelId: feePath.EndpointA.ChannelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(60))),
+		Fee:       &mockRelayerFee,
+	})
+	suite.Require().NoError(err)
+
+	balance = app.BankKeeper.GetBalance(ctx, owner, "umars")
+	suite.Require().True(balance.IsZero())
+
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	suite.Require().Equal(sdk.NewDec(289), communityPool.AmountOf("umars"))
+
+	// the fee can't be paid on a channel without the fee middleware
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(cacheCtx), &types.MsgSendFunds{
+		Authority: authority.String(),
+		ChannelId: channelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))),
+		Fee:       &mockRelayerFee,
+	})
+	suite.Require().ErrorIs(err, ibcfeetypes.ErrFeeNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestSendMessagesWithRelayerFee() {
+	suite.SetupTest()
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+
+	registerInterchainAccount(suite.path1, owner.String())
+	registerInterchainAccount(suite.path2, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	// the outposts' interchain account host stacks don't have the fee
+	// middleware, so we can't open a fee-enabled channel with them. instead,
+	// mark the first account's channel as fee-enabled on the hub side, which is
+	// all that is needed for escrowing the fee
+	app.IBCFeeKeeper.SetFeeEnabled(ctx, portID, suite.path1.EndpointA.ChannelID)
+
+	icaAddress := suite.interchainAccountAddress(ctx)
+	any, err := codectypes.NewAnyWithValue(getMockMessages(icaAddress)[0])
+	suite.Require().NoError(err)
+
+	res, err := msgServer.SendMessages(sdk.WrapSDKContext(ctx), &types.MsgSendMessages{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+		Messages:     []*codectypes.Any{any},
+		Fee:          &mockRelayerFee,
+	})
+	suite.Require().NoError(err)
+
+	suite.requireFeeInEscrow(ctx, portID, suite.path1.EndpointA.ChannelID, res.Sequence)
+
+	balance := app.BankKeeper.GetBalance(ctx, owner, "umars")
+	suite.Require().Equal(sdk.NewInt(183), balance.Amount)
+
+	// the fee can't be paid on an interchain account channel without the fee
+	// middleware
+	icaAddress2, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, suite.path2.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+
+	any, err = codectypes.NewAnyWithValue(getMockMessages(icaAddress2)[0])
+	suite.Require().NoError(err)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.SendMessages(sdk.WrapSDKContext(cacheCtx), &types.MsgSendMessages{
+		Authority:    authority.String(),
+		ConnectionId: suite.path2.EndpointA.ConnectionID,
+		Messages:     []*codectypes.Any{any},
+		Fee:          &mockRelayerFee,
+	})
+	suite.Require().ErrorIs(err, ibcfeetypes.ErrFeeNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestRelayerFeeWithAuthorityPolicy() {
+	suite.SetupTest()
+
+	feePath := suite.newFeeTransferPath(suite.path1)
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	suite.setMockAuthorityPolicy(ctx)
+
+	// 90 umars fits within the spend limit of 100 umars, but not with the fee
+	_, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: sender,
+		ChannelId: feePath.EndpointA.ChannelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(90))),
+		Fee:       &mockRelayerFee,
+	})
+	suite.Require().ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: sender,
+		ChannelId: feePath.EndpointA.ChannelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(83))),
+		Fee:       &mockRelayerFee,
+	})
+	suite.Require().NoError(err)
+
+	spending, found := app.EnvoyKeeper.GetAuthoritySpending(ctx, sender)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))), spending.Spent)
+}
+
+func (suite *KeeperTestSuite) TestRelayerFeeRefundedToCommunityPool() {
+	testCases := []struct {
+		name     string
+		callback func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error
+		// community pool balance after the packet's callback, starting from 300
+		communityPool int64
+		// envoy module balance after the packet's callback
+		envoy int64
+	}{
+		{
+			// the unused timeout fee of 2 umars was drawn from the community pool
+			"acknowledged",
+			func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error {
+				ack := ibcfeetypes.NewIncentivizedAcknowledgement(
+					suite.hub.SenderAccount.GetAddress().String(),
+					ibcchanneltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+					true,
+				)
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.hub.SenderAccount.GetAddress())
+			},
+			285,
+			0,
+		},
+		{
+			// the unused recv and ack fees of 15 umars were drawn from the
+			// community pool, while the transfer itself was not
+			"timed out",
+			func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error {
+				return im.OnTimeoutPacket(ctx, packet, suite.hub.SenderAccount.GetAddress())
+			},
+			298,
+			200,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			feePath := suite.newFeeTransferPath(suite.path1)
+
+			setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+			registerInterchainAccount(suite.path1, owner.String())
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+			// the envoy module's 200 umars are all sent, so the fee of 17 umars
+			// is entirely drawn from the community pool
+			_, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+				Authority: authority.String(),
+				ChannelId: feePath.EndpointA.ChannelID,
+				Amount:    envoyInitBalance,
+				Fee:       &mockRelayerFee,
+			})
+			suite.Require().NoError(err)
+
+			packet := suite.lastSentPacket(ctx)
+
+			fee, found := app.EnvoyKeeper.GetFundedFee(ctx, packet.SourceChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2))), fee.AckRefund)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(15))), fee.TimeoutRefund)
+
+			// the callbacks go through the whole transfer stack, so that the
+			// fee middleware refunds the unused fee first
+			im, found := app.IBCKeeper.Router.GetRoute(ibctransfertypes.ModuleName)
+			suite.Require().True(found)
+
+			err = tc.callback(ctx, im, packet)
+			suite.Require().NoError(err)
+
+			_, found = app.EnvoyKeeper.GetFundedFee(ctx, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+			suite.Require().Equal(sdk.NewDec(tc.communityPool), communityPool.AmountOf("umars"))
+
+			balance := app.BankKeeper.GetBalance(ctx, owner, "umars")
+			suite.Require().Equal(sdk.NewInt(tc.envoy), balance.Amount)
+		})
+	}
+}
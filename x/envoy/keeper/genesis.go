@@ -11,12 +11,171 @@ import (
 //
 // NOTE: we call `GetModuleAccount` instead of `SetModuleAccount` because the
 // "get" function automatically sets the module account if it doesn't exist.
-func (k Keeper) InitGenesis(ctx sdk.Context, _ *types.GenesisState) {
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
 	// set module account
 	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	// set params
+	k.SetParams(ctx, gs.Params)
+
+	// set packet records
+	for _, packet := range gs.Packets {
+		k.SetPacket(ctx, packet)
+	}
+
+	// set recoveries
+	for _, recovery := range gs.Recoveries {
+		k.SetRecovery(ctx, recovery)
+	}
+
+	// set queued packets
+	k.SetNextQueuedPacketID(ctx, gs.NextQueuedPacketId)
+	for _, queuedPacket := range gs.QueuedPackets {
+		k.SetQueuedPacket(ctx, queuedPacket)
+	}
+
+	// set funded transfers and relayer fees
+	for _, transfer := range gs.FundedTransfers {
+		k.SetFundedTransfer(ctx, transfer)
+	}
+	for _, fee := range gs.FundedFees {
+		k.SetFundedFee(ctx, fee)
+	}
+
+	// set balances queries
+	for _, query := range gs.BalancesQueries {
+		k.SetBalancesQuery(ctx, query)
+	}
+
+	// set account balances
+	for _, accountBalances := range gs.AccountBalances {
+		k.SetAccountBalances(ctx, accountBalances)
+	}
+
+	k.SetLastBalancesQueryTime(ctx, gs.LastBalancesQueryTime)
+
+	// set host params queries
+	for _, query := range gs.HostParamsQueries {
+		k.SetHostParamsQuery(ctx, query)
+	}
+
+	// set host params
+	for _, hostParams := range gs.HostParams {
+		k.SetHostParams(ctx, hostParams)
+	}
+
+	k.SetLastHostParamsQueryTime(ctx, gs.LastHostParamsQueryTime)
+
+	// set authority policies
+	for _, policy := range gs.AuthorityPolicies {
+		k.SetAuthorityPolicy(ctx, policy)
+	}
+
+	// set authority spendings
+	for _, spending := range gs.AuthoritySpendings {
+		k.SetAuthoritySpending(ctx, spending)
+	}
+
+	// set scheduled operations
+	k.SetNextScheduledOperationID(ctx, gs.NextScheduledOperationId)
+	for _, op := range gs.ScheduledOperations {
+		k.SetScheduledOperation(ctx, op)
+	}
 }
 
 // ExportGenesis returns a genesis state for a given context and keeper.
-func (k Keeper) ExportGenesis(_ sdk.Context) *types.GenesisState {
-	return &types.GenesisState{}
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	packets := []types.Packet{}
+	k.IteratePackets(ctx, func(packet types.Packet) bool {
+		packets = append(packets, packet)
+		return false
+	})
+
+	recoveries := []types.Recovery{}
+	k.IterateRecoveries(ctx, func(recovery types.Recovery) bool {
+		recoveries = append(recoveries, recovery)
+		return false
+	})
+
+	queuedPackets := []types.QueuedPacket{}
+	k.IterateQueuedPackets(ctx, "", func(queuedPacket types.QueuedPacket) bool {
+		queuedPackets = append(queuedPackets, queuedPacket)
+		return false
+	})
+
+	fundedTransfers := []types.FundedTransfer{}
+	k.IterateFundedTransfers(ctx, func(transfer types.FundedTransfer) bool {
+		fundedTransfers = append(fundedTransfers, transfer)
+		return false
+	})
+
+	fundedFees := []types.FundedFee{}
+	k.IterateFundedFees(ctx, func(fee types.FundedFee) bool {
+		fundedFees = append(fundedFees, fee)
+		return false
+	})
+
+	balancesQueries := []types.BalancesQuery{}
+	k.IterateBalancesQueries(ctx, func(query types.BalancesQuery) bool {
+		balancesQueries = append(balancesQueries, query)
+		return false
+	})
+
+	accountBalances := []types.AccountBalances{}
+	k.IterateAccountBalances(ctx, func(balances types.AccountBalances) bool {
+		accountBalances = append(accountBalances, balances)
+		return false
+	})
+
+	hostParamsQueries := []types.HostParamsQuery{}
+	k.IterateHostParamsQueries(ctx, func(query types.HostParamsQuery) bool {
+		hostParamsQueries = append(hostParamsQueries, query)
+		return false
+	})
+
+	hostParams := []types.HostParams{}
+	k.IterateHostParams(ctx, func(params types.HostParams) bool {
+		hostParams = append(hostParams, params)
+		return false
+	})
+
+	authorityPolicies := []types.AuthorityPolicy{}
+	k.IterateAuthorityPolicies(ctx, func(policy types.AuthorityPolicy) bool {
+		authorityPolicies = append(authorityPolicies, policy)
+		return false
+	})
+
+	authoritySpendings := []types.AuthoritySpending{}
+	k.IterateAuthoritySpendings(ctx, func(spending types.AuthoritySpending) bool {
+		authoritySpendings = append(authoritySpendings, spending)
+		return false
+	})
+
+	scheduledOperations := []types.ScheduledOperation{}
+	k.IterateScheduledOperations(ctx, func(op types.ScheduledOperation) bool {
+		scheduledOperations = append(scheduledOperations, op)
+		return false
+	})
+
+	return &types.GenesisState{
+		Packets:                 packets,
+		Params:                  k.GetParams(ctx),
+		Recoveries:              recoveries,
+		NextQueuedPacketId:      k.GetNextQueuedPacketID(ctx),
+		QueuedPackets:           queuedPackets,
+		FundedTransfers:         fundedTransfers,
+		BalancesQueries:         balancesQueries,
+		AccountBalances:         accountBalances,
+		LastBalancesQueryTime:   k.GetLastBalancesQueryTime(ctx),
+		HostParamsQueries:       hostParamsQueries,
+		HostParams:              hostParams,
+		LastHostParamsQueryTime: k.GetLastHostParamsQueryTime(ctx),
+		AuthorityPolicies:       authorityPolicies,
+		AuthoritySpendings:      authoritySpendings,
+
+		NextScheduledOperationId: k.GetNextScheduledOperationID(ctx),
+		ScheduledOperations:      scheduledOperations,
+
+		FundedFees: fundedFees,
+	}
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -15,7 +16,163 @@ import (
 	"github.com/mars-protocol/hub/v2/x/envoy/types"
 )
 
-var mockGenesisState = &types.GenesisState{}
+var mockGenesisState = &types.GenesisState{
+	Packets: []types.Packet{
+		{
+			ChannelId:    "channel-0",
+			Sequence:     1,
+			ConnectionId: "connection-0",
+			Authority:    authtypes.NewModuleAddress("gov").String(),
+			MsgTypeUrls:  []string{"/cosmwasm.wasm.v1.MsgExecuteContract"},
+			SendTime:     time.Unix(10000, 0).UTC(),
+			Status:       types.PacketStatusSuccess,
+			Responses: []types.MsgResponse{
+				{
+					TypeUrl: "/cosmwasm.wasm.v1.MsgExecuteContract",
+					Data:    "{}",
+				},
+			},
+		},
+		{
+			ChannelId:    "channel-0",
+			Sequence:     2,
+			ConnectionId: "connection-0",
+			Authority:    authtypes.NewModuleAddress("gov").String(),
+			MsgTypeUrls:  []string{"/cosmos.gov.v1.MsgVote"},
+			SendTime:     time.Unix(20000, 0).UTC(),
+			Status:       types.PacketStatusPending,
+		},
+	},
+	Params: types.Params{
+		TransferTimeout:     30 * time.Minute,
+		MessagesTimeout:     24 * time.Hour,
+		RecoveryMaxAttempts: 3,
+		RecoveryBackoff:     time.Hour,
+		QueueExpiry:         7 * 24 * time.Hour,
+	},
+	Recoveries: []types.Recovery{
+		{
+			ConnectionId:    "connection-1",
+			ChannelId:       "channel-1",
+			Attempts:        2,
+			NextAttemptTime: time.Unix(20000, 0).UTC(),
+			LastError:       "connection not found",
+		},
+	},
+	NextQueuedPacketId: 3,
+	// queued packets are exported ordered by connection id first
+	QueuedPackets: []types.QueuedPacket{
+		{
+			Id:           2,
+			ConnectionId: "connection-0",
+			ChannelId:    "channel-0",
+			Sequence:     3,
+			Authority:    authtypes.NewModuleAddress("gov").String(),
+			MsgTypeUrls:  []string{"/cosmos.gov.v1.MsgVote"},
+			Data:         []byte{4, 5, 6},
+			ExpiryTime:   time.Unix(40000, 0).UTC(),
+		},
+		{
+			Id:           1,
+			ConnectionId: "connection-1",
+			ChannelId:    "channel-1",
+			Sequence:     5,
+			Authority:    authtypes.NewModuleAddress("gov").String(),
+			MsgTypeUrls:  []string{"/cosmwasm.wasm.v1.MsgExecuteContract"},
+			Data:         []byte{1, 2, 3},
+			ExpiryTime:   time.Unix(30000, 0).UTC(),
+		},
+	},
+	FundedTransfers: []types.FundedTransfer{
+		{
+			ChannelId:           "channel-2",
+			Sequence:            7,
+			CommunityPoolAmount: sdk.NewCoin("umars", sdk.NewInt(12345)),
+		},
+	},
+	BalancesQueries: []types.BalancesQuery{
+		{
+			ChannelId:    "channel-0",
+			Sequence:     4,
+			ConnectionId: "connection-0",
+		},
+	},
+	AccountBalances: []types.AccountBalances{
+		{
+			ConnectionId: "connection-0",
+			Address:      "osmo1ica",
+			Balances:     sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(420))),
+			Delegations: []types.DelegationBalance{
+				{
+					ValidatorAddress: "osmovaloper1abc",
+					Balance:          sdk.NewCoin("uosmo", sdk.NewInt(69)),
+				},
+			},
+			Height: 12345,
+			Time:   time.Unix(30000, 0).UTC(),
+		},
+	},
+	LastBalancesQueryTime: time.Unix(25000, 0).UTC(),
+	HostParamsQueries: []types.HostParamsQuery{
+		{
+			ChannelId:    "channel-0",
+			Sequence:     5,
+			ConnectionId: "connection-0",
+		},
+	},
+	HostParams: []types.HostParams{
+		{
+			ConnectionId:  "connection-0",
+			HostEnabled:   true,
+			AllowMessages: []string{"/cosmwasm.wasm.v1.MsgExecuteContract"},
+			Height:        12345,
+			Time:          time.Unix(30000, 0).UTC(),
+		},
+	},
+	LastHostParamsQueryTime: time.Unix(25000, 0).UTC(),
+	AuthorityPolicies: []types.AuthorityPolicy{
+		{
+			Authority:          sender,
+			AllowedConnections: []string{"connection-0"},
+			AllowedMsgTypeUrls: []string{"/cosmwasm.wasm.v1.MsgExecuteContract"},
+			SpendLimit:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))),
+			EpochDuration:      24 * time.Hour,
+		},
+	},
+	AuthoritySpendings: []types.AuthoritySpending{
+		{
+			Authority:      sender,
+			EpochStartTime: time.Unix(20000, 0).UTC(),
+			Spent:          sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+		},
+	},
+	NextScheduledOperationId: 2,
+	ScheduledOperations: []types.ScheduledOperation{
+		{
+			Id: 1,
+			SendFunds: &types.MsgSendFunds{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				ChannelId: "channel-2",
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+			},
+			StartTime:      time.Unix(20000, 0).UTC(),
+			Interval:       24 * time.Hour,
+			Repetitions:    3,
+			Executions:     1,
+			ReservedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(200))),
+			SpentAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+		},
+	},
+	FundedFees: []types.FundedFee{
+		{
+			PortId:        "transfer",
+			ChannelId:     "channel-2",
+			Sequence:      7,
+			AckRefund:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2))),
+			TimeoutRefund: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(15))),
+		},
+	},
+}
 
 func setupGenesisTest() (ctx sdk.Context, app *marsapp.MarsApp) {
 	app = marsapptesting.MakeSimpleMockApp()
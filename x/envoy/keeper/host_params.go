@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// QueryHostParams sends an ICS-27 packet to each interchain account with an
+// open channel, querying the ICA host params of the host chain, if the
+// HostParamsQueryInterval param is non-zero and at least this long has passed
+// since the last queries.
+//
+// Accounts that already have a query in flight are skipped. A failure to send
+// the query to one account doesn't prevent the queries to the others.
+func (k Keeper) QueryHostParams(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.HostParamsQueryInterval == 0 {
+		return
+	}
+
+	if ctx.BlockTime().Before(k.GetLastHostParamsQueryTime(ctx).Add(params.HostParamsQueryInterval)) {
+		return
+	}
+
+	k.SetLastHostParamsQueryTime(ctx, ctx.BlockTime())
+
+	_, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the ICA controller port ID", "error", err)
+		return
+	}
+
+	inFlight := make(map[string]bool)
+	k.IterateHostParamsQueries(ctx, func(query types.HostParamsQuery) bool {
+		inFlight[query.ConnectionId] = true
+		return false
+	})
+
+	for _, activeChannel := range k.icaControllerKeeper.GetAllActiveChannels(ctx) {
+		if activeChannel.PortId != portID || inFlight[activeChannel.ConnectionId] {
+			continue
+		}
+
+		// a packet sent on a closed channel would fail anyway
+		if _, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, activeChannel.ConnectionId, portID); !found {
+			continue
+		}
+
+		// use a cached context, so that a failed query doesn't leave any state
+		// changes behind
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		if err := k.sendHostParamsQuery(cacheCtx, activeChannel.ConnectionId, portID, params); err != nil {
+			k.Logger(ctx).Error(
+				"failed to send interchain host params query",
+				"connectionID", activeChannel.ConnectionId,
+				"error", err,
+			)
+
+			continue
+		}
+
+		// this also emits the events, which the IBC relayer listens to
+		writeCache()
+	}
+}
+
+// sendHostParamsQuery sends an ICS-27 packet querying the ICA host params of
+// the chain on the other end of the given connection, and records it, so that
+// its acknowledgement can be parsed as a query response.
+func (k Keeper) sendHostParamsQuery(ctx sdk.Context, connectionID, portID string, params types.Params) error {
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.ErrNotFound.Wrapf("no interchain account exists on %s", connectionID)
+	}
+
+	paramsReq, err := k.cdc.Marshal(&icahosttypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	channelID, sequence, err := k.sendInterchainQuery(ctx, connectionID, address, []*types.QueryRequest{
+		{Path: types.QueryPathHostParams, Data: paramsReq},
+	}, params.MessagesTimeout)
+	if err != nil {
+		return err
+	}
+
+	k.SetHostParamsQuery(ctx, types.HostParamsQuery{
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		ConnectionId: connectionID,
+	})
+
+	k.Logger(ctx).Info(
+		"initiated interchain host params query",
+		"connectionID", connectionID,
+		"channelID", channelID,
+		"sequence", sequence,
+	)
+
+	return nil
+}
+
+// HandleHostParamsQueryAcknowledged parses the acknowledgement of a host params
+// query, saves the params, and deletes the query's record.
+//
+// Errors are logged instead of returned, as failing the acknowledgement would
+// leave the packet stuck and block the ordered ICA channel. The previously
+// known params are kept in this case.
+func (k Keeper) HandleHostParamsQueryAcknowledged(ctx sdk.Context, query types.HostParamsQuery, ack ibcchanneltypes.Acknowledgement) {
+	k.DeleteHostParamsQuery(ctx, query.ChannelId, query.Sequence)
+
+	logger := k.Logger(ctx)
+
+	if !ack.Success() {
+		logger.Info(
+			"interchain host params query acknowledged with error",
+			"connectionID", query.ConnectionId,
+			"error", ack.GetError(),
+		)
+
+		return
+	}
+
+	hostParams, err := k.parseHostParamsQueryResult(ctx, query.ConnectionId, ack.GetResult())
+	if err != nil {
+		logger.Error(
+			"failed to parse interchain host params query result",
+			"connectionID", query.ConnectionId,
+			"error", err,
+		)
+
+		return
+	}
+
+	k.SetHostParams(ctx, hostParams)
+
+	logger.Info(
+		"received interchain host params",
+		"connectionID", hostParams.ConnectionId,
+		"height", hostParams.Height,
+		"hostEnabled", hostParams.HostEnabled,
+		"numAllowMessages", len(hostParams.AllowMessages),
+	)
+}
+
+// HandleHostParamsQueryTimedOut deletes the record of a host params query that
+// has timed out. As the channel is closed by the timeout, the next query is
+// sent once the channel has been reopened.
+func (k Keeper) HandleHostParamsQueryTimedOut(ctx sdk.Context, query types.HostParamsQuery) {
+	k.DeleteHostParamsQuery(ctx, query.ChannelId, query.Sequence)
+
+	k.Logger(ctx).Info(
+		"interchain host params query timed out",
+		"connectionID", query.ConnectionId,
+	)
+}
+
+// parseHostParamsQueryResult decodes the result of a successful host params
+// query.
+func (k Keeper) parseHostParamsQueryResult(ctx sdk.Context, connectionID string, result []byte) (types.HostParams, error) {
+	queryRes, err := k.parseInterchainQueryResult(result, 1)
+	if err != nil {
+		return types.HostParams{}, err
+	}
+
+	var paramsRes icahosttypes.QueryParamsResponse
+	if err := k.cdc.Unmarshal(queryRes.Responses[0], &paramsRes); err != nil {
+		return types.HostParams{}, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal host Params response: %v", err)
+	}
+
+	if paramsRes.Params == nil {
+		return types.HostParams{}, sdkerrors.ErrUnknownRequest.Wrap("host Params response contains no params")
+	}
+
+	return types.HostParams{
+		ConnectionId:  connectionID,
+		HostEnabled:   paramsRes.Params.HostEnabled,
+		AllowMessages: paramsRes.Params.AllowMessages,
+		Height:        queryRes.Height,
+		Time:          ctx.BlockTime(),
+	}, nil
+}
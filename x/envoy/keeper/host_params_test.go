@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+var mockAllowMessages = []string{"/cosmos.gov.v1.MsgVote"}
+
+// sendHostParamsQuery enables the host params queries and runs the EndBlocker,
+// which sends a query to the ICA on outpost 1. It returns the sent packet. It
+// assumes an ICA has already been registered on outpost 1.
+func (suite *KeeperTestSuite) sendHostParamsQuery(ctx sdk.Context) ibcchanneltypes.Packet {
+	app := getMarsApp(suite.hub)
+
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.HostParamsQueryInterval = time.Hour
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	return suite.lastSentPacket(ctx)
+}
+
+// mockHostParamsQueryAck creates the acknowledgement that a host chain
+// supporting ICS-27 queries responds to a host params query with.
+func (suite *KeeperTestSuite) mockHostParamsQueryAck() ibcchanneltypes.Acknowledgement {
+	app := getMarsApp(suite.hub)
+
+	paramsRes, err := app.AppCodec().Marshal(&icahosttypes.QueryParamsResponse{
+		Params: &icahosttypes.Params{HostEnabled: true, AllowMessages: mockAllowMessages},
+	})
+	suite.Require().NoError(err)
+
+	queryRes, err := app.AppCodec().Marshal(&types.MsgModuleQuerySafeResponse{
+		Height:    12345,
+		Responses: [][]byte{paramsRes},
+	})
+	suite.Require().NoError(err)
+
+	result, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{{TypeUrl: types.TypeURLMsgModuleQuerySafeResponse, Value: queryRes}},
+	})
+	suite.Require().NoError(err)
+
+	return ibcchanneltypes.NewResultAcknowledgement(result)
+}
+
+func (suite *KeeperTestSuite) TestHostParamsQuerySent() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	packet := suite.sendHostParamsQuery(ctx)
+
+	query, found := app.EnvoyKeeper.GetHostParamsQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(connectionID, query.ConnectionId)
+
+	// the packet should contain a MsgModuleQuerySafe querying the host params
+	var packetData icatypes.InterchainAccountPacketData
+	err := icatypes.ModuleCdc.UnmarshalJSON(packet.Data, &packetData)
+	suite.Require().NoError(err)
+
+	var cosmosTx icatypes.CosmosTx
+	err = proto.Unmarshal(packetData.Data, &cosmosTx)
+	suite.Require().NoError(err)
+	suite.Require().Len(cosmosTx.Messages, 1)
+	suite.Require().Equal(types.TypeURLMsgModuleQuerySafe, cosmosTx.Messages[0].TypeUrl)
+
+	var msg types.MsgModuleQuerySafe
+	err = app.AppCodec().Unmarshal(cosmosTx.Messages[0].Value, &msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.interchainAccountAddress(ctx), msg.Signer)
+	suite.Require().Len(msg.Requests, 1)
+	suite.Require().Equal(types.QueryPathHostParams, msg.Requests[0].Path)
+
+	// no other query should be sent while the query is in flight
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+	suite.Require().Empty(suite.sentPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestHostParamsQueryAcknowledged() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	packet := suite.sendHostParamsQuery(ctx)
+
+	err := ibcModule.OnAcknowledgementPacket(ctx, packet, suite.mockHostParamsQueryAck().Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetHostParamsQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	hostParams, found := app.EnvoyKeeper.GetHostParams(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.HostParams{
+		ConnectionId:  connectionID,
+		HostEnabled:   true,
+		AllowMessages: mockAllowMessages,
+		Height:        12345,
+		Time:          ctx.BlockTime(),
+	}, hostParams)
+}
+
+func (suite *KeeperTestSuite) TestHostParamsQueryTimedOut() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendHostParamsQuery(ctx)
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	// the query's record should have been deleted, and the packet should not
+	// have been queued
+	_, found := app.EnvoyKeeper.GetHostParamsQuery(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetQueuedPacket(ctx, suite.path1.EndpointA.ConnectionID, 1)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetHostParams(ctx, suite.path1.EndpointA.ConnectionID)
+	suite.Require().False(found)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// RegisterInvariants registers the envoy module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "orphaned-refunds", OrphanedRefunds(k))
+}
+
+// OrphanedRefunds asserts that every funded transfer record corresponds to an
+// ICS-20 packet that is still in flight, and every funded relayer fee record
+// to a packet that is still in flight on its port.
+//
+// Once a packet is acknowledged or timed out, its commitment is deleted, and
+// its record should have been deleted as well, with the community pool part
+// refunded if the transfer failed, or the unused part of the fee drawn from
+// it. A record left behind means the community pool has not been refunded.
+func OrphanedRefunds(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		orphaned := []string{}
+		k.IterateFundedTransfers(ctx, func(transfer types.FundedTransfer) bool {
+			if !k.channelKeeper.HasPacketCommitment(ctx, ibctransfertypes.PortID, transfer.ChannelId, transfer.Sequence) {
+				orphaned = append(orphaned, fmt.Sprintf("\t%s/%d: %s", transfer.ChannelId, transfer.Sequence, transfer.CommunityPoolAmount))
+			}
+			return false
+		})
+
+		orphanedFees := []string{}
+		k.IterateFundedFees(ctx, func(fee types.FundedFee) bool {
+			if !k.channelKeeper.HasPacketCommitment(ctx, fee.PortId, fee.ChannelId, fee.Sequence) {
+				orphanedFees = append(orphanedFees, fmt.Sprintf("\t%s/%s/%d: ack %s, timeout %s", fee.PortId, fee.ChannelId, fee.Sequence, fee.AckRefund, fee.TimeoutRefund))
+			}
+			return false
+		})
+
+		broken := len(orphaned) > 0 || len(orphanedFees) > 0
+
+		msg := sdk.FormatInvariant(
+			types.ModuleName,
+			"orphaned-refunds",
+			fmt.Sprintf(
+				"found %d funded transfer(s) and %d funded fee(s) without packet commitment\n%s",
+				len(orphaned),
+				len(orphanedFees),
+				strings.Join(append(orphaned, orphanedFees...), "\n"),
+			),
+		)
+
+		return msg, broken
+	}
+}
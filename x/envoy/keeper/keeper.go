@@ -2,19 +2,24 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/keeper"
 	ibcchannelkeeper "github.com/cosmos/ibc-go/v6/modules/core/04-channel/keeper"
 
 	"github.com/mars-protocol/hub/v2/x/envoy/types"
@@ -22,14 +27,21 @@ import (
 
 // Keeper is the envoy module's keeper.
 type Keeper struct {
-	cdc codec.Codec
+	cdc      codec.Codec
+	storeKey storetypes.StoreKey
 
 	accountKeeper       authkeeper.AccountKeeper
 	bankKeeper          bankkeeper.Keeper
 	distrKeeper         distrkeeper.Keeper
 	channelKeeper       ibcchannelkeeper.Keeper
+	feeKeeper           ibcfeekeeper.Keeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
 
+	// The ICA controller module's scoped capability keeper.
+	// The controller module owns the capabilities of the interchain accounts'
+	// channels. We use this to authenticate when closing a channel.
+	icaControllerScopedKeeper capabilitykeeper.ScopedKeeper
+
 	// The baseapp's message service router.
 	// We use this to dispatch messages upon successful governance proposals.
 	router *baseapp.MsgServiceRouter
@@ -41,9 +53,11 @@ type Keeper struct {
 
 // NewKeeper creates a new envoy module keeper.
 func NewKeeper(
-	cdc codec.Codec, accountKeeper authkeeper.AccountKeeper,
+	cdc codec.Codec, storeKey storetypes.StoreKey, accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper, distrKeeper distrkeeper.Keeper,
-	channelKeeper ibcchannelkeeper.Keeper, icaControllerKeeper icacontrollerkeeper.Keeper,
+	channelKeeper ibcchannelkeeper.Keeper, feeKeeper ibcfeekeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	icaControllerScopedKeeper capabilitykeeper.ScopedKeeper,
 	router *baseapp.MsgServiceRouter, authorities []string,
 ) Keeper {
 	// ensure envoy module account is set
@@ -59,14 +73,17 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:                 cdc,
-		accountKeeper:       accountKeeper,
-		bankKeeper:          bankKeeper,
-		distrKeeper:         distrKeeper,
-		channelKeeper:       channelKeeper,
-		icaControllerKeeper: icaControllerKeeper,
-		router:              router,
-		authorities:         authorities,
+		cdc:                       cdc,
+		storeKey:                  storeKey,
+		accountKeeper:             accountKeeper,
+		bankKeeper:                bankKeeper,
+		distrKeeper:               distrKeeper,
+		channelKeeper:             channelKeeper,
+		feeKeeper:                 feeKeeper,
+		icaControllerKeeper:       icaControllerKeeper,
+		icaControllerScopedKeeper: icaControllerScopedKeeper,
+		router:                    router,
+		authorities:               authorities,
 	}
 }
 
@@ -94,3 +111,771 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 	handler := k.router.Handler(msg)
 	return handler(ctx, msg)
 }
+
+//------------------------------------------------------------------------------
+// Params
+//------------------------------------------------------------------------------
+
+// GetParams loads the module's parameters.
+//
+// NOTE: the params should have been initialized in genesis or during the
+// migration, so them being undefined is a fatal error. we have the module panic
+// in this case, instead of returning an error.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		panic("stored envoy module params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams saves the provided params to store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
+//------------------------------------------------------------------------------
+// Packet
+//------------------------------------------------------------------------------
+
+// GetPacket loads the record of the ICS-27 packet of the given channel id and
+// sequence.
+func (k Keeper) GetPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.Packet, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPacketKey(channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// SetPacket saves the provided packet record to store.
+func (k Keeper) SetPacket(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// IteratePackets iterates over all packet records, calling the callback
+// function with the packet info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IteratePackets(ctx sdk.Context, cb func(types.Packet) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPacket)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if cb(packet) {
+			break
+		}
+	}
+}
+
+// GetPacketPrefixStore returns a prefix store of all packet records.
+func (k Keeper) GetPacketPrefixStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.KeyPacket)
+}
+
+//------------------------------------------------------------------------------
+// Recovery
+//------------------------------------------------------------------------------
+
+// GetRecovery loads the recovery record of the given connection id.
+func (k Keeper) GetRecovery(ctx sdk.Context, connectionID string) (recovery types.Recovery, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRecoveryKey(connectionID))
+	if bz == nil {
+		return recovery, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &recovery)
+
+	return recovery, true
+}
+
+// SetRecovery saves the provided recovery record to store.
+func (k Keeper) SetRecovery(ctx sdk.Context, recovery types.Recovery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecoveryKey(recovery.ConnectionId), k.cdc.MustMarshal(&recovery))
+}
+
+// DeleteRecovery removes the recovery record of the given connection id.
+func (k Keeper) DeleteRecovery(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRecoveryKey(connectionID))
+}
+
+// IterateRecoveries iterates over all recovery records, calling the callback
+// function with the recovery info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateRecoveries(ctx sdk.Context, cb func(types.Recovery) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRecovery)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var recovery types.Recovery
+		k.cdc.MustUnmarshal(iterator.Value(), &recovery)
+
+		if cb(recovery) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// NextQueuedPacketID
+//------------------------------------------------------------------------------
+
+// GetNextQueuedPacketID loads the next queued packet id if a timed out packet
+// is to be queued.
+//
+// NOTE: the id should have been initialized in genesis or during the migration,
+// so it being undefined is a fatal error. we have the module panic in this
+// case, instead of returning an error.
+func (k Keeper) GetNextQueuedPacketID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextQueuedPacketID)
+	if bz == nil {
+		panic("stored next queued packet id should not have been nil")
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextQueuedPacketID sets the next queued packet id to the provided value.
+func (k Keeper) SetNextQueuedPacketID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextQueuedPacketID, sdk.Uint64ToBigEndian(id))
+}
+
+// IncrementNextQueuedPacketID increases the next id by one, and returns the
+// previous value.
+func (k Keeper) IncrementNextQueuedPacketID(ctx sdk.Context) uint64 {
+	id := k.GetNextQueuedPacketID(ctx)
+
+	k.SetNextQueuedPacketID(ctx, id+1)
+
+	return id
+}
+
+//------------------------------------------------------------------------------
+// QueuedPacket
+//------------------------------------------------------------------------------
+
+// GetQueuedPacket loads the queued packet of the given connection id and id.
+func (k Keeper) GetQueuedPacket(ctx sdk.Context, connectionID string, id uint64) (queuedPacket types.QueuedPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetQueuedPacketKey(connectionID, id))
+	if bz == nil {
+		return queuedPacket, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &queuedPacket)
+
+	return queuedPacket, true
+}
+
+// SetQueuedPacket saves the provided queued packet to store.
+func (k Keeper) SetQueuedPacket(ctx sdk.Context, queuedPacket types.QueuedPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedPacketKey(queuedPacket.ConnectionId, queuedPacket.Id), k.cdc.MustMarshal(&queuedPacket))
+}
+
+// DeleteQueuedPacket removes the queued packet of the given connection id and
+// id.
+func (k Keeper) DeleteQueuedPacket(ctx sdk.Context, connectionID string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedPacketKey(connectionID, id))
+}
+
+// IterateQueuedPackets iterates over all queued packets, calling the callback
+// function with the packet info. If the connection id is not empty, only the
+// packets of that connection are iterated, in the order they were queued.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateQueuedPackets(ctx sdk.Context, connectionID string, cb func(types.QueuedPacket) bool) {
+	store := k.GetQueuedPacketPrefixStore(ctx, connectionID)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedPacket types.QueuedPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedPacket)
+
+		if cb(queuedPacket) {
+			break
+		}
+	}
+}
+
+// GetQueuedPacketPrefixStore returns a prefix store of the queued packets. If
+// the connection id is not empty, only the packets of that connection are
+// included.
+func (k Keeper) GetQueuedPacketPrefixStore(ctx sdk.Context, connectionID string) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+
+	if connectionID == "" {
+		return prefix.NewStore(store, types.KeyQueuedPacket)
+	}
+
+	return prefix.NewStore(store, types.GetQueuedPacketPrefix(connectionID))
+}
+
+//------------------------------------------------------------------------------
+// FundedTransfer
+//------------------------------------------------------------------------------
+
+// GetFundedTransfer loads the funded transfer record of the given channel id and
+// sequence.
+func (k Keeper) GetFundedTransfer(ctx sdk.Context, channelID string, sequence uint64) (transfer types.FundedTransfer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFundedTransferKey(channelID, sequence))
+	if bz == nil {
+		return transfer, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &transfer)
+
+	return transfer, true
+}
+
+// SetFundedTransfer saves the provided funded transfer record to store.
+func (k Keeper) SetFundedTransfer(ctx sdk.Context, transfer types.FundedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFundedTransferKey(transfer.ChannelId, transfer.Sequence), k.cdc.MustMarshal(&transfer))
+}
+
+// DeleteFundedTransfer removes the funded transfer record of the given channel
+// id and sequence.
+func (k Keeper) DeleteFundedTransfer(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundedTransferKey(channelID, sequence))
+}
+
+// IterateFundedTransfers iterates over all funded transfer records, calling the
+// callback function with the transfer info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateFundedTransfers(ctx sdk.Context, cb func(types.FundedTransfer) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFundedTransfer)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.FundedTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+
+		if cb(transfer) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// FundedFee
+//------------------------------------------------------------------------------
+
+// GetFundedFee loads the funded relayer fee record of the given channel id and
+// sequence.
+func (k Keeper) GetFundedFee(ctx sdk.Context, channelID string, sequence uint64) (fee types.FundedFee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFundedFeeKey(channelID, sequence))
+	if bz == nil {
+		return fee, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &fee)
+
+	return fee, true
+}
+
+// SetFundedFee saves the provided funded relayer fee record to store.
+func (k Keeper) SetFundedFee(ctx sdk.Context, fee types.FundedFee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFundedFeeKey(fee.ChannelId, fee.Sequence), k.cdc.MustMarshal(&fee))
+}
+
+// DeleteFundedFee removes the funded relayer fee record of the given channel
+// id and sequence.
+func (k Keeper) DeleteFundedFee(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundedFeeKey(channelID, sequence))
+}
+
+// IterateFundedFees iterates over all funded relayer fee records, calling the
+// callback function with the fee info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateFundedFees(ctx sdk.Context, cb func(types.FundedFee) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFundedFee)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.FundedFee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// BalancesQuery
+//------------------------------------------------------------------------------
+
+// GetBalancesQuery loads the balances query record of the given channel id and
+// sequence.
+func (k Keeper) GetBalancesQuery(ctx sdk.Context, channelID string, sequence uint64) (query types.BalancesQuery, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBalancesQueryKey(channelID, sequence))
+	if bz == nil {
+		return query, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &query)
+
+	return query, true
+}
+
+// SetBalancesQuery saves the provided balances query record to store.
+func (k Keeper) SetBalancesQuery(ctx sdk.Context, query types.BalancesQuery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBalancesQueryKey(query.ChannelId, query.Sequence), k.cdc.MustMarshal(&query))
+}
+
+// DeleteBalancesQuery removes the balances query record of the given channel id
+// and sequence.
+func (k Keeper) DeleteBalancesQuery(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBalancesQueryKey(channelID, sequence))
+}
+
+// IterateBalancesQueries iterates over all balances query records, calling the
+// callback function with the query info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateBalancesQueries(ctx sdk.Context, cb func(types.BalancesQuery) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyBalancesQuery)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var query types.BalancesQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+
+		if cb(query) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// AccountBalances
+//------------------------------------------------------------------------------
+
+// GetAccountBalances loads the latest known balances of the interchain account
+// on the given connection.
+func (k Keeper) GetAccountBalances(ctx sdk.Context, connectionID string) (accountBalances types.AccountBalances, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAccountBalancesKey(connectionID))
+	if bz == nil {
+		return accountBalances, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &accountBalances)
+
+	return accountBalances, true
+}
+
+// SetAccountBalances saves the provided account balances to store.
+func (k Keeper) SetAccountBalances(ctx sdk.Context, accountBalances types.AccountBalances) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAccountBalancesKey(accountBalances.ConnectionId), k.cdc.MustMarshal(&accountBalances))
+}
+
+// IterateAccountBalances iterates over the balances of all interchain accounts,
+// calling the callback function with the balances info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateAccountBalances(ctx sdk.Context, cb func(types.AccountBalances) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAccountBalances)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var accountBalances types.AccountBalances
+		k.cdc.MustUnmarshal(iterator.Value(), &accountBalances)
+
+		if cb(accountBalances) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// LastBalancesQueryTime
+//------------------------------------------------------------------------------
+
+// GetLastBalancesQueryTime loads the block time at which the balances of the
+// interchain accounts were last queried. If they have never been queried,
+// returns the zero time, so that the first queries are sent right away.
+func (k Keeper) GetLastBalancesQueryTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyLastBalancesQueryTime)
+	if bz == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse stored last balances query time: %s", err))
+	}
+
+	return t
+}
+
+// SetLastBalancesQueryTime sets the block time at which the balances of the
+// interchain accounts were last queried.
+func (k Keeper) SetLastBalancesQueryTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastBalancesQueryTime, sdk.FormatTimeBytes(t))
+}
+
+//------------------------------------------------------------------------------
+// HostParamsQuery
+//------------------------------------------------------------------------------
+
+// GetHostParamsQuery loads the host params query record of the given channel
+// id and sequence.
+func (k Keeper) GetHostParamsQuery(ctx sdk.Context, channelID string, sequence uint64) (query types.HostParamsQuery, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetHostParamsQueryKey(channelID, sequence))
+	if bz == nil {
+		return query, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &query)
+
+	return query, true
+}
+
+// SetHostParamsQuery saves the provided host params query record to store.
+func (k Keeper) SetHostParamsQuery(ctx sdk.Context, query types.HostParamsQuery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostParamsQueryKey(query.ChannelId, query.Sequence), k.cdc.MustMarshal(&query))
+}
+
+// DeleteHostParamsQuery removes the host params query record of the given
+// channel id and sequence.
+func (k Keeper) DeleteHostParamsQuery(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHostParamsQueryKey(channelID, sequence))
+}
+
+// IterateHostParamsQueries iterates over all host params query records,
+// calling the callback function with the query info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateHostParamsQueries(ctx sdk.Context, cb func(types.HostParamsQuery) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyHostParamsQuery)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var query types.HostParamsQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+
+		if cb(query) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// HostParams
+//------------------------------------------------------------------------------
+
+// GetHostParams loads the latest known ICA host params of the chain on the
+// other end of the given connection.
+func (k Keeper) GetHostParams(ctx sdk.Context, connectionID string) (hostParams types.HostParams, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetHostParamsKey(connectionID))
+	if bz == nil {
+		return hostParams, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &hostParams)
+
+	return hostParams, true
+}
+
+// SetHostParams saves the provided host params to store.
+func (k Keeper) SetHostParams(ctx sdk.Context, hostParams types.HostParams) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostParamsKey(hostParams.ConnectionId), k.cdc.MustMarshal(&hostParams))
+}
+
+// IterateHostParams iterates over the host params of all connections, calling
+// the callback function with the params info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateHostParams(ctx sdk.Context, cb func(types.HostParams) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyHostParams)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var hostParams types.HostParams
+		k.cdc.MustUnmarshal(iterator.Value(), &hostParams)
+
+		if cb(hostParams) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// LastHostParamsQueryTime
+//------------------------------------------------------------------------------
+
+// GetLastHostParamsQueryTime loads the block time at which the ICA host params
+// were last queried. If they have never been queried, returns the zero time,
+// so that the first queries are sent right away.
+func (k Keeper) GetLastHostParamsQueryTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyLastHostParamsQueryTime)
+	if bz == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse stored last host params query time: %s", err))
+	}
+
+	return t
+}
+
+// SetLastHostParamsQueryTime sets the block time at which the ICA host params
+// were last queried.
+func (k Keeper) SetLastHostParamsQueryTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastHostParamsQueryTime, sdk.FormatTimeBytes(t))
+}
+
+//------------------------------------------------------------------------------
+// AuthorityPolicy
+//------------------------------------------------------------------------------
+
+// GetAuthorityPolicy loads the policy of the given authority.
+func (k Keeper) GetAuthorityPolicy(ctx sdk.Context, authority string) (policy types.AuthorityPolicy, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuthorityPolicyKey(authority))
+	if bz == nil {
+		return policy, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+
+	return policy, true
+}
+
+// SetAuthorityPolicy saves the provided authority policy to store.
+func (k Keeper) SetAuthorityPolicy(ctx sdk.Context, policy types.AuthorityPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuthorityPolicyKey(policy.Authority), k.cdc.MustMarshal(&policy))
+}
+
+// DeleteAuthorityPolicy removes the policy of the given authority.
+func (k Keeper) DeleteAuthorityPolicy(ctx sdk.Context, authority string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAuthorityPolicyKey(authority))
+}
+
+// GetAuthorityPolicyPrefixStore returns a prefix store of all authority
+// policies.
+func (k Keeper) GetAuthorityPolicyPrefixStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.KeyAuthorityPolicy)
+}
+
+// IterateAuthorityPolicies iterates over all authority policies, calling the
+// callback function with the policy info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateAuthorityPolicies(ctx sdk.Context, cb func(types.AuthorityPolicy) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAuthorityPolicy)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.AuthorityPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// AuthoritySpending
+//------------------------------------------------------------------------------
+
+// GetAuthoritySpending loads the spending of the given authority in its
+// current epoch.
+func (k Keeper) GetAuthoritySpending(ctx sdk.Context, authority string) (spending types.AuthoritySpending, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuthoritySpendingKey(authority))
+	if bz == nil {
+		return spending, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &spending)
+
+	return spending, true
+}
+
+// SetAuthoritySpending saves the provided authority spending to store.
+func (k Keeper) SetAuthoritySpending(ctx sdk.Context, spending types.AuthoritySpending) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuthoritySpendingKey(spending.Authority), k.cdc.MustMarshal(&spending))
+}
+
+// DeleteAuthoritySpending removes the spending of the given authority.
+func (k Keeper) DeleteAuthoritySpending(ctx sdk.Context, authority string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAuthoritySpendingKey(authority))
+}
+
+// IterateAuthoritySpendings iterates over the spendings of all authorities,
+// calling the callback function with the spending info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateAuthoritySpendings(ctx sdk.Context, cb func(types.AuthoritySpending) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAuthoritySpending)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var spending types.AuthoritySpending
+		k.cdc.MustUnmarshal(iterator.Value(), &spending)
+
+		if cb(spending) {
+			break
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+// NextScheduledOperationID
+//------------------------------------------------------------------------------
+
+// GetNextScheduledOperationID loads the next scheduled operation id if an
+// operation is to be scheduled.
+//
+// NOTE: the id should have been initialized in genesis or during the migration,
+// so it being undefined is a fatal error. we have the module panic in this
+// case, instead of returning an error.
+func (k Keeper) GetNextScheduledOperationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextScheduledOperationID)
+	if bz == nil {
+		panic("stored next scheduled operation id should not have been nil")
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledOperationID sets the next scheduled operation id to the
+// provided value.
+func (k Keeper) SetNextScheduledOperationID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextScheduledOperationID, sdk.Uint64ToBigEndian(id))
+}
+
+// IncrementNextScheduledOperationID increases the next id by one, and returns
+// the previous value.
+func (k Keeper) IncrementNextScheduledOperationID(ctx sdk.Context) uint64 {
+	id := k.GetNextScheduledOperationID(ctx)
+
+	k.SetNextScheduledOperationID(ctx, id+1)
+
+	return id
+}
+
+//------------------------------------------------------------------------------
+// ScheduledOperation
+//------------------------------------------------------------------------------
+
+// GetScheduledOperation loads the scheduled operation of the given id.
+func (k Keeper) GetScheduledOperation(ctx sdk.Context, id uint64) (op types.ScheduledOperation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetScheduledOperationKey(id))
+	if bz == nil {
+		return op, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &op)
+
+	return op, true
+}
+
+// SetScheduledOperation saves the provided scheduled operation to store.
+func (k Keeper) SetScheduledOperation(ctx sdk.Context, op types.ScheduledOperation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledOperationKey(op.Id), k.cdc.MustMarshal(&op))
+}
+
+// DeleteScheduledOperation removes the scheduled operation of the given id.
+func (k Keeper) DeleteScheduledOperation(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledOperationKey(id))
+}
+
+// GetScheduledOperationPrefixStore returns a prefix store of the scheduled
+// operations.
+func (k Keeper) GetScheduledOperationPrefixStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.KeyScheduledOperation)
+}
+
+// IterateScheduledOperations iterates over all scheduled operations in the
+// order of their ids, calling the callback function with the operation info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateScheduledOperations(ctx sdk.Context, cb func(types.ScheduledOperation) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyScheduledOperation)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var op types.ScheduledOperation
+		k.cdc.MustUnmarshal(iterator.Value(), &op)
+
+		if cb(op) {
+			break
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
@@ -118,7 +119,11 @@ func registerInterchainAccount(path *ibctesting.Path, owner string) {
 
 	channelSequence := controllerApp.IBCKeeper.ChannelKeeper.GetNextChannelSequence(controllerCtx)
 
-	if err := controllerApp.ICAControllerKeeper.RegisterInterchainAccount(controllerCtx, path.EndpointA.ConnectionID, owner, ""); err != nil {
+	// explicitly use the default version, as the fee middleware would otherwise
+	// default to a fee-enabled one
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+
+	if err := controllerApp.ICAControllerKeeper.RegisterInterchainAccount(controllerCtx, path.EndpointA.ConnectionID, owner, version); err != nil {
 		panic(err)
 	}
 
@@ -165,6 +170,31 @@ func newTransferPath(hub, outpost *ibctesting.TestChain) *ibctesting.Path {
 	return path
 }
 
+// newFeeTransferPath creates a fee-enabled transfer channel on the same
+// connection as the given path.
+func (suite *KeeperTestSuite) newFeeTransferPath(path *ibctesting.Path) *ibctesting.Path {
+	feePath := newTransferPath(path.EndpointA.Chain, path.EndpointB.Chain)
+
+	feePath.EndpointA.ClientID = path.EndpointA.ClientID
+	feePath.EndpointB.ClientID = path.EndpointB.ClientID
+	feePath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	feePath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+	feePath.EndpointA.ChannelConfig.Version = feeEnabledVersion(ibctransfertypes.Version)
+	feePath.EndpointB.ChannelConfig.Version = feeEnabledVersion(ibctransfertypes.Version)
+
+	suite.coordinator.CreateChannels(feePath)
+
+	return feePath
+}
+
+// feeEnabledVersion wraps the given app version with the fee middleware's.
+func feeEnabledVersion(appVersion string) string {
+	return string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: appVersion,
+	}))
+}
+
 func updateToICAPath(path *ibctesting.Path, owner string) *ibctesting.Path {
 	controllerPortID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
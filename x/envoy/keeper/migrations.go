@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct{ k Keeper }
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k}
+}
+
+// Migrate1to2 migrates the envoy module's store from consensus version 1 to 2.
+//
+// In version 1 the module didn't have any parameters; the packet timeout was a
+// hardcoded constant of 15 minutes. Here we initialize the params to the
+// default values, which use the same timeout.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// Migrate2to3 migrates the envoy module's store from consensus version 2 to 3.
+//
+// Version 3 introduces automatic recovery of interchain accounts whose channels
+// have been closed. Here we initialize the recovery params to the default
+// values.
+//
+// Additionally, the envoy module now relies on the ICA controller middleware to
+// invoke its IBC callbacks. Previously, accounts were registered by dispatching
+// a MsgRegisterInterchainAccount, which disables the middleware. We enable it
+// for all existing accounts.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+
+	params := m.k.GetParams(ctx)
+	params.RecoveryMaxAttempts = defaultParams.RecoveryMaxAttempts
+	params.RecoveryBackoff = defaultParams.RecoveryBackoff
+	m.k.SetParams(ctx, params)
+
+	_, portID, err := m.k.GetOwnerAndPortID()
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range m.k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID {
+			m.k.icaControllerKeeper.SetMiddlewareEnabled(ctx, portID, channel.ConnectionHops[0])
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates the envoy module's store from consensus version 3 to 4.
+//
+// Version 4 keeps the payloads of timed out ICS-27 packets so that they can be
+// resent. Here we initialize the queue expiry param to the default value, and
+// the next queued packet id to 1.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	params.QueueExpiry = types.DefaultParams().QueueExpiry
+	m.k.SetParams(ctx, params)
+
+	m.k.SetNextQueuedPacketID(ctx, 1)
+
+	return nil
+}
+
+// Migrate4to5 migrates the envoy module's store from consensus version 4 to 5.
+//
+// Version 5 introduces scheduled operations. Here we initialize the next
+// scheduled operation id to 1.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.k.SetNextScheduledOperationID(ctx, 1)
+	return nil
+}
+
+// Migrate5to6 migrates the envoy module's store from consensus version 5 to 6.
+//
+// Version 6 periodically queries the balances of interchain accounts. Here we
+// initialize the balances query interval param to the default value.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	params.BalancesQueryInterval = types.DefaultParams().BalancesQueryInterval
+	m.k.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate6to7 migrates the envoy module's store from consensus version 6 to 7.
+//
+// Version 7 periodically queries the ICA host params of the chains the
+// interchain accounts are on. Here we initialize the host params query
+// interval param to the default value.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	params.HostParamsQueryInterval = types.DefaultParams().HostParamsQueryInterval
+	m.k.SetParams(ctx, params)
+
+	return nil
+}
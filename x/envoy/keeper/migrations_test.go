@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	marsapptesting "github.com/mars-protocol/hub/v2/app/testing"
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	app.EnvoyKeeper.SetParams(ctx, types.Params{
+		TransferTimeout: time.Minute,
+		MessagesTimeout: time.Minute,
+	})
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), app.EnvoyKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	// in consensus version 2, accounts were registered with the middleware
+	// disabled, and there were no recovery params
+	app.ICAControllerKeeper.SetMiddlewareDisabled(ctx, portID, connectionID)
+	app.EnvoyKeeper.SetParams(ctx, types.Params{
+		TransferTimeout: time.Hour,
+		MessagesTimeout: time.Hour,
+	})
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(app.ICAControllerKeeper.IsMiddlewareEnabled(ctx, portID, connectionID))
+	suite.Require().Equal(types.Params{
+		TransferTimeout:     time.Hour,
+		MessagesTimeout:     time.Hour,
+		RecoveryMaxAttempts: types.DefaultParams().RecoveryMaxAttempts,
+		RecoveryBackoff:     types.DefaultParams().RecoveryBackoff,
+	}, app.EnvoyKeeper.GetParams(ctx))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// in consensus version 3 there was no queue expiry
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.QueueExpiry = 0
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate3to4(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().QueueExpiry, app.EnvoyKeeper.GetParams(ctx).QueueExpiry)
+	require.Equal(t, uint64(1), app.EnvoyKeeper.GetNextQueuedPacketID(ctx))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate4to5(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), app.EnvoyKeeper.GetNextScheduledOperationID(ctx))
+}
+
+func TestMigrate5to6(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// in consensus version 5 there was no balances query interval, so the
+	// param is set to the default value whatever the store holds
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.BalancesQueryInterval = time.Hour
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate5to6(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().BalancesQueryInterval, app.EnvoyKeeper.GetParams(ctx).BalancesQueryInterval)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// in consensus version 6 there was no host params query interval, so the
+	// param is set to the default value whatever the store holds
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.HostParamsQueryInterval = time.Hour
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	err := keeper.NewMigrator(app.EnvoyKeeper).Migrate6to7(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().HostParamsQueryInterval, app.EnvoyKeeper.GetParams(ctx).HostParamsQueryInterval)
+}
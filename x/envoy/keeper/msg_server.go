@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -10,25 +13,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 
-	marsutils "github.com/mars-protocol/hub/v2/utils"
 	"github.com/mars-protocol/hub/v2/x/envoy/types"
 )
 
-const (
-	// timeoutTime is the timeout time for packets.
-	//
-	// Currently we set this as a constant of 5 minutes. Should be make this a
-	// configurable parameter? Or a part of the proposal?
-	timeoutTime = 15 * time.Minute
-
-	// memo is the memo string to be attached to packets.
-	memo = "🛰️ INCOMING TRANSMISSION FROM MARS HUB"
-)
+// memo is the memo string to be attached to packets.
+const memo = "🛰️ INCOMING TRANSMISSION FROM MARS HUB"
 
 type msgServer struct{ k Keeper }
 
@@ -56,6 +52,19 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 // this for us:
 // https://github.com/cosmos/ibc-go/blob/v6.1.0/modules/apps/27-interchain-accounts/controller/keeper/account.go#L52-L56
 //
+// We call the controller keeper's RegisterInterchainAccount method instead of
+// dispatching a MsgRegisterInterchainAccount. The msg server disables the
+// controller middleware for the account, meaning the envoy module's IBC
+// callbacks (e.g. OnTimeoutPacket) would never be invoked.
+//
+// If a channel is closed due to a packet timeout, the module also attempts to
+// reopen it automatically in the EndBlocker. This message is still needed if
+// the automatic recovery has given up.
+//
+// The channel is opened with the default version generated by the ICA
+// controller module. To choose the version, e.g. to add or remove the ICS-29
+// fee middleware wrapper, governance can use RegisterAccountWithVersion.
+//
 // ## IMPORTANT NOTE
 //
 // In order versions of ibc-go there is a bug with the ICA host module that
@@ -91,19 +100,26 @@ func (ms msgServer) RegisterAccount(goCtx context.Context, req *types.MsgRegiste
 	// the interchain account is to be owned by the envoy module account
 	owner := ms.k.GetModuleAddress()
 
-	// build and execute the register interchain account message
-	//
-	// use an empty string as version here. the controller module will generate
-	// a default version string for us
-	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(req.ConnectionId, owner.String(), "")
-	res, err := ms.k.executeMsg(ctx, msg)
+	_, portID, err := ms.k.GetOwnerAndPortID()
 	if err != nil {
 		return nil, err
 	}
 
-	// IMPORTANT: emit the events!
-	// the IBC relayer listens to these events
-	ctx.EventManager().EmitEvents(res.GetEvents())
+	// we can't use an empty string as version here, as the fee middleware
+	// would then default to a fee-enabled version, which not all host chains
+	// support. see getAccountChannelVersion for details
+	version, err := ms.k.getAccountChannelVersion(ctx, req.ConnectionId, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	// register the interchain account
+	//
+	// the controller keeper emits the events, which the IBC relayer listens to,
+	// to our context
+	if err := ms.k.icaControllerKeeper.RegisterInterchainAccount(ctx, req.ConnectionId, owner.String(), version); err != nil {
+		return nil, err
+	}
 
 	// TODO: currently this gets printed to CLI even during tx simulations.
 	// how can we make it only appear during actual deliverTx?
@@ -118,10 +134,6 @@ func (ms msgServer) RegisterAccount(goCtx context.Context, req *types.MsgRegiste
 func (ms msgServer) SendFunds(goCtx context.Context, req *types.MsgSendFunds) (*types.MsgSendFundsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !marsutils.Contains(ms.k.authorities, req.Authority) {
-		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
-	}
-
 	owner, portID, err := ms.k.GetOwnerAndPortID()
 	if err != nil {
 		return nil, err
@@ -137,36 +149,67 @@ func (ms msgServer) SendFunds(goCtx context.Context, req *types.MsgSendFunds) (*
 
 	// the transfer channel must only have one hop
 	//
-	// we do not need to support multihop channels, as Mars Hub will establish
-	// direct connections with all its outpost chains.
+	// we do not need to support multihop channels. outposts that Mars Hub
+	// doesn't have a direct connection with are reached by forwarding the funds
+	// through intermediate chains using packet-forward-middleware instead.
 	if len(channel.ConnectionHops) > 1 {
 		return nil, types.ErrMultihopUnsupported.Wrapf("%s has more than one connection hops", req.ChannelId)
 	}
 
 	// find the interchain account address associated with the connection
+	//
+	// if a forwarding route is provided, the transfer channel leads to an
+	// intermediate chain, so the connection is specified in the message instead.
+	// as nothing on-chain ties the route's destination to that connection, only
+	// the module's authorities may provide a route; the policies of other
+	// authorities are checked against the connection of the transfer channel.
 	connectionID := channel.ConnectionHops[0]
+	if len(req.Route) > 0 {
+		if !ms.k.isAuthority(req.Authority) {
+			return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send funds through a forwarding route", req.Authority)
+		}
+
+		connectionID = req.ConnectionId
+	}
+
+	// authorities other than the module's are limited by their policies
+	//
+	// relayer fees count towards the spend limit, as if they were sent
+	spent := req.Amount.Add(types.TotalRelayerFees(req.Fee, len(req.Amount))...)
+	if err = ms.k.authorizeSendFunds(ctx, req.Authority, connectionID, spent); err != nil {
+		return nil, err
+	}
+
 	address, found := ms.k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return nil, sdkerrors.ErrNotFound.Wrapf("no interchain account exists on %s", connectionID)
 	}
 
-	// find token balances of the envoy module account
-	balance := ms.k.bankKeeper.GetAllBalances(ctx, owner)
-
-	// if the proposal requires sending more coins than what the module acocunt
-	// holds, then draw the difference from the community pool
-	shortfall := marsutils.SaturateSub(req.Amount, balance)
-	if !shortfall.Empty() {
-		if err = ms.k.distrKeeper.DistributeFromFeePool(ctx, shortfall, owner); err != nil {
+	// if a forwarding route is provided, the packets are sent to the receiver
+	// on the first intermediate chain, with a memo instructing it to forward the
+	// funds the rest of the way to the interchain account
+	receiver, packetMemo := address, memo
+	if len(req.Route) > 0 {
+		receiver = req.Route[0].Receiver
+		if packetMemo, err = types.ForwardMemo(req.Route, address); err != nil {
 			return nil, err
 		}
 	}
 
+	// if the proposal requires sending more coins than what the module acocunt
+	// holds, then draw the difference from the community pool. only governance
+	// may do so; other authorities are limited to the module account's balance
+	shortfall, err := ms.k.drawShortfall(ctx, req.Authority, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	// set timeout parameters
 	// we use the timestamp and not the height.
 	// note that the timeoutTimestamp in MsgTransfer is in nanoseconds
+	timeout := timeoutOrDefault(req.Timeout, ms.k.GetParams(ctx).TransferTimeout)
 	timeoutHeight := ibcclienttypes.Height{}
-	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeoutTime).UnixNano())
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
 
 	// send the funds via ICS-20
 	//
@@ -175,16 +218,17 @@ func (ms msgServer) SendFunds(goCtx context.Context, req *types.MsgSendFunds) (*
 	//
 	// the ibctransferkeeper has a sendTransfer method but it's not public.
 	// therefore we need to send a MsgTransfer to the baseapp msgRouter.
+	sequences := []uint64{}
 	for _, coin := range req.Amount {
 		msg := ibctransfertypes.NewMsgTransfer(
 			ibctransfertypes.PortID,
 			req.ChannelId,
 			coin,
 			owner.String(),
-			address,
+			receiver,
 			timeoutHeight,
 			timeoutTimestamp,
-			memo,
+			packetMemo,
 		)
 
 		res, err := ms.k.executeMsg(ctx, msg)
@@ -195,65 +239,578 @@ func (ms msgServer) SendFunds(goCtx context.Context, req *types.MsgSendFunds) (*
 		// IMPORTANT: emit the events!
 		// the IBC relayer listens to these events
 		ctx.EventManager().EmitEvents(res.GetEvents())
+
+		var transferRes ibctransfertypes.MsgTransferResponse
+		if err = ms.k.cdc.Unmarshal(res.Data, &transferRes); err != nil {
+			return nil, err
+		}
+
+		sequences = append(sequences, transferRes.Sequence)
+
+		// if part of this coin was drawn from the community pool, record it, so
+		// that it can be returned to the community pool if the transfer fails
+		//
+		// the envoy module's own balance is used first, so the community pool
+		// part of each coin is the shortfall, capped at the coin's amount
+		fromPool := sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, shortfall.AmountOf(coin.Denom)))
+		if fromPool.IsPositive() {
+			ms.k.SetFundedTransfer(ctx, types.FundedTransfer{
+				ChannelId:           req.ChannelId,
+				Sequence:            transferRes.Sequence,
+				CommunityPoolAmount: fromPool,
+			})
+		}
+	}
+
+	// escrow the relayer fees, if any, now that the packets have been sent
+	if req.Fee != nil {
+		if err = ms.k.payRelayerFees(ctx, req.Authority, ibctransfertypes.PortID, req.ChannelId, sequences, *req.Fee); err != nil {
+			return nil, err
+		}
 	}
 
+	// emit a single event for all the transfers, so that they can be told apart
+	// from those sent by other proposals
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendFunds,
+			sdk.NewAttribute(types.AttributeKeyChannel, req.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyInterchainAccount, address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, req.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySequences, formatSequences(sequences)),
+		),
+	)
+
 	ms.k.Logger(ctx).Info(
 		"initiated ICS-20 transfer(s) to interchain account",
 		"connectionID", connectionID,
 		"channelID", req.ChannelId,
 		"amount", req.Amount.String(),
+		"sequences", formatSequences(sequences),
 	)
 
-	return &types.MsgSendFundsResponse{}, nil
+	return &types.MsgSendFundsResponse{Sequences: sequences}, nil
 }
 
 func (ms msgServer) SendMessages(goCtx context.Context, req *types.MsgSendMessages) (*types.MsgSendMessagesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !marsutils.Contains(ms.k.authorities, req.Authority) {
-		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	data, msgTypeURLs, err := ms.k.buildCosmosTx(ctx, req.ConnectionId, req.Messages)
+	if err != nil {
+		return nil, err
 	}
 
-	owner := ms.k.GetModuleAddress()
+	// authorities other than the module's are limited by their policies
+	if err = ms.k.authorizeSendMessages(ctx, req.Authority, req.ConnectionId, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	// the relayer fee, if any, counts towards the spend limit
+	if req.Fee != nil {
+		if err = ms.k.authorizeSendFunds(ctx, req.Authority, req.ConnectionId, req.Fee.Total()); err != nil {
+			return nil, err
+		}
+	}
+
+	timeout := timeoutOrDefault(req.Timeout, ms.k.GetParams(ctx).MessagesTimeout)
+	channelID, sequence, err := ms.sendTx(ctx, req.Authority, req.ConnectionId, data, msgTypeURLs, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Fee != nil {
+		_, portID, err := ms.k.GetOwnerAndPortID()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = ms.k.payRelayerFees(ctx, req.Authority, portID, channelID, []uint64{sequence}, *req.Fee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSendMessagesResponse{Sequence: sequence}, nil
+}
 
-	protoMsgs, err := convertToProtoMessages(req.Messages)
+// BroadcastMessages sends one ICS-27 packet to the interchain account on each
+// of the given connections.
+//
+// All the connections are checked before any packet is sent: each must have an
+// interchain account with an open channel, fee-enabled if a relayer fee is to
+// be paid, and the messages must pass the same checks as in SendMessages. This
+// way a broadcast either reaches all the outposts or none of them.
+func (ms msgServer) BroadcastMessages(goCtx context.Context, req *types.MsgBroadcastMessages) (*types.MsgBroadcastMessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, portID, err := ms.k.GetOwnerAndPortID()
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := icatypes.SerializeCosmosTx(ms.k.cdc, protoMsgs)
+	txData := make([][]byte, len(req.Connections))
+	txMsgTypeURLs := make([][]string, len(req.Connections))
+
+	for i, connection := range req.Connections {
+		data, msgTypeURLs, err := ms.k.buildCosmosTx(ctx, connection.ConnectionId, connection.Messages)
+		if err != nil {
+			return nil, err
+		}
+
+		// authorities other than the module's are limited by their policies
+		if err = ms.k.authorizeSendMessages(ctx, req.Authority, connection.ConnectionId, msgTypeURLs); err != nil {
+			return nil, err
+		}
+
+		channelID, found := ms.k.icaControllerKeeper.GetOpenActiveChannel(ctx, connection.ConnectionId, portID)
+		if !found {
+			return nil, types.ErrChannelNotOpen.Wrapf("connection ID (%s)", connection.ConnectionId)
+		}
+
+		// the relayer fee, if any, counts towards the spend limit
+		if req.Fee != nil {
+			if !ms.k.feeKeeper.IsFeeEnabled(ctx, portID, channelID) {
+				return nil, ibcfeetypes.ErrFeeNotEnabled.Wrapf("port ID (%s) channel ID (%s)", portID, channelID)
+			}
+
+			if err = ms.k.authorizeSendFunds(ctx, req.Authority, connection.ConnectionId, req.Fee.Total()); err != nil {
+				return nil, err
+			}
+		}
+
+		txData[i] = data
+		txMsgTypeURLs[i] = msgTypeURLs
+	}
+
+	timeout := timeoutOrDefault(req.Timeout, ms.k.GetParams(ctx).MessagesTimeout)
+	results := make([]types.BroadcastResult, 0, len(req.Connections))
+
+	for i, connection := range req.Connections {
+		channelID, sequence, err := ms.sendTx(ctx, req.Authority, connection.ConnectionId, txData[i], txMsgTypeURLs[i], timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Fee != nil {
+			if err = ms.k.payRelayerFees(ctx, req.Authority, portID, channelID, []uint64{sequence}, *req.Fee); err != nil {
+				return nil, err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMessagesBroadcast,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyConnection, connection.ConnectionId),
+				sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			),
+		)
+
+		results = append(results, types.BroadcastResult{
+			ConnectionId: connection.ConnectionId,
+			ChannelId:    channelID,
+			Sequence:     sequence,
+		})
+	}
+
+	return &types.MsgBroadcastMessagesResponse{Results: results}, nil
+}
+
+// RetryPackets resends the payloads of timed out ICS-27 packets on the given
+// connection, and removes them from the queue.
+//
+// The ICA channel must have been reopened, either by the automatic recovery in
+// the EndBlocker or by a MsgRegisterAccount. As ICA channels are ordered, the
+// packets are resent in the order they were queued, i.e. the order in which
+// they originally timed out.
+func (ms msgServer) RetryPackets(goCtx context.Context, req *types.MsgRetryPackets) (*types.MsgRetryPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	_, portID, err := ms.k.GetOwnerAndPortID()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := ms.k.icaControllerKeeper.GetOpenActiveChannel(ctx, req.ConnectionId, portID); !found {
+		return nil, types.ErrChannelNotOpen.Wrapf("connection ID (%s)", req.ConnectionId)
+	}
+
+	// find the packets to be resent
+	queuedPackets := []types.QueuedPacket{}
+	if len(req.Ids) == 0 {
+		ms.k.IterateQueuedPackets(ctx, req.ConnectionId, func(queuedPacket types.QueuedPacket) bool {
+			queuedPackets = append(queuedPackets, queuedPacket)
+			return false
+		})
+
+		if len(queuedPackets) == 0 {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no queued packet exists on %s", req.ConnectionId)
+		}
+	} else {
+		ids := append([]uint64{}, req.Ids...)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, id := range ids {
+			queuedPacket, found := ms.k.GetQueuedPacket(ctx, req.ConnectionId, id)
+			if !found {
+				return nil, sdkerrors.ErrNotFound.Wrapf("queued packet: connectionID (%s) id (%d)", req.ConnectionId, id)
+			}
+
+			queuedPackets = append(queuedPackets, queuedPacket)
+		}
+	}
+
+	timeout := timeoutOrDefault(req.Timeout, ms.k.GetParams(ctx).MessagesTimeout)
+	sequences := []uint64{}
+
+	for _, queuedPacket := range queuedPackets {
+		_, sequence, err := ms.sendTx(ctx, req.Authority, req.ConnectionId, queuedPacket.Data, queuedPacket.MsgTypeUrls, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		ms.k.DeleteQueuedPacket(ctx, req.ConnectionId, queuedPacket.Id)
+
+		sequences = append(sequences, sequence)
+	}
+
+	return &types.MsgRetryPacketsResponse{Sequences: sequences}, nil
+}
+
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	ms.k.SetParams(ctx, req.Params)
+
+	ms.k.Logger(ctx).Info(
+		"updated envoy module params",
+		"transferTimeout", req.Params.TransferTimeout.String(),
+		"messagesTimeout", req.Params.MessagesTimeout.String(),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (ms msgServer) SetAuthorityPolicy(goCtx context.Context, req *types.MsgSetAuthorityPolicy) (*types.MsgSetAuthorityPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	// the module's authorities already have full power, so a policy would have
+	// no effect on them
+	if ms.k.isAuthority(req.Policy.Authority) {
+		return nil, types.ErrInvalidAuthorityPolicy.Wrapf("address `%s` is already an authority of the module", req.Policy.Authority)
+	}
+
+	ms.k.SetAuthorityPolicy(ctx, req.Policy)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorityPolicySet,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Policy.Authority),
+		),
+	)
+
+	ms.k.Logger(ctx).Info(
+		"set envoy module authority policy",
+		"authority", req.Policy.Authority,
+		"allowedConnections", strings.Join(req.Policy.AllowedConnections, ","),
+		"spendLimit", req.Policy.SpendLimit.String(),
+		"epochDuration", req.Policy.EpochDuration.String(),
+	)
+
+	return &types.MsgSetAuthorityPolicyResponse{}, nil
+}
+
+func (ms msgServer) RemoveAuthorityPolicy(goCtx context.Context, req *types.MsgRemoveAuthorityPolicy) (*types.MsgRemoveAuthorityPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	if _, found := ms.k.GetAuthorityPolicy(ctx, req.Address); !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("authority policy: address (%s)", req.Address)
+	}
+
+	ms.k.DeleteAuthorityPolicy(ctx, req.Address)
+	ms.k.DeleteAuthoritySpending(ctx, req.Address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorityPolicyRemoved,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Address),
+		),
+	)
+
+	ms.k.Logger(ctx).Info("removed envoy module authority policy", "authority", req.Address)
+
+	return &types.MsgRemoveAuthorityPolicyResponse{}, nil
+}
+
+// RegisterAccountWithVersion is like RegisterAccount, but opens the channel
+// with the version composed from the given ordering, encoding and fee version,
+// instead of the default one generated by the ICA controller module.
+//
+// If the account already has an open channel, it must first be closed with
+// CloseAccountChannel. The ICA controller module only allows the new channel
+// to differ from the previous one in the fee middleware wrapper; the ICS-27
+// metadata, including the encoding, must remain the same.
+func (ms msgServer) RegisterAccountWithVersion(goCtx context.Context, req *types.MsgRegisterAccountWithVersion) (*types.MsgRegisterAccountWithVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	// the ICA controller module of ibc-go v6 always opens ordered channels.
+	// reject other orderings here rather than silently ignoring them
+	ordering, err := types.ParseChannelOrdering(req.Ordering)
 	if err != nil {
 		return nil, err
 	}
 
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: data,
-		Memo: memo,
+	if ordering != ibcchanneltypes.ORDERED {
+		return nil, types.ErrInvalidChannelVersion.Wrapf("ICA controller only supports %s channels, got %s", ibcchanneltypes.ORDERED, ordering)
+	}
+
+	connection, err := ms.k.channelKeeper.GetConnection(ctx, req.ConnectionId)
+	if err != nil {
+		return nil, err
 	}
-	msg := icacontrollertypes.NewMsgSendTx(
-		owner.String(),
+
+	metadata := icatypes.NewMetadata(
+		icatypes.Version,
 		req.ConnectionId,
-		uint64(timeoutTime.Nanoseconds()), // NOTE: should be nanoseconds not seconds
-		packetData,
+		connection.GetCounterparty().GetConnectionID(),
+		"", // the address is set by the host chain during the handshake
+		req.Encoding,
+		icatypes.TxTypeSDKMultiMsg,
+	)
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+	// wrap the ICS-27 version in the fee middleware's, if requested
+	if req.FeeVersion != "" {
+		version = string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+			FeeVersion: req.FeeVersion,
+			AppVersion: version,
+		}))
+	}
+
+	if err := ms.k.icaControllerKeeper.RegisterInterchainAccount(ctx, req.ConnectionId, ms.k.GetModuleAddress().String(), version); err != nil {
+		return nil, err
+	}
+
+	ms.k.Logger(ctx).Info(
+		"initiated interchain account channel handshake",
+		"connectionID", req.ConnectionId,
+		"version", version,
 	)
 
-	res, err := ms.k.executeMsg(ctx, msg)
+	return &types.MsgRegisterAccountWithVersionResponse{}, nil
+}
+
+// CloseAccountChannel closes the open channel of the interchain account on the
+// given connection.
+//
+// The channel can't be closed while it has packets in flight, including the
+// queries sent by the EndBlocker. Once closed, these packets would time out
+// instead of being acknowledged. Timeouts on a channel closed this way don't
+// mark the account for recovery, see MarkForRecovery.
+func (ms msgServer) CloseAccountChannel(goCtx context.Context, req *types.MsgCloseAccountChannel) (*types.MsgCloseAccountChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	_, portID, err := ms.k.GetOwnerAndPortID()
 	if err != nil {
 		return nil, err
 	}
 
-	// IMPORTANT: emit the events!
-	// the IBC relayer listens to these events
-	ctx.EventManager().EmitEvents(res.GetEvents())
+	channelID, found := ms.k.icaControllerKeeper.GetOpenActiveChannel(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, types.ErrChannelNotOpen.Wrapf("connection ID (%s)", req.ConnectionId)
+	}
+
+	// queries are not recorded as packets, so compare the channel's sequences
+	// instead. ICA channels are ordered, so all packets sent on the channel
+	// have been acknowledged once the next ack sequence has caught up with the
+	// next send sequence
+	nextSequenceSend, _ := ms.k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	nextSequenceAck, _ := ms.k.channelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+	if nextSequenceSend > nextSequenceAck {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("channel %s has packets in flight", channelID)
+	}
+
+	// the ICA controller middleware rejects closing channels through the IBC
+	// message router, so call the channel keeper directly, authenticating with
+	// the controller's capability for the channel
+	chanCap, found := ms.k.icaControllerScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return nil, ibcchanneltypes.ErrChannelCapabilityNotFound.Wrapf("port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if err := ms.k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccountChannelClosed,
+			sdk.NewAttribute(types.AttributeKeyConnection, req.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		),
+	)
 
 	ms.k.Logger(ctx).Info(
-		"initiated ICS-27 tx execution with interchain account",
+		"closed interchain account channel",
 		"connectionID", req.ConnectionId,
-		"numMsgs", len(req.Messages),
+		"channelID", channelID,
 	)
 
-	return &types.MsgSendMessagesResponse{}, nil
+	return &types.MsgCloseAccountChannelResponse{ChannelId: channelID}, nil
+}
+
+// ScheduleOperation registers a SendFunds or SendMessages payload to be
+// executed by the EndBlocker, and reserves the funding for all of its
+// executions. See ExecuteScheduledOperations for how it is executed.
+//
+// The payload is not checked against the state at this point, e.g. whether the
+// channel or interchain account exists, as these may change by the time the
+// operation is due. Use Query/SimulateMessages to check a payload beforehand.
+func (ms msgServer) ScheduleOperation(goCtx context.Context, req *types.MsgScheduleOperation) (*types.MsgScheduleOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	op := types.ScheduledOperation{
+		Id:           ms.k.IncrementNextScheduledOperationID(ctx),
+		SendFunds:    req.SendFunds,
+		SendMessages: req.SendMessages,
+		StartTime:    req.StartTime,
+		Interval:     req.Interval,
+		Repetitions:  req.Repetitions,
+		SpentAmount:  sdk.NewCoins(),
+	}
+	op.ReservedAmount = op.ReservedAmountFor(op.Repetitions)
+
+	ms.k.SetScheduledOperation(ctx, op)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperationScheduled,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, op.ReservedAmount.String()),
+		),
+	)
+
+	ms.k.Logger(ctx).Info(
+		"scheduled envoy operation",
+		"id", op.Id,
+		"startTime", op.StartTime.String(),
+		"interval", op.Interval.String(),
+		"repetitions", op.Repetitions,
+		"reservedAmount", op.ReservedAmount.String(),
+	)
+
+	return &types.MsgScheduleOperationResponse{Id: op.Id}, nil
+}
+
+// CancelScheduledOperation deletes a scheduled operation, so that its
+// remaining executions don't happen and its reserved funding is released.
+// Packets already sent by past executions are not affected.
+func (ms msgServer) CancelScheduledOperation(goCtx context.Context, req *types.MsgCancelScheduledOperation) (*types.MsgCancelScheduledOperationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.isAuthority(req.Authority) {
+		return nil, types.ErrUnauthorized.Wrapf("address `%s` is not authorized to send this message", req.Authority)
+	}
+
+	op, found := ms.k.GetScheduledOperation(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scheduled operation: id (%d)", req.Id)
+	}
+
+	ms.k.DeleteScheduledOperation(ctx, req.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOperationCancelled,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, op.ReservedAmount.String()),
+		),
+	)
+
+	ms.k.Logger(ctx).Info(
+		"cancelled scheduled envoy operation",
+		"id", op.Id,
+		"executions", op.Executions,
+		"releasedAmount", op.ReservedAmount.String(),
+	)
+
+	return &types.MsgCancelScheduledOperationResponse{}, nil
+}
+
+// sendTx sends an ICS-27 packet containing the given serialized CosmosTx to the
+// interchain account on the given connection, and records the packet, so that
+// its outcome can be looked up once the acknowledgement or timeout is received.
+//
+// Returns the channel id and sequence of the sent packet.
+func (ms msgServer) sendTx(
+	ctx sdk.Context, authority, connectionID string, data []byte,
+	msgTypeURLs []string, timeout time.Duration,
+) (string, uint64, error) {
+	channelID, sequence, err := ms.k.sendCosmosTx(ctx, connectionID, data, timeout)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ms.k.RecordPacketSent(ctx, channelID, sequence, connectionID, authority, msgTypeURLs)
+
+	ms.k.Logger(ctx).Info(
+		"initiated ICS-27 tx execution with interchain account",
+		"connectionID", connectionID,
+		"channelID", channelID,
+		"sequence", sequence,
+		"numMsgs", len(msgTypeURLs),
+	)
+
+	return channelID, sequence, nil
+}
+
+// timeoutOrDefault returns the timeout specified in the message if there is
+// one, or the default timeout from the module's params otherwise.
+func timeoutOrDefault(timeout *time.Duration, defaultTimeout time.Duration) time.Duration {
+	if timeout != nil {
+		return *timeout
+	}
+
+	return defaultTimeout
+}
+
+// formatSequences formats packet sequence numbers as a comma-separated string,
+// for use in events and log messages.
+func formatSequences(sequences []uint64) string {
+	strs := make([]string, 0, len(sequences))
+	for _, sequence := range sequences {
+		strs = append(strs, strconv.FormatUint(sequence, 10))
+	}
+
+	return strings.Join(strs, ",")
 }
 
 // getProtoMessages converts []*codectypes.Any to []proto.Message; returns error
@@ -1,20 +1,30 @@
 package keeper_test
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 
 	wasm "github.com/CosmWasm/wasmd/x/wasm"
 
 	marsutils "github.com/mars-protocol/hub/v2/utils"
+	"github.com/mars-protocol/hub/v2/x/envoy"
 	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
 	"github.com/mars-protocol/hub/v2/x/envoy/types"
 )
@@ -25,23 +35,26 @@ var (
 
 	// initial balance of the community pool
 	communityPoolInitBalance = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(300)), sdk.NewCoin("uastro", sdk.NewInt(500)))
+)
 
-	// some random messages for use in testing SendMessages
-	mockMessages = []proto.Message{
+// getMockMessages returns some random messages signed by the given address,
+// for use in testing SendMessages
+func getMockMessages(signer string) []proto.Message {
+	return []proto.Message{
 		&govv1.MsgVote{
-			Voter:      "interchainAccountAddress",
+			Voter:      signer,
 			ProposalId: 69420,
 			Option:     govv1.OptionNoWithVeto,
 			Metadata:   "lol",
 		},
 		&wasm.MsgExecuteContract{
-			Sender:   "interchainAccountAddress",
+			Sender:   signer,
 			Contract: "contractAddress",
 			Msg:      []byte(`{"detonate_nuclear_bomb":{}}`),
 			Funds:    sdk.NewCoins(),
 		},
 	}
-)
+}
 
 func (suite *KeeperTestSuite) TestRegisterAccount() {
 	testCases := []struct {
@@ -173,6 +186,199 @@ func (suite *KeeperTestSuite) TestRegisterAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCloseAccountChannel() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	msg := &types.MsgCloseAccountChannel{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+	}
+
+	// only the module's authority can close channels
+	_, err := msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), &types.MsgCloseAccountChannel{
+		Authority:    sender,
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the channel can't be closed while it has packets in flight, whether
+	// proposal messages or queries sent by the EndBlocker
+	packet := suite.sendMockMessages(ctx)
+
+	_, err = msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// acknowledge the packet, as the IBC core module does for ordered channels
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceAck(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence+1)
+
+	packet = suite.sendBalancesQuery(ctx)
+
+	_, err = msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	app.IBCKeeper.ChannelKeeper.SetNextSequenceAck(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence+1)
+
+	res, err := msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.path1.EndpointA.ChannelID, res.ChannelId)
+
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, suite.path1.EndpointA.ChannelConfig.PortID, res.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(ibcchanneltypes.CLOSED, channel.State)
+
+	// the channel is no longer open, so it can't be closed again
+	_, err = msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrChannelNotOpen)
+	// a packet timing out on the closed channel doesn't mark the account for
+	// recovery, which would reopen the channel with the previous version
+	err = envoy.NewIBCModule(app.EnvoyKeeper).OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	_, found = app.EnvoyKeeper.GetRecovery(ctx, suite.path1.EndpointA.ConnectionID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRegisterAccountWithVersion() {
+	testCases := []struct {
+		name      string
+		authority string
+		ordering  string
+		encoding  string
+		expPass   bool
+	}{
+		{
+			"success",
+			authority.String(),
+			"ORDER_ORDERED",
+			icatypes.EncodingProtobuf,
+			true,
+		},
+		{
+			"fail - sender is not authority",
+			sender,
+			"ORDER_ORDERED",
+			icatypes.EncodingProtobuf,
+			false,
+		},
+		{
+			"fail - unordered channels are not supported",
+			authority.String(),
+			"ORDER_UNORDERED",
+			icatypes.EncodingProtobuf,
+			false,
+		},
+		{
+			"fail - unsupported encoding",
+			authority.String(),
+			"ORDER_ORDERED",
+			"proto3json",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+			res, err := msgServer.RegisterAccountWithVersion(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccountWithVersion{
+				Authority:    tc.authority,
+				ConnectionId: suite.path1.EndpointA.ConnectionID,
+				Ordering:     tc.ordering,
+				Encoding:     tc.encoding,
+			})
+			events := ctx.EventManager().Events()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(ibcchanneltypes.EventTypeChannelOpenInit, events[0].Type)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenAccountChannelWithVersion() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	msg := &types.MsgRegisterAccountWithVersion{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+		Ordering:     "ORDER_ORDERED",
+		Encoding:     icatypes.EncodingProtobuf,
+	}
+
+	// the account's channel is open, so it must be closed first
+	_, err := msgServer.RegisterAccountWithVersion(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+
+	_, err = msgServer.CloseAccountChannel(sdk.WrapSDKContext(ctx), &types.MsgCloseAccountChannel{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+	})
+	suite.Require().NoError(err)
+
+	_, err = msgServer.RegisterAccountWithVersion(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestValidateChannelOpenInit() {
+	suite.SetupTest()
+
+	app := getMarsApp(suite.hub)
+
+	version := icatypes.NewDefaultMetadataString(suite.path1.EndpointA.ConnectionID, suite.path1.EndpointB.ConnectionID)
+
+	err := app.EnvoyKeeper.ValidateChannelOpenInit(ibcchanneltypes.ORDERED, portID, version)
+	suite.Require().NoError(err)
+
+	// other owners' ports
+	otherPortID, err := icatypes.NewControllerPortID(sender)
+	suite.Require().NoError(err)
+
+	err = app.EnvoyKeeper.ValidateChannelOpenInit(ibcchanneltypes.ORDERED, otherPortID, version)
+	suite.Require().Error(err)
+
+	// unordered channels
+	err = app.EnvoyKeeper.ValidateChannelOpenInit(ibcchanneltypes.UNORDERED, portID, version)
+	suite.Require().Error(err)
+
+	// invalid version string
+	err = app.EnvoyKeeper.ValidateChannelOpenInit(ibcchanneltypes.ORDERED, portID, "ics27-1")
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelVersion)
+
+	// unsupported encoding
+	metadata := icatypes.NewMetadata(
+		icatypes.Version,
+		suite.path1.EndpointA.ConnectionID,
+		suite.path1.EndpointB.ConnectionID,
+		"",
+		"proto3json",
+		icatypes.TxTypeSDKMultiMsg,
+	)
+
+	err = app.EnvoyKeeper.ValidateChannelOpenInit(ibcchanneltypes.ORDERED, portID, string(icatypes.ModuleCdc.MustMarshalJSON(&metadata)))
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelVersion)
+}
+
 func (suite *KeeperTestSuite) TestSendFunds() {
 	testCases := []struct {
 		name      string
@@ -259,6 +465,39 @@ func (suite *KeeperTestSuite) TestSendFunds() {
 				suite.Require().NotNil(res)
 				suite.Require().NotZero(events)
 
+				// the response should contain the sequence of each packet
+				expSequences := []uint64{}
+				for _, packet := range suite.sentPackets(ctx) {
+					expSequences = append(expSequences, packet.Sequence)
+				}
+				suite.Require().Equal(expSequences, res.Sequences)
+				suite.Require().Len(res.Sequences, len(tc.amount))
+
+				// a single event should have been emitted for all the transfers
+				address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, suite.path1.EndpointA.ConnectionID, portID)
+				suite.Require().True(found)
+
+				expSequenceStrs := []string{}
+				for _, sequence := range expSequences {
+					expSequenceStrs = append(expSequenceStrs, strconv.FormatUint(sequence, 10))
+				}
+
+				sendFundsEvents := []sdk.Event{}
+				for _, event := range events {
+					if event.Type == types.EventTypeSendFunds {
+						sendFundsEvents = append(sendFundsEvents, event)
+					}
+				}
+				suite.Require().Equal([]sdk.Event{
+					sdk.NewEvent(
+						types.EventTypeSendFunds,
+						sdk.NewAttribute(types.AttributeKeyChannel, tc.channelID),
+						sdk.NewAttribute(types.AttributeKeyInterchainAccount, address),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, tc.amount.String()),
+						sdk.NewAttribute(types.AttributeKeySequences, strings.Join(expSequenceStrs, ",")),
+					),
+				}, sendFundsEvents)
+
 				envoyBalance := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
 				expEnvoyBalance := marsutils.SaturateSub(envoyInitBalance, tc.amount)
 				suite.Require().True(envoyBalance.IsEqual(expEnvoyBalance))
@@ -275,47 +514,130 @@ func (suite *KeeperTestSuite) TestSendFunds() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSendFundsForwarded() {
+	suite.SetupTest()
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+
+	// register an interchain account on outpost 1, but send the funds through
+	// the transfer channel to outpost 2, as if outpost 2 were an intermediate
+	// chain
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	route := []types.ForwardHop{{Receiver: "cosmos1pfm", ChannelId: "channel-7"}}
+
+	_, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority:    authority.String(),
+		ChannelId:    suite.path2.EndpointA.ChannelID,
+		Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(123))),
+		Route:        route,
+		ConnectionId: connectionID,
+	})
+	suite.Require().NoError(err)
+
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	suite.Require().True(found)
+
+	expMemo, err := types.ForwardMemo(route, address)
+	suite.Require().NoError(err)
+
+	// the packet should be sent to the first hop's receiver, with the memo
+	// instructing it to forward the funds to the interchain account
+	packet := suite.lastSentPacket(ctx)
+	suite.Require().Equal(suite.path2.EndpointA.ChannelID, packet.SourceChannel)
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	err = ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data)
+	suite.Require().NoError(err)
+	suite.Require().Equal("cosmos1pfm", data.Receiver)
+	suite.Require().Equal(expMemo, data.Memo)
+
+	// the funds can't be forwarded to a connection without an interchain account
+	_, err = msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority:    authority.String(),
+		ChannelId:    suite.path1.EndpointA.ChannelID,
+		Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(123))),
+		Route:        route,
+		ConnectionId: suite.path2.EndpointA.ConnectionID,
+	})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestSendMessages() {
 	testCases := []struct {
 		name         string
 		authority    string
 		connectionID string
-		messages     []proto.Message
+		messages     func(icaAddress string) []proto.Message
 		expPass      bool
 	}{
 		{
 			"success",
 			authority.String(),
 			suite.path1.EndpointA.ConnectionID,
-			mockMessages,
+			getMockMessages,
+			true,
+		},
+		{
+			"success - signer has the host chain's bech32 prefix",
+			authority.String(),
+			suite.path1.EndpointA.ConnectionID,
+			func(icaAddress string) []proto.Message {
+				_, bz, err := bech32.DecodeAndConvert(icaAddress)
+				suite.Require().NoError(err)
+
+				osmoAddress, err := bech32.ConvertAndEncode("osmo", bz)
+				suite.Require().NoError(err)
+
+				return getMockMessages(osmoAddress)
+			},
 			true,
 		},
 		{
 			"fail - no message",
 			authority.String(),
 			suite.path1.EndpointA.ConnectionID,
-			[]proto.Message{},
+			func(string) []proto.Message { return []proto.Message{} },
+			false,
+		},
+		{
+			"fail - signer is not the interchain account",
+			authority.String(),
+			suite.path1.EndpointA.ConnectionID,
+			func(string) []proto.Message { return getMockMessages(sender) },
+			false,
+		},
+		{
+			"fail - signer is not a valid address",
+			authority.String(),
+			suite.path1.EndpointA.ConnectionID,
+			func(string) []proto.Message { return getMockMessages("interchainAccountAddress") },
 			false,
 		},
 		{
 			"fail - sender is not authority",
 			sender,
 			suite.path1.EndpointA.ConnectionID,
-			mockMessages,
+			getMockMessages,
 			false,
 		},
 		{
 			"fail - non-existent connection",
 			authority.String(),
 			"connection-88888",
-			mockMessages,
+			getMockMessages,
 			false,
 		},
 		{
 			"fail - no interchain account found on the connection",
 			authority.String(),
 			suite.path2.EndpointA.ConnectionID, // to outpost2, which doesn't have an ICA registered
-			mockMessages,
+			getMockMessages,
 			false,
 		},
 	}
@@ -331,8 +653,13 @@ func (suite *KeeperTestSuite) TestSendMessages() {
 			app := getMarsApp(suite.hub)
 			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
 
+			// the interchain account only exists on outpost 1, so the messages
+			// of the cases with other connections are signed by this address
+			// as well
+			messages := tc.messages(suite.interchainAccountAddress(ctx))
+
 			anys := []*codectypes.Any{}
-			for _, protoMsg := range tc.messages {
+			for _, protoMsg := range messages {
 				any, err := codectypes.NewAnyWithValue(protoMsg)
 				suite.Require().NoError(err)
 
@@ -353,6 +680,20 @@ func (suite *KeeperTestSuite) TestSendMessages() {
 				suite.Require().Len(events, 2)
 				suite.Require().Equal(ibcchanneltypes.EventTypeSendPacket, events[0].Type)
 				suite.Require().Equal(sdk.EventTypeMessage, events[1].Type)
+
+				// the response should contain the packet's sequence
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				// a pending record should have been created for the packet
+				channelID, found := app.ICAControllerKeeper.GetActiveChannelID(ctx, tc.connectionID, portID)
+				suite.Require().True(found)
+
+				packet, found := app.EnvoyKeeper.GetPacket(ctx, channelID, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.connectionID, packet.ConnectionId)
+				suite.Require().Equal(tc.authority, packet.Authority)
+				suite.Require().Len(packet.MsgTypeUrls, len(messages))
+				suite.Require().Equal(types.PacketStatusPending, packet.Status)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
@@ -361,3 +702,454 @@ func (suite *KeeperTestSuite) TestSendMessages() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSendMessagesHostAllowList() {
+	testCases := []struct {
+		name       string
+		hostParams *types.HostParams
+		expPass    bool
+	}{
+		{
+			"success - host params not known",
+			nil,
+			true,
+		},
+		{
+			"success - all messages allowed",
+			&types.HostParams{HostEnabled: true, AllowMessages: []string{"*"}},
+			true,
+		},
+		{
+			"success - messages in allow-list",
+			&types.HostParams{
+				HostEnabled:   true,
+				AllowMessages: []string{"/cosmos.gov.v1.MsgVote", "/cosmwasm.wasm.v1.MsgExecuteContract"},
+			},
+			true,
+		},
+		{
+			"fail - message not in allow-list",
+			&types.HostParams{HostEnabled: true, AllowMessages: []string{"/cosmos.gov.v1.MsgVote"}},
+			false,
+		},
+		{
+			"fail - host disabled",
+			&types.HostParams{HostEnabled: false, AllowMessages: []string{"*"}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			registerInterchainAccount(suite.path1, owner.String())
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+			if tc.hostParams != nil {
+				tc.hostParams.ConnectionId = suite.path1.EndpointA.ConnectionID
+				app.EnvoyKeeper.SetHostParams(ctx, *tc.hostParams)
+			}
+
+			anys := []*codectypes.Any{}
+			for _, protoMsg := range getMockMessages(suite.interchainAccountAddress(ctx)) {
+				any, err := codectypes.NewAnyWithValue(protoMsg)
+				suite.Require().NoError(err)
+
+				anys = append(anys, any)
+			}
+
+			_, err := msgServer.SendMessages(sdk.WrapSDKContext(ctx), &types.MsgSendMessages{
+				Authority:    authority.String(),
+				ConnectionId: suite.path1.EndpointA.ConnectionID,
+				Messages:     anys,
+			})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrMsgNotAllowed)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendMessagesTimeout() {
+	customTimeout := 3 * time.Hour
+
+	testCases := []struct {
+		name       string
+		timeout    *time.Duration
+		expTimeout time.Duration
+	}{
+		{
+			"default timeout",
+			nil,
+			types.DefaultParams().MessagesTimeout,
+		},
+		{
+			"custom timeout",
+			&customTimeout,
+			customTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			registerInterchainAccount(suite.path1, owner.String())
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+			any, err := codectypes.NewAnyWithValue(getMockMessages(suite.interchainAccountAddress(ctx))[0])
+			suite.Require().NoError(err)
+
+			_, err = msgServer.SendMessages(sdk.WrapSDKContext(ctx), &types.MsgSendMessages{
+				Authority:    authority.String(),
+				ConnectionId: suite.path1.EndpointA.ConnectionID,
+				Messages:     []*codectypes.Any{any},
+				Timeout:      tc.timeout,
+			})
+			suite.Require().NoError(err)
+
+			// find the timeout timestamp in the send_packet event
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(ibcchanneltypes.EventTypeSendPacket, events[0].Type)
+
+			expTimeoutTimestamp := strconv.FormatInt(ctx.BlockTime().Add(tc.expTimeout).UnixNano(), 10)
+			found := false
+			for _, attr := range events[0].Attributes {
+				if string(attr.Key) == ibcchanneltypes.AttributeKeyTimeoutTimestamp {
+					suite.Require().Equal(expTimeoutTimestamp, string(attr.Value))
+					found = true
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
+
+// mockConnectionMessages composes the mock messages signed by the interchain
+// account on each of the given paths' connections.
+func (suite *KeeperTestSuite) mockConnectionMessages(ctx sdk.Context, paths ...*ibctesting.Path) []types.ConnectionMessages {
+	app := getMarsApp(suite.hub)
+
+	connections := []types.ConnectionMessages{}
+	for _, path := range paths {
+		// if there's no interchain account on the connection, sign the messages
+		// with the one on outpost 1 instead
+		icaAddress, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, path.EndpointA.ConnectionID, portID)
+		if !found {
+			icaAddress = suite.interchainAccountAddress(ctx)
+		}
+
+		anys := []*codectypes.Any{}
+		for _, protoMsg := range getMockMessages(icaAddress) {
+			any, err := codectypes.NewAnyWithValue(protoMsg)
+			suite.Require().NoError(err)
+
+			anys = append(anys, any)
+		}
+
+		connections = append(connections, types.ConnectionMessages{
+			ConnectionId: path.EndpointA.ConnectionID,
+			Messages:     anys,
+		})
+	}
+
+	return connections
+}
+
+func (suite *KeeperTestSuite) TestBroadcastMessages() {
+	suite.SetupTest()
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+
+	registerInterchainAccount(suite.path1, owner.String())
+	registerInterchainAccount(suite.path2, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	connections := suite.mockConnectionMessages(ctx, suite.path1, suite.path2)
+
+	// only the module's authority can broadcast messages, unless a policy
+	// allows it
+	_, err := msgServer.BroadcastMessages(sdk.WrapSDKContext(ctx), &types.MsgBroadcastMessages{
+		Authority:   sender,
+		Connections: connections,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the fee can't be paid unless all the channels are fee-enabled, in which
+	// case no packet is sent
+	app.IBCFeeKeeper.SetFeeEnabled(ctx, portID, suite.path1.EndpointA.ChannelID)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.BroadcastMessages(sdk.WrapSDKContext(cacheCtx), &types.MsgBroadcastMessages{
+		Authority:   authority.String(),
+		Connections: connections,
+		Fee:         &mockRelayerFee,
+	})
+	suite.Require().ErrorIs(err, ibcfeetypes.ErrFeeNotEnabled)
+	suite.Require().Empty(suite.sentPackets(cacheCtx))
+
+	// one packet is sent per connection
+	res, err := msgServer.BroadcastMessages(sdk.WrapSDKContext(ctx), &types.MsgBroadcastMessages{
+		Authority:   authority.String(),
+		Connections: connections,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BroadcastResult{
+		{
+			ConnectionId: suite.path1.EndpointA.ConnectionID,
+			ChannelId:    suite.path1.EndpointA.ChannelID,
+			Sequence:     1,
+		},
+		{
+			ConnectionId: suite.path2.EndpointA.ConnectionID,
+			ChannelId:    suite.path2.EndpointA.ChannelID,
+			Sequence:     1,
+		},
+	}, res.Results)
+
+	packets := suite.sentPackets(ctx)
+	suite.Require().Len(packets, 2)
+
+	for i, result := range res.Results {
+		suite.Require().Equal(result.ChannelId, packets[i].SourceChannel)
+
+		// a pending record should have been created for each packet
+		packet, found := app.EnvoyKeeper.GetPacket(ctx, result.ChannelId, result.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(result.ConnectionId, packet.ConnectionId)
+		suite.Require().Equal(authority.String(), packet.Authority)
+		suite.Require().Equal(types.PacketStatusPending, packet.Status)
+	}
+
+	broadcastEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMessagesBroadcast {
+			broadcastEvents++
+		}
+	}
+	suite.Require().Equal(2, broadcastEvents)
+}
+
+func (suite *KeeperTestSuite) TestBroadcastMessagesMissingAccount() {
+	suite.SetupTest()
+
+	// register an interchain account on outpost 1 only
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	// the messages to outpost 1 are valid, but as there's no account on
+	// outpost 2, none of the packets is sent
+	res, err := msgServer.BroadcastMessages(sdk.WrapSDKContext(ctx), &types.MsgBroadcastMessages{
+		Authority:   authority.String(),
+		Connections: suite.mockConnectionMessages(ctx, suite.path1, suite.path2),
+	})
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	_, found := app.EnvoyKeeper.GetPacket(ctx, suite.path1.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	newParams := types.Params{
+		TransferTimeout: time.Hour,
+		MessagesTimeout: 24 * time.Hour,
+	}
+
+	testCases := []struct {
+		name      string
+		authority string
+		expPass   bool
+	}{
+		{
+			"success",
+			authority.String(),
+			true,
+		},
+		{
+			"fail - sender is not authority",
+			sender,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    newParams,
+			})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(newParams, app.EnvoyKeeper.GetParams(ctx))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.DefaultParams(), app.EnvoyKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRetryPackets() {
+	testCases := []struct {
+		name         string
+		numTimeouts  int
+		closeChannel bool
+		authority    string
+		ids          []uint64
+		expSequences []uint64
+		expRemaining []uint64
+		expPass      bool
+	}{
+		{
+			"success - all packets",
+			2,
+			false,
+			authority.String(),
+			[]uint64{},
+			[]uint64{3, 4},
+			[]uint64{},
+			true,
+		},
+		{
+			"success - selected packets",
+			2,
+			false,
+			authority.String(),
+			[]uint64{2},
+			[]uint64{3},
+			[]uint64{1},
+			true,
+		},
+		{
+			"fail - sender is not authority",
+			1,
+			false,
+			sender,
+			[]uint64{},
+			nil,
+			[]uint64{1},
+			false,
+		},
+		{
+			"fail - channel is not open",
+			1,
+			true,
+			authority.String(),
+			[]uint64{},
+			nil,
+			[]uint64{1},
+			false,
+		},
+		{
+			"fail - packet not found",
+			1,
+			false,
+			authority.String(),
+			[]uint64{1, 2},
+			nil,
+			[]uint64{1},
+			false,
+		},
+		{
+			"fail - no queued packets",
+			0,
+			false,
+			authority.String(),
+			[]uint64{},
+			nil,
+			[]uint64{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			registerInterchainAccount(suite.path1, owner.String())
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+			msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+			connectionID := suite.path1.EndpointA.ConnectionID
+
+			// here we don't close the channel after the timeouts, so that the
+			// packets can be resent right away
+			for i := 0; i < tc.numTimeouts; i++ {
+				packet := suite.sendMockMessages(ctx)
+				err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+				suite.Require().NoError(err)
+			}
+
+			if tc.closeChannel {
+				channelID, found := app.ICAControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+				suite.Require().True(found)
+
+				channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+				suite.Require().True(found)
+
+				channel.State = ibcchanneltypes.CLOSED
+				app.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+			}
+
+			res, err := msgServer.RetryPackets(sdk.WrapSDKContext(ctx), &types.MsgRetryPackets{
+				Authority:    tc.authority,
+				ConnectionId: connectionID,
+				Ids:          tc.ids,
+			})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSequences, res.Sequences)
+
+				// the resent packets should have been recorded
+				channelID, found := app.ICAControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+				suite.Require().True(found)
+
+				for _, sequence := range tc.expSequences {
+					record, found := app.EnvoyKeeper.GetPacket(ctx, channelID, sequence)
+					suite.Require().True(found)
+					suite.Require().Equal(types.PacketStatusPending, record.Status)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			remaining := []uint64{}
+			app.EnvoyKeeper.IterateQueuedPackets(ctx, connectionID, func(queuedPacket types.QueuedPacket) bool {
+				remaining = append(remaining, queuedPacket.Id)
+				return false
+			})
+			suite.Require().Equal(tc.expRemaining, remaining)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// buildCosmosTx rejects the messages that would certainly fail when executed
+// by the interchain account on the given connection, and serializes them into
+// a CosmosTx.
+//
+// Returns the serialized CosmosTx and the type URLs of the messages.
+func (k Keeper) buildCosmosTx(ctx sdk.Context, connectionID string, anys []*codectypes.Any) ([]byte, []string, error) {
+	protoMsgs, err := convertToProtoMessages(anys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = k.validateInterchainMessages(ctx, connectionID, protoMsgs); err != nil {
+		return nil, nil, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, protoMsgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgTypeURLs := []string{}
+	for _, any := range anys {
+		msgTypeURLs = append(msgTypeURLs, any.TypeUrl)
+	}
+
+	return data, msgTypeURLs, nil
+}
+
+// newCosmosTxPacketData creates the data of an ICS-27 packet executing the
+// given serialized CosmosTx.
+func newCosmosTxPacketData(data []byte) icatypes.InterchainAccountPacketData {
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+}
+
+// sendCosmosTx sends an ICS-27 packet containing the given serialized CosmosTx
+// to the interchain account on the given connection.
+//
+// Returns the channel id and sequence of the sent packet.
+func (k Keeper) sendCosmosTx(ctx sdk.Context, connectionID string, data []byte, timeout time.Duration) (string, uint64, error) {
+	owner, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		return "", 0, err
+	}
+
+	packetData := newCosmosTxPacketData(data)
+
+	// unlike MsgTransfer, MsgSendTx takes a relative timeout
+	msg := icacontrollertypes.NewMsgSendTx(
+		owner.String(),
+		connectionID,
+		uint64(timeout.Nanoseconds()), // NOTE: should be nanoseconds not seconds
+		packetData,
+	)
+
+	res, err := k.executeMsg(ctx, msg)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// IMPORTANT: emit the events!
+	// the IBC relayer listens to these events
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	// the packet sequence is returned in the controller's response, while the
+	// channel id is the connection's active channel, through which the packet
+	// was just sent
+	var sendTxRes icacontrollertypes.MsgSendTxResponse
+	if err = k.cdc.Unmarshal(res.Data, &sendTxRes); err != nil {
+		return "", 0, err
+	}
+
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", 0, sdkerrors.ErrNotFound.Wrapf("no active channel exists on %s", connectionID)
+	}
+
+	return channelID, sendTxRes.Sequence, nil
+}
+
+// DecodeMsgResponse decodes the response of a message executed by an interchain
+// account into JSON, by resolving its type URL through the app's interface
+// registry.
+//
+// The response types of all messages registered with the app's msg service
+// router can be resolved. Types unknown to Mars Hub, e.g. those of modules that
+// only exist on the host chain, can't be, in which case the hex-encoded bytes
+// are returned.
+func (k Keeper) DecodeMsgResponse(typeURL string, bz []byte) string {
+	// we have asserted that the codec is a ProtoCodec in the keeper's
+	// constructor, so the type assertion here is safe
+	msg, err := k.cdc.(*codec.ProtoCodec).InterfaceRegistry().Resolve(typeURL)
+	if err != nil {
+		return hex.EncodeToString(bz)
+	}
+
+	if err = proto.Unmarshal(bz, msg); err != nil {
+		return hex.EncodeToString(bz)
+	}
+
+	jsonBz, err := k.cdc.MarshalJSON(msg)
+	if err != nil {
+		return hex.EncodeToString(bz)
+	}
+
+	return string(jsonBz)
+}
+
+// RecordPacketSent creates a pending record for an ICS-27 packet that has just
+// been sent.
+func (k Keeper) RecordPacketSent(ctx sdk.Context, channelID string, sequence uint64, connectionID, authority string, msgTypeURLs []string) {
+	k.SetPacket(ctx, types.Packet{
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		ConnectionId: connectionID,
+		Authority:    authority,
+		MsgTypeUrls:  msgTypeURLs,
+		SendTime:     ctx.BlockTime(),
+		Status:       types.PacketStatusPending,
+	})
+}
+
+// RecordPacketAcknowledged updates the record of an ICS-27 packet upon
+// receiving an acknowledgement. If errMsg is non-empty, the packet is marked as
+// failed; otherwise it is marked as successful, and the responses are saved.
+//
+// Packets sent before the envoy module started to keep records don't have a
+// record, in which case this function does nothing.
+func (k Keeper) RecordPacketAcknowledged(ctx sdk.Context, channelID string, sequence uint64, responses []types.MsgResponse, errMsg string) {
+	packet, found := k.GetPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+
+	if errMsg != "" {
+		packet.Status = types.PacketStatusError
+		packet.Error = errMsg
+	} else {
+		packet.Status = types.PacketStatusSuccess
+		packet.Responses = responses
+	}
+
+	k.SetPacket(ctx, packet)
+}
+
+// RecordPacketTimedOut updates the record of an ICS-27 packet upon it having
+// timed out.
+//
+// Same as in RecordPacketAcknowledged, this function does nothing if the packet
+// doesn't have a record.
+func (k Keeper) RecordPacketTimedOut(ctx sdk.Context, channelID string, sequence uint64) {
+	packet, found := k.GetPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+
+	packet.Status = types.PacketStatusTimeout
+
+	k.SetPacket(ctx, packet)
+}
+
+// QueuePacket saves the payload of a timed out ICS-27 packet, so that it can be
+// resent via MsgRetryPackets once the channel is reopened. The payload is
+// pruned after the QueueExpiry param if not resent.
+//
+// The authority and message types are copied from the packet's record, if it
+// has one.
+func (k Keeper) QueuePacket(ctx sdk.Context, packet ibcchanneltypes.Packet) error {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return err
+	}
+
+	connectionID, _, err := k.channelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+
+	queuedPacket := types.QueuedPacket{
+		Id:           k.IncrementNextQueuedPacketID(ctx),
+		ConnectionId: connectionID,
+		ChannelId:    packet.SourceChannel,
+		Sequence:     packet.Sequence,
+		Data:         packetData.Data,
+		ExpiryTime:   ctx.BlockTime().Add(k.GetParams(ctx).QueueExpiry),
+	}
+
+	if record, found := k.GetPacket(ctx, packet.SourceChannel, packet.Sequence); found {
+		queuedPacket.Authority = record.Authority
+		queuedPacket.MsgTypeUrls = record.MsgTypeUrls
+	}
+
+	k.SetQueuedPacket(ctx, queuedPacket)
+
+	k.Logger(ctx).Info(
+		"queued timed out ICS-27 packet",
+		"id", queuedPacket.Id,
+		"connectionID", connectionID,
+		"channelID", packet.SourceChannel,
+		"sequence", packet.Sequence,
+	)
+
+	return nil
+}
+
+// PruneQueuedPackets deletes the queued packets whose expiry time has passed.
+func (k Keeper) PruneQueuedPackets(ctx sdk.Context) {
+	// collect the packets first, as we can't write to the store while iterating
+	expired := []types.QueuedPacket{}
+	k.IterateQueuedPackets(ctx, "", func(queuedPacket types.QueuedPacket) bool {
+		if !ctx.BlockTime().Before(queuedPacket.ExpiryTime) {
+			expired = append(expired, queuedPacket)
+		}
+
+		return false
+	})
+
+	for _, queuedPacket := range expired {
+		k.DeleteQueuedPacket(ctx, queuedPacket.ConnectionId, queuedPacket.Id)
+
+		k.Logger(ctx).Info(
+			"pruned expired ICS-27 packet",
+			"id", queuedPacket.Id,
+			"connectionID", queuedPacket.ConnectionId,
+		)
+	}
+}
@@ -0,0 +1,336 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+
+	"github.com/mars-protocol/hub/v2/x/envoy"
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// sendMockMessages sends the mock messages to outpost 1 and returns the sent
+// packet. It assumes an ICA has already been registered on outpost 1.
+func (suite *KeeperTestSuite) sendMockMessages(ctx sdk.Context) ibcchanneltypes.Packet {
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	anys := []*codectypes.Any{}
+	for _, protoMsg := range getMockMessages(suite.interchainAccountAddress(ctx)) {
+		any, err := codectypes.NewAnyWithValue(protoMsg)
+		suite.Require().NoError(err)
+
+		anys = append(anys, any)
+	}
+
+	_, err := msgServer.SendMessages(sdk.WrapSDKContext(ctx), &types.MsgSendMessages{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+		Messages:     anys,
+	})
+	suite.Require().NoError(err)
+
+	return suite.lastSentPacket(ctx)
+}
+
+// interchainAccountAddress returns the address of the interchain account on
+// outpost 1. It assumes an ICA has already been registered on outpost 1.
+func (suite *KeeperTestSuite) interchainAccountAddress(ctx sdk.Context) string {
+	app := getMarsApp(suite.hub)
+
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, suite.path1.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+
+	return address
+}
+
+// lastSentPacket parses the packet from the last send_packet event emitted in
+// the given context.
+func (suite *KeeperTestSuite) lastSentPacket(ctx sdk.Context) ibcchanneltypes.Packet {
+	packets := suite.sentPackets(ctx)
+	if len(packets) == 0 {
+		suite.FailNow("no send_packet event found")
+	}
+
+	return packets[len(packets)-1]
+}
+
+// sentPackets parses the packets from the send_packet events emitted in the
+// given context, in the order they were sent. Only the fields that the envoy
+// module's callbacks read are populated.
+func (suite *KeeperTestSuite) sentPackets(ctx sdk.Context) []ibcchanneltypes.Packet {
+	packets := []ibcchanneltypes.Packet{}
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != ibcchanneltypes.EventTypeSendPacket {
+			continue
+		}
+
+		packet := ibcchanneltypes.Packet{}
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case ibcchanneltypes.AttributeKeySequence:
+				sequence, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				suite.Require().NoError(err)
+				packet.Sequence = sequence
+			case ibcchanneltypes.AttributeKeySrcPort:
+				packet.SourcePort = string(attr.Value)
+			case ibcchanneltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = string(attr.Value)
+			case ibcchanneltypes.AttributeKeyDstPort:
+				packet.DestinationPort = string(attr.Value)
+			case ibcchanneltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = string(attr.Value)
+			case ibcchanneltypes.AttributeKeyDataHex:
+				data, err := hex.DecodeString(string(attr.Value))
+				suite.Require().NoError(err)
+				packet.Data = data
+			}
+		}
+
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+func (suite *KeeperTestSuite) TestPacketAcknowledged() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	voteResp, err := codectypes.NewAnyWithValue(&govv1.MsgVoteResponse{})
+	suite.Require().NoError(err)
+
+	executeResp, err := codectypes.NewAnyWithValue(&wasm.MsgExecuteContractResponse{Data: []byte{1, 2, 3}})
+	suite.Require().NoError(err)
+
+	// a response of a type that only exists on the host chain
+	unknownResp := &codectypes.Any{TypeUrl: "/osmosis.gamm.v1beta1.MsgSwapExactAmountInResponse", Value: []byte{10, 3, 49, 50, 51}}
+
+	txMsgData, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{voteResp, executeResp, unknownResp}}).Marshal()
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	ack := ibcchanneltypes.NewResultAcknowledgement(txMsgData)
+	err = ibcModule.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	// the responses should have been decoded into JSON, except the unknown one
+	expResponses := []types.MsgResponse{
+		{TypeUrl: voteResp.TypeUrl, Data: `{}`},
+		{TypeUrl: executeResp.TypeUrl, Data: `{"data":"AQID"}`},
+		{TypeUrl: unknownResp.TypeUrl, Data: "0a03313233"},
+	}
+
+	record, found := app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PacketStatusSuccess, record.Status)
+	suite.Require().Empty(record.Error)
+	suite.Require().Equal(expResponses, record.Responses)
+
+	// the outcome and the responses should have been emitted as events
+	sequence := strconv.FormatUint(packet.Sequence, 10)
+	expEvents := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketAcknowledged,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, sequence),
+			sdk.NewAttribute(types.AttributeKeySuccess, "true"),
+			sdk.NewAttribute(types.AttributeKeyError, ""),
+		),
+	}
+	for i, response := range expResponses {
+		expEvents = append(expEvents, sdk.NewEvent(
+			types.EventTypeMsgResponse,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, sequence),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.Itoa(i)),
+			sdk.NewAttribute(types.AttributeKeyTypeURL, response.TypeUrl),
+			sdk.NewAttribute(types.AttributeKeyData, response.Data),
+		))
+	}
+	suite.Require().Equal(expEvents, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestPacketAcknowledgedLegacy() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	executeResp, err := proto.Marshal(&wasm.MsgExecuteContractResponse{Data: []byte{1, 2, 3}})
+	suite.Require().NoError(err)
+
+	// sdk 0.45 chains respond with the message's type URL instead of the
+	// response's
+	txMsgData, err := (&sdk.TxMsgData{
+		Data: []*sdk.MsgData{{MsgType: sdk.MsgTypeURL(&wasm.MsgExecuteContract{}), Data: executeResp}}, //nolint:staticcheck
+	}).Marshal()
+	suite.Require().NoError(err)
+
+	ack := ibcchanneltypes.NewResultAcknowledgement(txMsgData)
+	err = ibcModule.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	record, found := app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.MsgResponse{
+		{TypeUrl: sdk.MsgTypeURL(&wasm.MsgExecuteContract{}), Data: `{"data":"AQID"}`},
+	}, record.Responses)
+}
+
+func (suite *KeeperTestSuite) TestPacketAcknowledgedWithError() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	ack := ibcchanneltypes.NewErrorAcknowledgement(types.ErrUnauthorized)
+	err := ibcModule.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+	suite.Require().NoError(err)
+
+	record, found := app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PacketStatusError, record.Status)
+	suite.Require().Equal(ack.GetError(), record.Error)
+	suite.Require().Empty(record.Responses)
+
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketAcknowledged,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyError, ack.GetError()),
+		),
+	}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestPacketTimedOut() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	record, found := app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PacketStatusTimeout, record.Status)
+}
+
+func (suite *KeeperTestSuite) TestPacketWithoutRecord() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	// packets sent before the module started to keep records don't have one.
+	// the callbacks should not fail in this case, nor create a record
+	packet := ibcchanneltypes.Packet{Sequence: 1, SourcePort: portID, SourceChannel: "channel-69"}
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPacketQueued() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	var packetData icatypes.InterchainAccountPacketData
+	err = icatypes.ModuleCdc.UnmarshalJSON(packet.Data, &packetData)
+	suite.Require().NoError(err)
+
+	mockMessages := getMockMessages(suite.interchainAccountAddress(ctx))
+
+	queuedPacket, found := app.EnvoyKeeper.GetQueuedPacket(ctx, suite.path1.EndpointA.ConnectionID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.QueuedPacket{
+		Id:           1,
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+		ChannelId:    packet.SourceChannel,
+		Sequence:     packet.Sequence,
+		Authority:    authority.String(),
+		MsgTypeUrls:  []string{"/" + proto.MessageName(mockMessages[0]), "/" + proto.MessageName(mockMessages[1])},
+		Data:         packetData.Data,
+		ExpiryTime:   ctx.BlockTime().Add(app.EnvoyKeeper.GetParams(ctx).QueueExpiry),
+	}, queuedPacket)
+	suite.Require().Equal(uint64(2), app.EnvoyKeeper.GetNextQueuedPacketID(ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneQueuedPackets() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+
+	app.EnvoyKeeper.SetQueuedPacket(ctx, types.QueuedPacket{
+		Id:           1,
+		ConnectionId: "connection-0",
+		ExpiryTime:   ctx.BlockTime(),
+	})
+	app.EnvoyKeeper.SetQueuedPacket(ctx, types.QueuedPacket{
+		Id:           2,
+		ConnectionId: "connection-1",
+		ExpiryTime:   ctx.BlockTime().Add(1),
+	})
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	_, found := app.EnvoyKeeper.GetQueuedPacket(ctx, "connection-0", 1)
+	suite.Require().False(found)
+
+	_, found = app.EnvoyKeeper.GetQueuedPacket(ctx, "connection-1", 2)
+	suite.Require().True(found)
+}
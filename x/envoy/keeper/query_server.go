@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibccore "github.com/cosmos/ibc-go/v6/modules/core/exported"
@@ -36,7 +41,11 @@ func (qs queryServer) Account(goCtx context.Context, req *types.QueryAccountRequ
 	return &types.QueryAccountResponse{Account: account}, nil
 }
 
-func (qs queryServer) Accounts(goCtx context.Context, _ *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+func (qs queryServer) Accounts(goCtx context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	_, portID, err := qs.k.GetOwnerAndPortID()
@@ -48,24 +57,268 @@ func (qs queryServer) Accounts(goCtx context.Context, _ *types.QueryAccountsRequ
 	// we filter the channels by provided method GetAllChannelsWithPortPrefix()
 	// note, this will probably return valid ICA channels for envoy module
 	// but we will compare PortID explicitly to keep things safe
-	allChannels := qs.k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
-	accounts := []*types.AccountInfo{}
-
-	for _, channel := range allChannels {
+	channels := []ibcchanneltypes.IdentifiedChannel{}
+	for _, channel := range qs.k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
 		// allChannels is filtered by portID prefix, but not equality
 		// the following if-condition may seem unnecessary
 		// but, must remain for future-proofing
 		if channel.PortId == portID {
-			account, err := qs.queryAccountFromChannel(ctx, channel.ChannelId, portID)
-			if err != nil {
-				return nil, err
-			}
+			channels = append(channels, channel)
+		}
+	}
+
+	// the channel keeper doesn't give us access to its store, so we can't use
+	// the SDK's store-based pagination here. instead, paginate the channels
+	// that were already loaded.
+	channels, pageRes, err := paginateChannels(channels, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	accounts := []*types.AccountInfo{}
+	for _, channel := range channels {
+		// a single broken channel (e.g. one whose connection or light client
+		// can't be found) should not fail the whole query. the account is
+		// instead returned with the error annotated.
+		accounts = append(accounts, qs.queryAccountFromChannel(ctx, channel))
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) Packet(goCtx context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packet, found := qs.k.GetPacket(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("packet record: channelID (%s) sequence (%d)", req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryPacketResponse{Packet: packet}, nil
+}
+
+func (qs queryServer) Packets(goCtx context.Context, req *types.QueryPacketsRequest) (*types.QueryPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packets := []types.Packet{}
+
+	pageRes, err := query.FilteredPaginate(qs.k.GetPacketPrefixStore(ctx), req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var packet types.Packet
+		if err := qs.k.cdc.Unmarshal(value, &packet); err != nil {
+			return false, err
+		}
+
+		// if a status is specified, skip packets of other statuses
+		if req.Status != types.PacketStatusUnspecified && packet.Status != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			packets = append(packets, packet)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) QueuedPackets(goCtx context.Context, req *types.QueryQueuedPacketsRequest) (*types.QueryQueuedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	queuedPackets := []types.QueuedPacket{}
+
+	pageRes, err := query.Paginate(qs.k.GetQueuedPacketPrefixStore(ctx, req.ConnectionId), req.Pagination, func(_, value []byte) error {
+		var queuedPacket types.QueuedPacket
+		if err := qs.k.cdc.Unmarshal(value, &queuedPacket); err != nil {
+			return err
+		}
+
+		queuedPackets = append(queuedPackets, queuedPacket)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryQueuedPacketsResponse{QueuedPackets: queuedPackets, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) AccountBalances(goCtx context.Context, req *types.QueryAccountBalancesRequest) (*types.QueryAccountBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountBalances, found := qs.k.GetAccountBalances(ctx, req.ConnectionId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("account balances: connectionID (%s)", req.ConnectionId)
+	}
+
+	return &types.QueryAccountBalancesResponse{Balances: accountBalances}, nil
+}
+
+func (qs queryServer) SimulateMessages(goCtx context.Context, req *types.QuerySimulateMessagesRequest) (*types.QuerySimulateMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	_, portID, err := qs.k.GetOwnerAndPortID()
+	if err != nil {
+		return nil, err
+	}
+
+	address, found := qs.k.icaControllerKeeper.GetInterchainAccountAddress(ctx, req.Msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no interchain account exists on %s", req.Msg.ConnectionId)
+	}
+
+	channelID, found := qs.k.icaControllerKeeper.GetOpenActiveChannel(ctx, req.Msg.ConnectionId, portID)
+	if !found {
+		return nil, types.ErrChannelNotOpen.Wrapf("connection ID (%s)", req.Msg.ConnectionId)
+	}
+
+	data, msgTypeURLs, err := qs.k.buildCosmosTx(ctx, req.Msg.ConnectionId, req.Msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = qs.k.authorizeSendMessages(ctx, req.Msg.Authority, req.Msg.ConnectionId, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	packetData := newCosmosTxPacketData(data)
+
+	return &types.QuerySimulateMessagesResponse{
+		InterchainAccount: address,
+		ChannelId:         channelID,
+		PacketSize:        uint64(len(packetData.GetBytes())),
+		MsgTypeUrls:       msgTypeURLs,
+	}, nil
+}
 
-			accounts = append(accounts, account)
+func (qs queryServer) AuthorityPolicy(goCtx context.Context, req *types.QueryAuthorityPolicyRequest) (*types.QueryAuthorityPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, found := qs.k.GetAuthorityPolicy(ctx, req.Authority)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("authority policy: address (%s)", req.Authority)
+	}
+
+	return &types.QueryAuthorityPolicyResponse{
+		Policy:   policy,
+		Spending: qs.k.getCurrentAuthoritySpending(ctx, policy),
+	}, nil
+}
+
+func (qs queryServer) AuthorityPolicies(goCtx context.Context, req *types.QueryAuthorityPoliciesRequest) (*types.QueryAuthorityPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policies := []types.AuthorityPolicy{}
+
+	pageRes, err := query.Paginate(qs.k.GetAuthorityPolicyPrefixStore(ctx), req.Pagination, func(_, value []byte) error {
+		var policy types.AuthorityPolicy
+		if err := qs.k.cdc.Unmarshal(value, &policy); err != nil {
+			return err
+		}
+
+		policies = append(policies, policy)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryAuthorityPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) ScheduledOperation(goCtx context.Context, req *types.QueryScheduledOperationRequest) (*types.QueryScheduledOperationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	op, found := qs.k.GetScheduledOperation(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scheduled operation: id (%d)", req.Id)
+	}
+
+	return &types.QueryScheduledOperationResponse{Operation: op}, nil
+}
+
+func (qs queryServer) ScheduledOperations(goCtx context.Context, req *types.QueryScheduledOperationsRequest) (*types.QueryScheduledOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ops := []types.ScheduledOperation{}
+
+	pageRes, err := query.Paginate(qs.k.GetScheduledOperationPrefixStore(ctx), req.Pagination, func(_, value []byte) error {
+		var op types.ScheduledOperation
+		if err := qs.k.cdc.Unmarshal(value, &op); err != nil {
+			return err
 		}
+
+		ops = append(ops, op)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
 
-	return &types.QueryAccountsResponse{Accounts: accounts}, nil
+	// the total is over all operations, not only those in the page
+	totalReserved := sdk.NewCoins()
+	qs.k.IterateScheduledOperations(ctx, func(op types.ScheduledOperation) bool {
+		totalReserved = totalReserved.Add(op.ReservedAmount...)
+		return false
+	})
+
+	return &types.QueryScheduledOperationsResponse{
+		Operations:    ops,
+		TotalReserved: totalReserved,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (qs queryServer) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: qs.k.GetParams(ctx)}, nil
 }
 
 func (qs queryServer) queryAccount(ctx sdk.Context, connectionID, portID string) (*types.AccountInfo, error) {
@@ -97,45 +350,171 @@ func (qs queryServer) queryAccount(ctx sdk.Context, connectionID, portID string)
 		return nil, err
 	}
 
-	return composeAccountInfo(address, connectionID, portID, channelID, connection, channel), nil
+	hostChainID, err := qs.getHostChainID(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := composeAccountInfo(ctx, portID, channelID, channel)
+	fillAccountConnectionInfo(account, connectionID, connection)
+	account.Address = address
+	account.Host.ChainId = hostChainID
+	account.Recovery = qs.getRecovery(ctx, connectionID)
+
+	return account, nil
 }
 
-func (qs queryServer) queryAccountFromChannel(ctx sdk.Context, channelID, portID string) (*types.AccountInfo, error) {
-	connectionID, connection, err := qs.k.channelKeeper.GetChannelConnection(ctx, portID, channelID)
+// queryAccountFromChannel composes the info of the account associated with
+// the given channel. Unlike queryAccount, it doesn't fail if some of the info
+// can't be found, but records the error in the returned account info.
+func (qs queryServer) queryAccountFromChannel(ctx sdk.Context, channel ibcchanneltypes.IdentifiedChannel) *types.AccountInfo {
+	account := composeAccountInfo(ctx, channel.PortId, channel.ChannelId, ibcchanneltypes.Channel{
+		State:          channel.State,
+		Ordering:       channel.Ordering,
+		Counterparty:   channel.Counterparty,
+		ConnectionHops: channel.ConnectionHops,
+		Version:        channel.Version,
+	})
+
+	connectionID, connection, err := qs.k.channelKeeper.GetChannelConnection(ctx, channel.PortId, channel.ChannelId)
 	if err != nil {
-		return nil, err
+		account.Error = err.Error()
+		return account
 	}
 
-	channel, found := qs.k.channelKeeper.GetChannel(ctx, portID, channelID)
+	fillAccountConnectionInfo(account, connectionID, connection)
+	account.Recovery = qs.getRecovery(ctx, connectionID)
+
+	address, found := qs.k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, channel.PortId)
 	if !found {
-		return nil, sdkerrors.ErrNotFound.Wrapf("IBC channel: portID (%s) channelID (%s)", portID, channelID)
+		account.Error = sdkerrors.ErrNotFound.Wrapf("envoy module-owned ICA: connection ID (%s)", connectionID).Error()
+		return account
 	}
 
-	address, found := qs.k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	account.Address = address
+
+	hostChainID, err := qs.getHostChainID(ctx, channel.PortId, channel.ChannelId)
+	if err != nil {
+		account.Error = err.Error()
+		return account
+	}
+
+	account.Host.ChainId = hostChainID
+
+	return account
+}
+
+// getHostChainID returns the ID of the chain at the other end of the given
+// channel, as recorded in the state of the light client tracking it.
+func (qs queryServer) getHostChainID(ctx sdk.Context, portID, channelID string) (string, error) {
+	_, clientState, err := qs.k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+
+	// the exported ClientState interface doesn't have a method for getting the
+	// chain ID, but tendermint light clients do
+	tmClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return "", sdkerrors.ErrInvalidType.Wrapf("client state of type %s does not have a chain ID", clientState.ClientType())
+	}
+
+	return tmClientState.GetChainID(), nil
+}
+
+// getRecovery returns the recovery record of the given connection, or nil if
+// the connection doesn't have one.
+func (qs queryServer) getRecovery(ctx sdk.Context, connectionID string) *types.Recovery {
+	recovery, found := qs.k.GetRecovery(ctx, connectionID)
 	if !found {
-		return nil, sdkerrors.ErrNotFound.Wrapf("envoy module-owned ICA: connection ID (%s)", connectionID)
+		return nil
 	}
 
-	return composeAccountInfo(address, connectionID, portID, channelID, connection, channel), nil
+	return &recovery
 }
 
-func composeAccountInfo(
-	address, connectionID, portID, channelID string,
-	connection ibccore.ConnectionI, channel ibcchanneltypes.Channel,
-) *types.AccountInfo {
+// composeAccountInfo composes the account info that can be derived from the
+// channel alone. The connection info, address and host chain ID are filled in
+// later.
+func composeAccountInfo(ctx sdk.Context, portID, channelID string, channel ibcchanneltypes.Channel) *types.AccountInfo {
 	return &types.AccountInfo{
 		Controller: &types.ChainInfo{
-			ClientId:     connection.GetClientID(),
-			ConnectionId: connectionID,
-			PortId:       portID,
-			ChannelId:    channelID,
+			PortId:    portID,
+			ChannelId: channelID,
+			ChainId:   ctx.ChainID(),
 		},
 		Host: &types.ChainInfo{
-			ClientId:     connection.GetCounterparty().GetClientID(),
-			ConnectionId: connection.GetCounterparty().GetConnectionID(),
-			PortId:       channel.Counterparty.PortId,
-			ChannelId:    channel.Counterparty.ChannelId,
+			PortId:    channel.Counterparty.PortId,
+			ChannelId: channel.Counterparty.ChannelId,
 		},
-		Address: address,
+		ChannelState:    channel.State.String(),
+		ChannelOrdering: channel.Ordering.String(),
+		ChannelVersion:  channel.Version,
 	}
 }
+
+func fillAccountConnectionInfo(account *types.AccountInfo, connectionID string, connection ibccore.ConnectionI) {
+	account.Controller.ClientId = connection.GetClientID()
+	account.Controller.ConnectionId = connectionID
+	account.Host.ClientId = connection.GetCounterparty().GetClientID()
+	account.Host.ConnectionId = connection.GetCounterparty().GetConnectionID()
+}
+
+// paginateChannels returns the page of the given channels specified by the
+// page request. The channels are expected to be sorted by channel ID, in the
+// same order as they are in the store, and the keys are the channel IDs.
+func paginateChannels(
+	channels []ibcchanneltypes.IdentifiedChannel,
+	pageReq *query.PageRequest,
+) ([]ibcchanneltypes.IdentifiedChannel, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]ibcchanneltypes.IdentifiedChannel, 0, len(channels))
+		for i := len(channels) - 1; i >= 0; i-- {
+			reversed = append(reversed, channels[i])
+		}
+		channels = reversed
+	}
+
+	start := uint64(len(channels))
+	if pageReq.Key != nil {
+		key := string(pageReq.Key)
+		for i, channel := range channels {
+			if (!pageReq.Reverse && channel.ChannelId >= key) || (pageReq.Reverse && channel.ChannelId <= key) {
+				start = uint64(i)
+				break
+			}
+		}
+	} else if pageReq.Offset < start {
+		start = pageReq.Offset
+	}
+
+	// compare the limit to the number of channels left instead of adding it to
+	// start, which could overflow if the limit is huge
+	end := uint64(len(channels))
+	if limit < end-start {
+		end = start + limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(channels)) {
+		pageRes.NextKey = []byte(channels[end].ChannelId)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(channels))
+	}
+
+	return channels[start:end], pageRes, nil
+}
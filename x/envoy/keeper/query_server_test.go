@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 
 	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
@@ -45,29 +52,207 @@ func (suite *KeeperTestSuite) TestQueryAccounts() {
 	app := getMarsApp(suite.hub)
 	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
 
+	// a channel whose connection doesn't exist. it should be annotated with an
+	// error instead of failing the whole query
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, "channel-99", ibcchanneltypes.Channel{
+		State:          ibcchanneltypes.OPEN,
+		Ordering:       ibcchanneltypes.ORDERED,
+		Counterparty:   ibcchanneltypes.NewCounterparty(icatypes.HostPortID, "channel-99"),
+		ConnectionHops: []string{"connection-99"},
+		Version:        "version",
+	})
+
 	res, err := queryServer.Accounts(ctx, &types.QueryAccountsRequest{})
 	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 3)
 
 	res.Accounts[0].Address = ""
 	suite.Require().Equal(composeAccountInfoFromPath(suite.path1), res.Accounts[0])
 
 	res.Accounts[1].Address = ""
 	suite.Require().Equal(composeAccountInfoFromPath(suite.path2), res.Accounts[1])
+
+	suite.Require().Equal("channel-99", res.Accounts[2].Controller.ChannelId)
+	suite.Require().Equal("STATE_OPEN", res.Accounts[2].ChannelState)
+	suite.Require().Empty(res.Accounts[2].Address)
+	suite.Require().NotEmpty(res.Accounts[2].Error)
+
+	// paginate through the accounts one at a time
+	channelIDs := []string{}
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	for {
+		res, err = queryServer.Accounts(ctx, &types.QueryAccountsRequest{Pagination: pageReq})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Accounts, 1)
+
+		channelIDs = append(channelIDs, res.Accounts[0].Controller.ChannelId)
+
+		if res.Pagination.NextKey == nil {
+			break
+		}
+
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+
+	suite.Require().Equal([]string{suite.path1.EndpointA.ChannelID, suite.path2.EndpointA.ChannelID, "channel-99"}, channelIDs)
+
+	// offset pagination
+	res, err = queryServer.Accounts(ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Offset: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// a huge limit must not overflow when added to the offset
+	res, err = queryServer.Accounts(ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: math.MaxUint64}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 2)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func composeAccountInfoFromPath(path *ibctesting.Path) *types.AccountInfo {
+	channel := path.EndpointA.GetChannel()
+
 	return &types.AccountInfo{
 		Controller: &types.ChainInfo{
 			ClientId:     path.EndpointA.ClientID,
 			ConnectionId: path.EndpointA.ConnectionID,
 			PortId:       portID,
 			ChannelId:    path.EndpointA.ChannelID,
+			ChainId:      path.EndpointA.Chain.ChainID,
 		},
 		Host: &types.ChainInfo{
 			ClientId:     path.EndpointB.ClientID,
 			ConnectionId: path.EndpointB.ConnectionID,
 			PortId:       icatypes.HostPortID,
 			ChannelId:    path.EndpointB.ChannelID,
+			ChainId:      path.EndpointB.Chain.ChainID,
 		},
+		ChannelState:    "STATE_OPEN",
+		ChannelOrdering: "ORDER_ORDERED",
+		ChannelVersion:  channel.Version,
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPackets() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
+
+	for _, packet := range mockGenesisState.Packets {
+		app.EnvoyKeeper.SetPacket(ctx, packet)
+	}
+
+	// query a single packet - should succeed
+	res, err := queryServer.Packet(ctx, &types.QueryPacketRequest{ChannelId: "channel-0", Sequence: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(mockGenesisState.Packets[1].MsgTypeUrls, res.Packet.MsgTypeUrls)
+
+	// query a non-existent packet - should fail
+	_, err = queryServer.Packet(ctx, &types.QueryPacketRequest{ChannelId: "channel-0", Sequence: 3})
+	suite.Require().Error(err)
+
+	// query all packets
+	resAll, err := queryServer.Packets(ctx, &types.QueryPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.Packets, 2)
+
+	// query packets filtered by status
+	resAll, err = queryServer.Packets(ctx, &types.QueryPacketsRequest{Status: types.PacketStatusPending})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.Packets, 1)
+	suite.Require().Equal(uint64(2), resAll.Packets[0].Sequence)
+
+	resAll, err = queryServer.Packets(ctx, &types.QueryPacketsRequest{Status: types.PacketStatusTimeout})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.Packets, 0)
+}
+
+func (suite *KeeperTestSuite) TestQuerySimulateMessages() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
+
+	anys := []*codectypes.Any{}
+	for _, protoMsg := range getMockMessages(suite.interchainAccountAddress(ctx)) {
+		any, err := codectypes.NewAnyWithValue(protoMsg)
+		suite.Require().NoError(err)
+
+		anys = append(anys, any)
+	}
+
+	msg := types.MsgSendMessages{
+		Authority:    authority.String(),
+		ConnectionId: suite.path1.EndpointA.ConnectionID,
+		Messages:     anys,
+	}
+
+	res, err := queryServer.SimulateMessages(ctx, &types.QuerySimulateMessagesRequest{Msg: msg})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.interchainAccountAddress(ctx), res.InterchainAccount)
+	suite.Require().Equal(suite.path1.EndpointA.ChannelID, res.ChannelId)
+	suite.Require().Equal([]string{"/cosmos.gov.v1.MsgVote", "/cosmwasm.wasm.v1.MsgExecuteContract"}, res.MsgTypeUrls)
+
+	// no packet should have been sent by the simulation
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	// the size should be that of the packet that is actually sent
+	packet := suite.sendMockMessages(ctx)
+	suite.Require().Equal(uint64(len(packet.Data)), res.PacketSize)
+
+	// the simulation should fail if the message would fail
+	invalidMsg := msg
+	invalidMsg.Authority = sender
+	_, err = queryServer.SimulateMessages(ctx, &types.QuerySimulateMessagesRequest{Msg: invalidMsg})
+	suite.Require().Error(err)
+
+	invalidMsg = msg
+	invalidMsg.ConnectionId = suite.path2.EndpointA.ConnectionID
+	_, err = queryServer.SimulateMessages(ctx, &types.QuerySimulateMessagesRequest{Msg: invalidMsg})
+	suite.Require().Error(err)
+
+	invalidMsg = msg
+	invalidMsg.Messages = anys[:0]
+	_, err = queryServer.SimulateMessages(ctx, &types.QuerySimulateMessagesRequest{Msg: invalidMsg})
+	suite.Require().Error(err)
+
+	// the simulation should fail if the channel is closed
+	suite.timeOutMockMessages(ctx)
+
+	_, err = queryServer.SimulateMessages(ctx, &types.QuerySimulateMessagesRequest{Msg: msg})
+	suite.Require().ErrorIs(err, types.ErrChannelNotOpen)
+}
+
+func (suite *KeeperTestSuite) TestQueryScheduledOperations() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
+
+	id1 := suite.scheduleMockSendFunds(ctx, suite.path1.EndpointA.ChannelID, 3)
+	id2 := suite.scheduleMockSendFunds(ctx, suite.path2.EndpointA.ChannelID, 2)
+
+	res, err := queryServer.ScheduledOperation(ctx, &types.QueryScheduledOperationRequest{Id: id2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(id2, res.Operation.Id)
+	suite.Require().Equal(suite.path2.EndpointA.ChannelID, res.Operation.SendFunds.ChannelId)
+
+	_, err = queryServer.ScheduledOperation(ctx, &types.QueryScheduledOperationRequest{Id: id2 + 1})
+	suite.Require().Error(err)
+
+	// the total reserved amount is over all operations, regardless of the page
+	resAll, err := queryServer.ScheduledOperations(ctx, &types.QueryScheduledOperationsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.Operations, 1)
+	suite.Require().Equal(id1, resAll.Operations[0].Id)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(500))), resAll.TotalReserved)
+}
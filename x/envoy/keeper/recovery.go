@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// MarkForRecovery creates a recovery record for the connection associated with
+// the given ICA channel, which is to be closed as a packet on it has timed out.
+// The EndBlocker will then attempt to reopen a new channel.
+//
+// If the connection already has a recovery record, it is left as-is, so that
+// the backoff isn't reset.
+//
+// The IBC core module closes the channel of a timed out packet only after the
+// callback, so if the channel is already closed, it was closed before the
+// packet timed out, e.g. by governance via MsgCloseAccountChannel in order to
+// reopen it with another version. The account isn't marked for recovery then,
+// as that would reopen the channel with the previous version.
+func (k Keeper) MarkForRecovery(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if found && channel.State == ibcchanneltypes.CLOSED {
+		return nil
+	}
+
+	connectionID, _, err := k.channelKeeper.GetChannelConnection(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetRecovery(ctx, connectionID); found {
+		return nil
+	}
+
+	k.SetRecovery(ctx, types.Recovery{
+		ConnectionId:    connectionID,
+		ChannelId:       channelID,
+		Attempts:        0,
+		NextAttemptTime: ctx.BlockTime(),
+	})
+
+	k.Logger(ctx).Info(
+		"marked interchain account for recovery",
+		"connectionID", connectionID,
+		"channelID", channelID,
+	)
+
+	return nil
+}
+
+// CompleteRecovery deletes the recovery record for the connection associated
+// with the given ICA channel, which has just been opened.
+//
+// If the connection doesn't have a recovery record (e.g. the channel is the
+// first one for the interchain account), this function does nothing.
+func (k Keeper) CompleteRecovery(ctx sdk.Context, portID, channelID string) error {
+	connectionID, _, err := k.channelKeeper.GetChannelConnection(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetRecovery(ctx, connectionID); !found {
+		return nil
+	}
+
+	k.DeleteRecovery(ctx, connectionID)
+
+	k.Logger(ctx).Info(
+		"recovered interchain account",
+		"connectionID", connectionID,
+		"channelID", channelID,
+	)
+
+	return nil
+}
+
+// AttemptRecoveries goes through the recovery records, and for each one that is
+// due, initiates a new channel handshake through the ICA controller.
+//
+// The delay between attempts starts at the RecoveryBackoff param and doubles
+// after each attempt. Once RecoveryMaxAttempts attempts have been made, the
+// module gives up, and the record is kept so that operators can see the account
+// is unreachable. In this case the channel needs to be reopened by sending a
+// MsgRegisterAccount.
+//
+// Initiating a handshake only sends the MsgChannelOpenInit. The recovery is
+// considered complete when the handshake is acknowledged by the host chain, at
+// which point the record is deleted in the OnChanOpenAck callback.
+func (k Keeper) AttemptRecoveries(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	owner, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the ICA controller port ID", "error", err)
+		return
+	}
+
+	// collect the records first, as we can't write to the store while iterating
+	recoveries := []types.Recovery{}
+	k.IterateRecoveries(ctx, func(recovery types.Recovery) bool {
+		recoveries = append(recoveries, recovery)
+		return false
+	})
+
+	for _, recovery := range recoveries {
+		// the channel may have been reopened by other means, e.g. a handshake
+		// initiated by MsgRegisterAccount before the module started to receive
+		// ICA callbacks
+		if channelID, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, recovery.ConnectionId, portID); found {
+			k.DeleteRecovery(ctx, recovery.ConnectionId)
+
+			k.Logger(ctx).Info(
+				"recovered interchain account",
+				"connectionID", recovery.ConnectionId,
+				"channelID", channelID,
+			)
+
+			continue
+		}
+
+		if recovery.Attempts >= params.RecoveryMaxAttempts || ctx.BlockTime().Before(recovery.NextAttemptTime) {
+			continue
+		}
+
+		// use a cached context, so that a failed attempt doesn't leave any state
+		// changes behind
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		// choose the version the same way as in MsgRegisterAccount
+		version, err := k.getAccountChannelVersion(cacheCtx, recovery.ConnectionId, portID)
+		if err == nil {
+			err = k.icaControllerKeeper.RegisterInterchainAccount(cacheCtx, recovery.ConnectionId, owner.String(), version)
+		}
+
+		if err != nil {
+			recovery.LastError = err.Error()
+
+			k.Logger(ctx).Error(
+				"failed to initiate interchain account channel handshake",
+				"connectionID", recovery.ConnectionId,
+				"attempt", recovery.Attempts+1,
+				"error", err,
+			)
+		} else {
+			// this also emits the events, which the IBC relayer listens to
+			writeCache()
+
+			recovery.LastError = ""
+
+			k.Logger(ctx).Info(
+				"initiated interchain account channel handshake",
+				"connectionID", recovery.ConnectionId,
+				"attempt", recovery.Attempts+1,
+			)
+		}
+
+		recovery.Attempts++
+		recovery.NextAttemptTime = ctx.BlockTime().Add(recoveryDelay(params.RecoveryBackoff, recovery.Attempts))
+
+		k.SetRecovery(ctx, recovery)
+	}
+}
+
+// recoveryDelay returns the delay before the next recovery attempt, given the
+// number of attempts that have been made. The delay is the backoff doubled
+// after each attempt but the first, capped to not overflow.
+func recoveryDelay(backoff time.Duration, attempts uint32) time.Duration {
+	delay := backoff
+	for i := uint32(1); i < attempts; i++ {
+		if delay > math.MaxInt64/2 {
+			break
+		}
+
+		delay *= 2
+	}
+
+	return delay
+}
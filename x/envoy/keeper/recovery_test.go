@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy"
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// timeOutMockMessages sends the mock messages to outpost 1, then times out the
+// packet and closes the channel, the same way the IBC core module does for
+// ordered channels. It assumes an ICA has already been registered on outpost 1.
+func (suite *KeeperTestSuite) timeOutMockMessages(ctx sdk.Context) ibcchanneltypes.Packet {
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+
+	packet := suite.sendMockMessages(ctx)
+
+	err := ibcModule.OnTimeoutPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	suite.Require().True(found)
+
+	channel.State = ibcchanneltypes.CLOSED
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, packet.SourcePort, packet.SourceChannel, channel)
+
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestRecovery() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	ibcModule := envoy.NewIBCModule(app.EnvoyKeeper)
+	queryServer := keeper.NewQueryServerImpl(app.EnvoyKeeper)
+	connectionID := suite.path1.EndpointA.ConnectionID
+	backoff := app.EnvoyKeeper.GetParams(ctx).RecoveryBackoff
+
+	packet := suite.timeOutMockMessages(ctx)
+
+	// the connection should have been marked for recovery
+	recovery, found := app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Recovery{
+		ConnectionId:    connectionID,
+		ChannelId:       packet.SourceChannel,
+		Attempts:        0,
+		NextAttemptTime: ctx.BlockTime(),
+	}, recovery)
+
+	// the recovery should be shown in the accounts query
+	res, err := queryServer.Accounts(ctx, &types.QueryAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(&recovery, res.Accounts[0].Recovery)
+
+	// the first attempt should be made right away
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	channelSequence := app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	recovery, found = app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), recovery.Attempts)
+	suite.Require().Equal(ctx.BlockTime().Add(backoff), recovery.NextAttemptTime)
+	suite.Require().Empty(recovery.LastError)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(ibcchanneltypes.EventTypeChannelOpenInit, events[0].Type)
+
+	channelID := ibcchanneltypes.FormatChannelIdentifier(channelSequence)
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(ibcchanneltypes.INIT, channel.State)
+
+	// no attempt should be made before the backoff has passed
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	recovery, found = app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), recovery.Attempts)
+
+	// after the backoff, the second attempt should be made, and the delay should
+	// be doubled
+	ctx = ctx.WithBlockTime(recovery.NextAttemptTime)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	recovery, found = app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(2), recovery.Attempts)
+	suite.Require().Equal(ctx.BlockTime().Add(2*backoff), recovery.NextAttemptTime)
+
+	// once the host chain acknowledges the handshake, the recovery is complete
+	err = ibcModule.OnChanOpenAck(ctx, portID, channelID, "", "")
+	suite.Require().NoError(err)
+
+	_, found = app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRecoveryMaxAttempts() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	params := app.EnvoyKeeper.GetParams(ctx)
+	params.RecoveryMaxAttempts = 1
+	app.EnvoyKeeper.SetParams(ctx, params)
+
+	suite.timeOutMockMessages(ctx)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	// the module should give up after the max number of attempts, but keep the
+	// record so that the account is shown as unreachable
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * params.RecoveryBackoff))
+	channelSequence := app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	recovery, found := app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), recovery.Attempts)
+	suite.Require().Equal(channelSequence, app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+}
+
+func (suite *KeeperTestSuite) TestRecoveryFailedAttempt() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+
+	// a connection that doesn't exist, so the handshake can't be initiated
+	app.EnvoyKeeper.SetRecovery(ctx, types.Recovery{
+		ConnectionId:    "connection-9",
+		ChannelId:       "channel-9",
+		NextAttemptTime: ctx.BlockTime(),
+	})
+
+	channelSequence := app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	recovery, found := app.EnvoyKeeper.GetRecovery(ctx, "connection-9")
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), recovery.Attempts)
+	suite.Require().NotEmpty(recovery.LastError)
+
+	// the failed attempt shouldn't have left any state changes
+	suite.Require().Equal(channelSequence, app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+}
+
+func (suite *KeeperTestSuite) TestRecoveryChannelReopened() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	connectionID := suite.path1.EndpointA.ConnectionID
+
+	// the account has an open channel, e.g. reopened by a MsgRegisterAccount
+	// while the module wasn't receiving callbacks
+	app.EnvoyKeeper.SetRecovery(ctx, types.Recovery{
+		ConnectionId:    connectionID,
+		ChannelId:       "channel-9",
+		NextAttemptTime: ctx.BlockTime(),
+	})
+
+	envoy.EndBlocker(ctx, app.EnvoyKeeper)
+
+	_, found := app.EnvoyKeeper.GetRecovery(ctx, connectionID)
+	suite.Require().False(found)
+}
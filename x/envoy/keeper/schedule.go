@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// ExecuteScheduledOperations executes the scheduled operations that are due,
+// in the order of their ids, and deletes those that have completed all their
+// repetitions.
+//
+// Each operation is executed at most once per block. If several of its
+// executions are overdue, e.g. after a chain halt, they are caught up over the
+// following blocks.
+//
+// An execution that fails still counts towards the operation's repetitions, so
+// that a payload that can no longer succeed, e.g. because the interchain
+// account's channel is closed, doesn't fail again every block. The error is
+// recorded in the operation.
+func (k Keeper) ExecuteScheduledOperations(ctx sdk.Context) {
+	// collect the operations first, as we can't write to the store while
+	// iterating
+	dueOps := []types.ScheduledOperation{}
+	k.IterateScheduledOperations(ctx, func(op types.ScheduledOperation) bool {
+		if !ctx.BlockTime().Before(op.NextExecutionTime()) {
+			dueOps = append(dueOps, op)
+		}
+
+		return false
+	})
+
+	for _, op := range dueOps {
+		cost := op.ExecutionCost()
+
+		// use a cached context, so that a failed execution doesn't leave any
+		// state changes behind
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		op.Executions++
+		op.ReservedAmount = marsutils.SaturateSub(op.ReservedAmount, cost)
+
+		if err := k.executeOperation(cacheCtx, op); err != nil {
+			op.LastError = err.Error()
+
+			k.Logger(ctx).Error(
+				"failed to execute scheduled envoy operation",
+				"id", op.Id,
+				"execution", op.Executions,
+				"error", err,
+			)
+		} else {
+			// this also emits the events, which the IBC relayer listens to
+			writeCache()
+
+			op.SpentAmount = op.SpentAmount.Add(cost...)
+			op.LastError = ""
+
+			k.Logger(ctx).Info(
+				"executed scheduled envoy operation",
+				"id", op.Id,
+				"execution", op.Executions,
+			)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOperationExecuted,
+				sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyExecution, strconv.FormatUint(uint64(op.Executions), 10)),
+				sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(op.LastError == "")),
+				sdk.NewAttribute(types.AttributeKeyError, op.LastError),
+			),
+		)
+
+		if op.Executions >= op.Repetitions {
+			k.DeleteScheduledOperation(ctx, op.Id)
+		} else {
+			k.SetScheduledOperation(ctx, op)
+		}
+	}
+}
+
+// executeOperation executes the payload of the given scheduled operation, as if
+// it were sent by the operation's authority.
+func (k Keeper) executeOperation(ctx sdk.Context, op types.ScheduledOperation) error {
+	ms := msgServer{k}
+
+	if op.SendFunds != nil {
+		_, err := ms.SendFunds(sdk.WrapSDKContext(ctx), op.SendFunds)
+		return err
+	}
+
+	if op.SendMessages != nil {
+		_, err := ms.SendMessages(sdk.WrapSDKContext(ctx), op.SendMessages)
+		return err
+	}
+
+	return types.ErrInvalidSchedule.Wrapf("scheduled operation %d has no payload", op.Id)
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// scheduleMockSendFunds schedules sending 100 umars through the given channel
+// every hour, starting an hour from now, for the given number of repetitions.
+func (suite *KeeperTestSuite) scheduleMockSendFunds(ctx sdk.Context, channelID string, repetitions uint32) uint64 {
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	res, err := msgServer.ScheduleOperation(sdk.WrapSDKContext(ctx), &types.MsgScheduleOperation{
+		Authority: authority.String(),
+		SendFunds: &types.MsgSendFunds{
+			Authority: authority.String(),
+			ChannelId: channelID,
+			Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+		},
+		StartTime:   ctx.BlockTime().Add(time.Hour),
+		Interval:    time.Hour,
+		Repetitions: repetitions,
+	})
+	suite.Require().NoError(err)
+
+	return res.Id
+}
+
+func (suite *KeeperTestSuite) TestScheduleOperation() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	// only the module's authority can schedule operations
+	_, err := msgServer.ScheduleOperation(sdk.WrapSDKContext(ctx), &types.MsgScheduleOperation{
+		Authority: sender,
+		SendFunds: &types.MsgSendFunds{
+			Authority: sender,
+			ChannelId: suite.path1.EndpointA.ChannelID,
+			Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+		},
+		Repetitions: 1,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	id := suite.scheduleMockSendFunds(ctx, suite.path1.EndpointA.ChannelID, 3)
+	suite.Require().Equal(uint64(1), id)
+
+	// the funding for all repetitions is reserved
+	op, found := app.EnvoyKeeper.GetScheduledOperation(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(300))), op.ReservedAmount)
+	suite.Require().True(op.SpentAmount.Empty())
+
+	// relayer fees are reserved too, once per packet
+	res, err := msgServer.ScheduleOperation(sdk.WrapSDKContext(ctx), &types.MsgScheduleOperation{
+		Authority: authority.String(),
+		SendFunds: &types.MsgSendFunds{
+			Authority: authority.String(),
+			ChannelId: suite.path1.EndpointA.ChannelID,
+			Amount:    sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(50)), sdk.NewCoin("umars", sdk.NewInt(100))),
+			Fee:       &mockRelayerFee,
+		},
+		Repetitions: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Id)
+
+	op, found = app.EnvoyKeeper.GetScheduledOperation(ctx, res.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(50)), sdk.NewCoin("umars", sdk.NewInt(134))),
+		op.ReservedAmount,
+	)
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledOperation() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	id := suite.scheduleMockSendFunds(ctx, suite.path1.EndpointA.ChannelID, 3)
+
+	// only the module's authority can cancel operations
+	_, err := msgServer.CancelScheduledOperation(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledOperation{
+		Authority: sender,
+		Id:        id,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the operation must exist
+	_, err = msgServer.CancelScheduledOperation(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledOperation{
+		Authority: authority.String(),
+		Id:        id + 1,
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.CancelScheduledOperation(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledOperation{
+		Authority: authority.String(),
+		Id:        id,
+	})
+	suite.Require().NoError(err)
+
+	_, found := app.EnvoyKeeper.GetScheduledOperation(ctx, id)
+	suite.Require().False(found)
+
+	// the cancelled operation is no longer executed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Empty(suite.sentPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduledOperations() {
+	suite.SetupTest()
+
+	// registering the ICA changes the path's channel ID, so get the transfer
+	// channel ID first
+	channelID := suite.path1.EndpointA.ChannelID
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	startTime := ctx.BlockTime().Add(time.Hour)
+
+	id := suite.scheduleMockSendFunds(ctx, channelID, 2)
+
+	// not due yet
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	// the first execution
+	ctx = ctx.WithBlockTime(startTime).WithEventManager(sdk.NewEventManager())
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Len(suite.sentPackets(ctx), 1)
+	suite.Require().Equal(channelID, suite.lastSentPacket(ctx).SourceChannel)
+
+	op, found := app.EnvoyKeeper.GetScheduledOperation(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), op.Executions)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))), op.ReservedAmount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))), op.SpentAmount)
+	suite.Require().Empty(op.LastError)
+
+	// the operation is executed at most once per interval
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	// the second and last execution deletes the operation
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Len(suite.sentPackets(ctx), 1)
+
+	_, found = app.EnvoyKeeper.GetScheduledOperation(ctx, id)
+	suite.Require().False(found)
+
+	// 200 from the envoy module account, the rest from the community pool
+	balance := app.BankKeeper.GetBalance(ctx, owner, "umars")
+	suite.Require().True(balance.IsZero())
+
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	suite.Require().Equal(sdk.NewDec(300), communityPool.AmountOf("umars"))
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduledOperationsFailure() {
+	suite.SetupTest()
+
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	icaAddress := suite.interchainAccountAddress(ctx)
+	any, err := codectypes.NewAnyWithValue(getMockMessages(icaAddress)[0])
+	suite.Require().NoError(err)
+
+	// there is no interchain account on outpost 2, so the messages can't be
+	// sent there
+	res, err := msgServer.ScheduleOperation(sdk.WrapSDKContext(ctx), &types.MsgScheduleOperation{
+		Authority: authority.String(),
+		SendMessages: &types.MsgSendMessages{
+			Authority:    authority.String(),
+			ConnectionId: suite.path2.EndpointA.ConnectionID,
+			Messages:     []*codectypes.Any{any},
+		},
+		StartTime:   ctx.BlockTime(),
+		Interval:    time.Hour,
+		Repetitions: 2,
+	})
+	suite.Require().NoError(err)
+
+	// the failed execution counts towards the repetitions, and its error is
+	// recorded
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Empty(suite.sentPackets(ctx))
+
+	op, found := app.EnvoyKeeper.GetScheduledOperation(ctx, res.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), op.Executions)
+	suite.Require().NotEmpty(op.LastError)
+
+	// once the payload is fixed, e.g. by cancelling the operation and
+	// scheduling a new one, it succeeds
+	_, err = msgServer.CancelScheduledOperation(sdk.WrapSDKContext(ctx), &types.MsgCancelScheduledOperation{
+		Authority: authority.String(),
+		Id:        res.Id,
+	})
+	suite.Require().NoError(err)
+
+	res, err = msgServer.ScheduleOperation(sdk.WrapSDKContext(ctx), &types.MsgScheduleOperation{
+		Authority: authority.String(),
+		SendMessages: &types.MsgSendMessages{
+			Authority:    authority.String(),
+			ConnectionId: suite.path1.EndpointA.ConnectionID,
+			Messages:     []*codectypes.Any{any},
+		},
+		StartTime:   ctx.BlockTime(),
+		Repetitions: 1,
+	})
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.EnvoyKeeper.ExecuteScheduledOperations(ctx)
+	suite.Require().Len(suite.sentPackets(ctx), 1)
+
+	packet, found := app.EnvoyKeeper.GetPacket(ctx, suite.path1.EndpointA.ChannelID, suite.lastSentPacket(ctx).Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(authority.String(), packet.Authority)
+
+	_, found = app.EnvoyKeeper.GetScheduledOperation(ctx, res.Id)
+	suite.Require().False(found)
+}
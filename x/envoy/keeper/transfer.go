@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// RefundFundedTransfer returns the part of a failed or timed out ICS-20
+// transfer that was drawn from the community pool back to the community pool,
+// and deletes the transfer's record.
+//
+// This function must be called after the ICS-20 module has refunded the coins
+// to the envoy module account.
+//
+// Transfers not sent by the envoy module, or that did not draw funds from the
+// community pool, don't have a record, in which case this function does
+// nothing.
+func (k Keeper) RefundFundedTransfer(ctx sdk.Context, channelID string, sequence uint64) error {
+	transfer, found := k.GetFundedTransfer(ctx, channelID, sequence)
+	if !found {
+		return nil
+	}
+
+	amount := sdk.NewCoins(transfer.CommunityPoolAmount)
+	if err := k.distrKeeper.FundCommunityPool(ctx, amount, k.GetModuleAddress()); err != nil {
+		return err
+	}
+
+	k.DeleteFundedTransfer(ctx, channelID, sequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolRefunded,
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"returned failed ICS-20 transfer to community pool",
+		"channelID", channelID,
+		"sequence", sequence,
+		"amount", amount.String(),
+	)
+
+	return nil
+}
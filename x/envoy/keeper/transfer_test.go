@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	ibctransfer "github.com/cosmos/ibc-go/v6/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcporttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	"github.com/mars-protocol/hub/v2/x/envoy"
+	"github.com/mars-protocol/hub/v2/x/envoy/keeper"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// sendMockFunds sends 250umars and 69uastro to the interchain account on
+// outpost 1 through the given transfer channel and returns the sent packets. The envoy module has 200umars, so
+// 50umars and 69uastro are drawn from the community pool. It assumes an ICA has
+// already been registered on outpost 1.
+func (suite *KeeperTestSuite) sendMockFunds(ctx sdk.Context, channelID string) []ibcchanneltypes.Packet {
+	app := getMarsApp(suite.hub)
+	msgServer := keeper.NewMsgServerImpl(app.EnvoyKeeper)
+
+	_, err := msgServer.SendFunds(sdk.WrapSDKContext(ctx), &types.MsgSendFunds{
+		Authority: authority.String(),
+		ChannelId: channelID,
+		Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(250)), sdk.NewCoin("uastro", sdk.NewInt(69))),
+	})
+	suite.Require().NoError(err)
+
+	return suite.sentPackets(ctx)
+}
+
+func (suite *KeeperTestSuite) TestFundedTransferRecorded() {
+	suite.SetupTest()
+
+	// registering the ICA changes the path's channel ID, so get the transfer
+	// channel ID first
+	channelID := suite.path1.EndpointA.ChannelID
+
+	setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+	registerInterchainAccount(suite.path1, owner.String())
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+
+	packets := suite.sendMockFunds(ctx, channelID)
+	suite.Require().Len(packets, 2)
+
+	// the coins are sent in order of denom, and only the parts drawn from the
+	// community pool are recorded
+	transfers := []types.FundedTransfer{}
+	app.EnvoyKeeper.IterateFundedTransfers(ctx, func(transfer types.FundedTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+	suite.Require().Equal([]types.FundedTransfer{
+		{
+			ChannelId:           channelID,
+			Sequence:            packets[0].Sequence,
+			CommunityPoolAmount: sdk.NewCoin("uastro", sdk.NewInt(69)),
+		},
+		{
+			ChannelId:           channelID,
+			Sequence:            packets[1].Sequence,
+			CommunityPoolAmount: sdk.NewCoin("umars", sdk.NewInt(50)),
+		},
+	}, transfers)
+
+	// the records correspond to packets in flight, so the invariant holds
+	_, broken := keeper.OrphanedRefunds(app.EnvoyKeeper)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestFundedTransferRefunded() {
+	testCases := []struct {
+		name     string
+		callback func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error
+		refunded bool
+	}{
+		{
+			"acknowledged with success",
+			func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error {
+				ack := ibcchanneltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			false,
+		},
+		{
+			"acknowledged with error",
+			func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error {
+				ack := ibcchanneltypes.NewErrorAcknowledgement(errors.New("mock error"))
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			true,
+		},
+		{
+			"timed out",
+			func(ctx sdk.Context, im ibcporttypes.IBCModule, packet ibcchanneltypes.Packet) error {
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			channelID := suite.path1.EndpointA.ChannelID
+
+			setTokenBalances(suite.hub, envoyInitBalance, communityPoolInitBalance)
+			registerInterchainAccount(suite.path1, owner.String())
+
+			ctx := suite.hub.GetContext()
+			app := getMarsApp(suite.hub)
+			im := envoy.NewTransferMiddleware(ibctransfer.NewIBCModule(app.IBCTransferKeeper), app.EnvoyKeeper)
+
+			packets := suite.sendMockFunds(ctx, channelID)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			for _, packet := range packets {
+				err := tc.callback(ctx, im, packet)
+				suite.Require().NoError(err)
+
+				_, found := app.EnvoyKeeper.GetFundedTransfer(ctx, packet.SourceChannel, packet.Sequence)
+				suite.Require().False(found)
+			}
+
+			envoyBalance := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+			communityPoolBalance := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName))
+
+			refundEvents := []sdk.Event{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeCommunityPoolRefunded {
+					refundEvents = append(refundEvents, event)
+				}
+			}
+
+			if tc.refunded {
+				// both the envoy module and the community pool should have been
+				// refunded what they each contributed
+				suite.Require().True(envoyBalance.IsEqual(envoyInitBalance))
+				suite.Require().True(communityPoolBalance.IsEqual(communityPoolInitBalance))
+				suite.Require().Len(refundEvents, 2)
+			} else {
+				suite.Require().True(envoyBalance.IsZero())
+				suite.Require().True(communityPoolBalance.IsEqual(sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(250)), sdk.NewCoin("uastro", sdk.NewInt(431)))))
+				suite.Require().Empty(refundEvents)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOrphanedRefundsInvariant() {
+	suite.SetupTest()
+
+	ctx := suite.hub.GetContext()
+	app := getMarsApp(suite.hub)
+
+	// a record whose packet has no commitment, i.e. has been acknowledged or
+	// timed out without the community pool being refunded
+	app.EnvoyKeeper.SetFundedTransfer(ctx, types.FundedTransfer{
+		ChannelId:           "channel-9",
+		Sequence:            1,
+		CommunityPoolAmount: sdk.NewCoin("umars", sdk.NewInt(50)),
+	})
+
+	msg, broken := keeper.OrphanedRefunds(app.EnvoyKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal(
+		`envoy: orphaned-refunds invariant
+found 1 funded transfer(s) and 0 funded fee(s) without packet commitment
+	channel-9/1: 50umars
+`,
+		msg,
+	)
+
+	app.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, ibctransfertypes.PortID, "channel-9", 1, []byte("commitment"))
+
+	_, broken = keeper.OrphanedRefunds(app.EnvoyKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// the same goes for relayer fees drawn from the community pool, whose
+	// unused part hasn't been returned to it
+	app.EnvoyKeeper.SetFundedFee(ctx, types.FundedFee{
+		PortId:        portID,
+		ChannelId:     "channel-9",
+		Sequence:      2,
+		AckRefund:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))),
+		TimeoutRefund: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(20))),
+	})
+
+	msg, broken = keeper.OrphanedRefunds(app.EnvoyKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal(
+		`envoy: orphaned-refunds invariant
+found 0 funded transfer(s) and 1 funded fee(s) without packet commitment
+	`+portID+`/channel-9/2: ack 10umars, timeout 20umars
+`,
+		msg,
+	)
+
+	app.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, portID, "channel-9", 2, []byte("commitment"))
+
+	_, broken = keeper.OrphanedRefunds(app.EnvoyKeeper)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestForwardedTransfer() {
+	suite.SetupTest()
+
+	intermediary := suite.hub.SenderAccount.GetAddress()
+	receiver := suite.outpost2.SenderAccount.GetAddress()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	// outpost 1 sends a transfer to the hub, asking it to forward the tokens to
+	// outpost 2
+	memo := fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
+		receiver, ibctransfertypes.PortID, suite.path2.EndpointA.ChannelID,
+	)
+	res, err := suite.outpost1.SendMsgs(ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		suite.path1.EndpointB.ChannelID,
+		amount,
+		suite.outpost1.SenderAccount.GetAddress().String(),
+		intermediary.String(),
+		suite.outpost1.GetTimeoutHeight(),
+		0,
+		memo,
+	))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the hub receives the packet and forwards it through path 2, instead of
+	// leaving the tokens with the intermediary
+	err = suite.path1.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = suite.path1.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.path2.EndpointA.ChannelID, forwardPacket.SourceChannel)
+
+	hubDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, suite.path1.EndpointA.ChannelID, amount.Denom),
+	)
+	intermediaryBalance := getMarsApp(suite.hub).BankKeeper.GetAllBalances(suite.hub.GetContext(), intermediary)
+	suite.Require().True(intermediaryBalance.AmountOf(hubDenom.IBCDenom()).IsZero())
+
+	// the forwarded packet is relayed to outpost 2, where the receiver gets the
+	// tokens
+	err = suite.path2.RelayPacket(forwardPacket)
+	suite.Require().NoError(err)
+
+	outpostDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, suite.path2.EndpointB.ChannelID, hubDenom.GetFullDenomPath()),
+	)
+	receiverBalance := getMarsApp(suite.outpost2).BankKeeper.GetBalance(suite.outpost2.GetContext(), receiver, outpostDenom.IBCDenom())
+	suite.Require().Equal(amount.Amount, receiverBalance.Amount)
+
+	// once the forwarded packet is acknowledged, the hub acknowledges the
+	// original packet
+	_, found := getMarsApp(suite.hub).IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.hub.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+	)
+	suite.Require().True(found)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+// validateInterchainMessages rejects messages that would certainly fail when
+// executed by the interchain account on the given connection, namely:
+//
+//   - messages whose signer is not the interchain account. the signer addresses
+//     are compared as bytes, as they have the host chain's bech32 prefix.
+//     messages whose signers can't be determined are let through.
+//   - messages not in the host chain's allow-list, if the list is known from an
+//     interchain host params query.
+func (k Keeper) validateInterchainMessages(ctx sdk.Context, connectionID string, msgs []proto.Message) error {
+	_, portID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		return err
+	}
+
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.ErrNotFound.Wrapf("no interchain account exists on %s", connectionID)
+	}
+
+	_, addressBytes, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid interchain account address `%s`: %v", address, err)
+	}
+
+	hostParams, hostParamsFound := k.GetHostParams(ctx, connectionID)
+
+	for i, msg := range msgs {
+		typeURL := "/" + proto.MessageName(msg)
+
+		if hostParamsFound && !hostParams.HostEnabled {
+			return types.ErrMsgNotAllowed.Wrapf("interchain accounts are disabled on the host chain of %s", connectionID)
+		}
+
+		if hostParamsFound && !isAllowedByHost(hostParams, typeURL) {
+			return types.ErrMsgNotAllowed.Wrapf("message %d of type %s is not in the allow-list of %s", i, typeURL, connectionID)
+		}
+
+		signers, found, err := types.GetSignerAddresses(msg)
+		if err != nil {
+			return types.ErrInvalidSigner.Wrapf("message %d of type %s: %v", i, typeURL, err)
+		}
+
+		if !found {
+			continue
+		}
+
+		for _, signer := range signers {
+			if !bytes.Equal(signer, addressBytes) {
+				return types.ErrInvalidSigner.Wrapf("message %d of type %s must be signed by %s", i, typeURL, address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isAllowedByHost returns whether the given ICA host params' allow-list
+// includes the given type URL.
+func isAllowedByHost(hostParams types.HostParams, typeURL string) bool {
+	return marsutils.Contains(hostParams.AllowMessages, types.AllowAllHostMessages) ||
+		marsutils.Contains(hostParams.AllowMessages, typeURL)
+}
+
+// ValidateChannelOpenInit validates the parameters of an interchain account
+// channel to be opened by the module. The version is the ICS-27 metadata, as
+// the ICA controller module has already unwrapped the fee middleware's, if
+// any, and filled in the defaults.
+//
+// In addition to what the ICA controller module checks, the channel must be
+// on the module's own port, must be ordered, as the module relies on ordered
+// channels being closed when a packet times out, and must encode messages in
+// proto3, as that's the only encoding the module serializes messages in.
+func (k Keeper) ValidateChannelOpenInit(order ibcchanneltypes.Order, portID, version string) error {
+	_, expPortID, err := k.GetOwnerAndPortID()
+	if err != nil {
+		return err
+	}
+
+	if portID != expPortID {
+		return icatypes.ErrInvalidControllerPort.Wrapf("expected %s, got %s", expPortID, portID)
+	}
+
+	if order != ibcchanneltypes.ORDERED {
+		return ibcchanneltypes.ErrInvalidChannelOrdering.Wrapf("expected %s channel, got %s", ibcchanneltypes.ORDERED, order)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return types.ErrInvalidChannelVersion.Wrapf("cannot unmarshal ICS-27 metadata: %s", err)
+	}
+
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return types.ErrInvalidChannelVersion.Wrapf("unsupported encoding %s, expecting %s", metadata.Encoding, icatypes.EncodingProtobuf)
+	}
+
+	if metadata.TxType != icatypes.TxTypeSDKMultiMsg {
+		return types.ErrInvalidChannelVersion.Wrapf("unsupported tx type %s, expecting %s", metadata.TxType, icatypes.TxTypeSDKMultiMsg)
+	}
+
+	return nil
+}
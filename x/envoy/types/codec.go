@@ -12,6 +12,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterAccount{},
 		&MsgSendFunds{},
 		&MsgSendMessages{},
+		&MsgBroadcastMessages{},
+		&MsgRetryPackets{},
+		&MsgUpdateParams{},
+		&MsgSetAuthorityPolicy{},
+		&MsgRemoveAuthorityPolicy{},
+		&MsgRegisterAccountWithVersion{},
+		&MsgCloseAccountChannel{},
+		&MsgScheduleOperation{},
+		&MsgCancelScheduledOperation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,4 +8,16 @@ var (
 	ErrInvalidProposalMsg       = errors.Register(ModuleName, 4, "invalid envoy module proposal messages")
 	ErrMultihopUnsupported      = errors.Register(ModuleName, 5, "multihop channels are not supported")
 	ErrUnauthorized             = errors.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidProposalTimeout   = errors.Register(ModuleName, 7, "invalid envoy module proposal timeout")
+	ErrInvalidParams            = errors.Register(ModuleName, 8, "invalid envoy module params")
+	ErrInvalidProposalPacketIds = errors.Register(ModuleName, 9, "invalid envoy module proposal packet ids")
+	ErrChannelNotOpen           = errors.Register(ModuleName, 10, "interchain account channel is not open")
+	ErrInvalidProposalRoute     = errors.Register(ModuleName, 11, "invalid envoy module proposal forwarding route")
+	ErrInvalidSigner            = errors.Register(ModuleName, 12, "message signer is not the interchain account")
+	ErrMsgNotAllowed            = errors.Register(ModuleName, 13, "message is not allowed by the host chain")
+	ErrInvalidAuthorityPolicy   = errors.Register(ModuleName, 14, "invalid envoy module authority policy")
+	ErrSpendLimitExceeded       = errors.Register(ModuleName, 15, "authority policy spend limit exceeded")
+	ErrInvalidChannelVersion    = errors.Register(ModuleName, 16, "invalid interchain account channel version")
+	ErrInvalidRelayerFee        = errors.Register(ModuleName, 17, "invalid envoy module relayer fee")
+	ErrInvalidSchedule          = errors.Register(ModuleName, 18, "invalid envoy module operation schedule")
 )
@@ -0,0 +1,31 @@
+package types
+
+const (
+	EventTypeSendFunds              = "envoy_send_funds"
+	EventTypeCommunityPoolRefunded  = "envoy_community_pool_refunded"
+	EventTypeAccountBalances        = "envoy_account_balances"
+	EventTypePacketAcknowledged     = "envoy_packet_acknowledged"
+	EventTypeMsgResponse            = "envoy_msg_response"
+	EventTypeAuthorityPolicySet     = "envoy_authority_policy_set"
+	EventTypeAuthorityPolicyRemoved = "envoy_authority_policy_removed"
+	EventTypeAccountChannelClosed   = "envoy_account_channel_closed"
+	EventTypeOperationScheduled     = "envoy_operation_scheduled"
+	EventTypeOperationExecuted      = "envoy_operation_executed"
+	EventTypeOperationCancelled     = "envoy_operation_cancelled"
+	EventTypeMessagesBroadcast      = "envoy_messages_broadcast"
+
+	AttributeKeyChannel           = "channel"
+	AttributeKeySequence          = "sequence"
+	AttributeKeySequences         = "sequences"
+	AttributeKeyInterchainAccount = "interchain_account"
+	AttributeKeyConnection        = "connection"
+	AttributeKeyHeight            = "height"
+	AttributeKeySuccess           = "success"
+	AttributeKeyError             = "error"
+	AttributeKeyMsgIndex          = "msg_index"
+	AttributeKeyTypeURL           = "type_url"
+	AttributeKeyData              = "data"
+	AttributeKeyAuthority         = "authority"
+	AttributeKeyOperationID       = "operation_id"
+	AttributeKeyExecution         = "execution"
+)
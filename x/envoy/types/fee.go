@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+)
+
+// Validate validates the given relayer fee.
+//
+// - the recv, ack, and timeout fees must each be valid coins, but may be empty
+//
+// - at least one of them must not be empty
+func (f RelayerFee) Validate() error {
+	if err := f.RecvFee.Validate(); err != nil {
+		return fmt.Errorf("invalid recv fee: %w", err)
+	}
+
+	if err := f.AckFee.Validate(); err != nil {
+		return fmt.Errorf("invalid ack fee: %w", err)
+	}
+
+	if err := f.TimeoutFee.Validate(); err != nil {
+		return fmt.Errorf("invalid timeout fee: %w", err)
+	}
+
+	if f.Total().Empty() {
+		return fmt.Errorf("recv, ack, and timeout fees cannot all be empty")
+	}
+
+	return nil
+}
+
+// Total returns the total amount of coins to be escrowed for the fee, i.e. the
+// sum of the recv, ack, and timeout fees.
+func (f RelayerFee) Total() sdk.Coins {
+	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
+}
+
+// ToPacketFee converts the relayer fee to an ICS-29 packet fee, which refunds
+// to the given address and may be relayed by anyone.
+func (f RelayerFee) ToPacketFee(refundAddress string) ibcfeetypes.PacketFee {
+	return ibcfeetypes.NewPacketFee(
+		ibcfeetypes.NewFee(f.RecvFee, f.AckFee, f.TimeoutFee),
+		refundAddress,
+		nil,
+	)
+}
+
+// TotalRelayerFees returns the total amount of coins to be escrowed for the
+// given optional relayer fee, paid for the given number of packets.
+func TotalRelayerFees(fee *RelayerFee, packets int) sdk.Coins {
+	total := sdk.NewCoins()
+	if fee == nil {
+		return total
+	}
+
+	for i := 0; i < packets; i++ {
+		total = total.Add(fee.Total()...)
+	}
+
+	return total
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// forwardMemo is the memo format that packet-forward-middleware understands:
+// https://github.com/strangelove-ventures/packet-forward-middleware/tree/v6.0.0#example-multi-hop-forward
+type forwardMemo struct {
+	Forward forwardMetadata `json:"forward"`
+}
+
+type forwardMetadata struct {
+	Receiver string       `json:"receiver"`
+	Port     string       `json:"port"`
+	Channel  string       `json:"channel"`
+	Next     *forwardMemo `json:"next,omitempty"`
+}
+
+// ForwardMemo builds the ICS-20 packet memo that instructs packet-forward-
+// middleware on the intermediate chains to forward the funds through the given
+// route to the final receiver.
+//
+// The packet itself is to be sent to the receiver of the first hop. Each hop's
+// channel is used to forward the funds to the receiver of the next hop, or to
+// the final receiver in case of the last hop.
+//
+// The route must not be empty.
+func ForwardMemo(route []ForwardHop, receiver string) (string, error) {
+	var next *forwardMemo
+	for i := len(route) - 1; i >= 0; i-- {
+		next = &forwardMemo{
+			Forward: forwardMetadata{
+				Receiver: receiver,
+				Port:     ibctransfertypes.PortID,
+				Channel:  route[i].ChannelId,
+				Next:     next,
+			},
+		}
+
+		receiver = route[i].Receiver
+	}
+
+	bz, err := json.Marshal(next)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+func TestForwardMemo(t *testing.T) {
+	memo, err := types.ForwardMemo(testRoute[:1], "neutron1ica")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`{"forward":{"receiver":"neutron1ica","port":"transfer","channel":"channel-1"}}`,
+		memo,
+	)
+
+	memo, err = types.ForwardMemo(testRoute, "neutron1ica")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`{"forward":{"receiver":"juno1pfm","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"neutron1ica","port":"transfer","channel":"channel-2"}}}}`,
+		memo,
+	)
+}
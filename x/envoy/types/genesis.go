@@ -1,11 +1,330 @@
 package types
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
 // DefaultGenesisState returns the module's default genesis state.
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Packets:            []Packet{},
+		Params:             DefaultParams(),
+		Recoveries:         []Recovery{},
+		NextQueuedPacketId: 1,
+		QueuedPackets:      []QueuedPacket{},
+		FundedTransfers:    []FundedTransfer{},
+		BalancesQueries:    []BalancesQuery{},
+		AccountBalances:    []AccountBalances{},
+		HostParamsQueries:  []HostParamsQuery{},
+		HostParams:         []HostParams{},
+		AuthorityPolicies:  []AuthorityPolicy{},
+		AuthoritySpendings: []AuthoritySpending{},
+
+		NextScheduledOperationId: 1,
+		ScheduledOperations:      []ScheduledOperation{},
+
+		FundedFees: []FundedFee{},
+	}
 }
 
 // Validate validates the given instance of the module's genesis state.
+//
+// the params must be valid, and for each packet,
+//
+// - the channel id must not be empty
+//
+// - the channel id and sequence must not be duplicate
+//
+// - the status must be specified
+//
+// and for each recovery,
+//
+// - the connection id must not be empty
+//
+// - the connection id must not be duplicate
+//
+// and for each queued packet,
+//
+// - the connection id must not be empty
+//
+// - the id must not be duplicate, and must be smaller than the next queued
+// packet id
+//
+// and for each funded transfer,
+//
+// - the channel id must not be empty
+//
+// - the channel id and sequence must not be duplicate
+//
+// - the community pool amount must be valid and positive
+//
+// and for each balances query,
+//
+// - the channel id and connection id must not be empty
+//
+// - the channel id and sequence must not be duplicate
+//
+// and for each account balances,
+//
+// - the connection id must not be empty
+//
+// - the connection id must not be duplicate
+//
+// - the balances must be valid
+//
+// and for each host params query,
+//
+// - the channel id and connection id must not be empty
+//
+// - the channel id and sequence must not be duplicate
+//
+// and for each host params,
+//
+// - the connection id must not be empty
+//
+// - the connection id must not be duplicate
+//
+// and for each authority policy,
+//
+// - the policy must be valid
+//
+// - the authority must not be duplicate
+//
+// and for each authority spending,
+//
+// - the authority must have a policy, and must not be duplicate
+//
+// - the spent amount must be valid
+//
+// and for each scheduled operation,
+//
+// - the operation must be valid
+//
+// - the id must not be duplicate, and must be smaller than the next scheduled
+// operation id
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.Packets {
+		if packet.ChannelId == "" {
+			return fmt.Errorf("packet %d has empty channel id", packet.Sequence)
+		}
+
+		id := fmt.Sprintf("%s/%d", packet.ChannelId, packet.Sequence)
+		if seenPackets[id] {
+			return fmt.Errorf("duplicate packet %s", id)
+		}
+
+		if packet.Status == PacketStatusUnspecified {
+			return fmt.Errorf("packet %s has unspecified status", id)
+		}
+
+		seenPackets[id] = true
+	}
+
+	seenConnections := make(map[string]bool)
+	for _, recovery := range gs.Recoveries {
+		if recovery.ConnectionId == "" {
+			return fmt.Errorf("recovery of channel %s has empty connection id", recovery.ChannelId)
+		}
+
+		if seenConnections[recovery.ConnectionId] {
+			return fmt.Errorf("duplicate recovery for connection %s", recovery.ConnectionId)
+		}
+
+		seenConnections[recovery.ConnectionId] = true
+	}
+
+	seenQueuedPackets := make(map[uint64]bool)
+	for _, queuedPacket := range gs.QueuedPackets {
+		if queuedPacket.ConnectionId == "" {
+			return fmt.Errorf("queued packet %d has empty connection id", queuedPacket.Id)
+		}
+
+		if seenQueuedPackets[queuedPacket.Id] {
+			return fmt.Errorf("duplicate queued packet id %d", queuedPacket.Id)
+		}
+
+		if queuedPacket.Id >= gs.NextQueuedPacketId {
+			return fmt.Errorf("queued packet id %d is not smaller than next queued packet id %d", queuedPacket.Id, gs.NextQueuedPacketId)
+		}
+
+		seenQueuedPackets[queuedPacket.Id] = true
+	}
+
+	seenTransfers := make(map[string]bool)
+	for _, transfer := range gs.FundedTransfers {
+		if transfer.ChannelId == "" {
+			return fmt.Errorf("funded transfer %d has empty channel id", transfer.Sequence)
+		}
+
+		id := fmt.Sprintf("%s/%d", transfer.ChannelId, transfer.Sequence)
+		if seenTransfers[id] {
+			return fmt.Errorf("duplicate funded transfer %s", id)
+		}
+
+		if err := transfer.CommunityPoolAmount.Validate(); err != nil {
+			return fmt.Errorf("funded transfer %s has invalid community pool amount: %w", id, err)
+		}
+
+		if !transfer.CommunityPoolAmount.IsPositive() {
+			return fmt.Errorf("funded transfer %s has non-positive community pool amount", id)
+		}
+
+		seenTransfers[id] = true
+	}
+
+	seenQueries := make(map[string]bool)
+	for _, query := range gs.BalancesQueries {
+		if query.ChannelId == "" {
+			return fmt.Errorf("balances query %d has empty channel id", query.Sequence)
+		}
+
+		id := fmt.Sprintf("%s/%d", query.ChannelId, query.Sequence)
+		if seenQueries[id] {
+			return fmt.Errorf("duplicate balances query %s", id)
+		}
+
+		if query.ConnectionId == "" {
+			return fmt.Errorf("balances query %s has empty connection id", id)
+		}
+
+		seenQueries[id] = true
+	}
+
+	seenAccountBalances := make(map[string]bool)
+	for _, accountBalances := range gs.AccountBalances {
+		if accountBalances.ConnectionId == "" {
+			return fmt.Errorf("account balances of %s has empty connection id", accountBalances.Address)
+		}
+
+		if seenAccountBalances[accountBalances.ConnectionId] {
+			return fmt.Errorf("duplicate account balances for %s", accountBalances.ConnectionId)
+		}
+
+		if err := accountBalances.Balances.Validate(); err != nil {
+			return fmt.Errorf("account balances of %s are invalid: %w", accountBalances.ConnectionId, err)
+		}
+
+		seenAccountBalances[accountBalances.ConnectionId] = true
+	}
+
+	seenHostParamsQueries := make(map[string]bool)
+	for _, query := range gs.HostParamsQueries {
+		if query.ChannelId == "" {
+			return fmt.Errorf("host params query %d has empty channel id", query.Sequence)
+		}
+
+		id := fmt.Sprintf("%s/%d", query.ChannelId, query.Sequence)
+		if seenHostParamsQueries[id] {
+			return fmt.Errorf("duplicate host params query %s", id)
+		}
+
+		if query.ConnectionId == "" {
+			return fmt.Errorf("host params query %s has empty connection id", id)
+		}
+
+		seenHostParamsQueries[id] = true
+	}
+
+	seenHostParams := make(map[string]bool)
+	for _, hostParams := range gs.HostParams {
+		if hostParams.ConnectionId == "" {
+			return fmt.Errorf("host params has empty connection id")
+		}
+
+		if seenHostParams[hostParams.ConnectionId] {
+			return fmt.Errorf("duplicate host params for %s", hostParams.ConnectionId)
+		}
+
+		seenHostParams[hostParams.ConnectionId] = true
+	}
+
+	seenPolicies := make(map[string]bool)
+	for _, policy := range gs.AuthorityPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		if seenPolicies[policy.Authority] {
+			return fmt.Errorf("duplicate authority policy for %s", policy.Authority)
+		}
+
+		seenPolicies[policy.Authority] = true
+	}
+
+	seenSpendings := make(map[string]bool)
+	for _, spending := range gs.AuthoritySpendings {
+		if !seenPolicies[spending.Authority] {
+			return fmt.Errorf("authority spending of %s has no policy", spending.Authority)
+		}
+
+		if seenSpendings[spending.Authority] {
+			return fmt.Errorf("duplicate authority spending for %s", spending.Authority)
+		}
+
+		if err := spending.Spent.Validate(); err != nil {
+			return fmt.Errorf("authority spending of %s is invalid: %w", spending.Authority, err)
+		}
+
+		seenSpendings[spending.Authority] = true
+	}
+
+	seenOperations := make(map[uint64]bool)
+	for _, op := range gs.ScheduledOperations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+
+		if seenOperations[op.Id] {
+			return fmt.Errorf("duplicate scheduled operation id %d", op.Id)
+		}
+
+		if op.Id >= gs.NextScheduledOperationId {
+			return fmt.Errorf("scheduled operation id %d is not smaller than next scheduled operation id %d", op.Id, gs.NextScheduledOperationId)
+		}
+
+		seenOperations[op.Id] = true
+	}
+
+	seenFees := make(map[string]bool)
+	for _, fee := range gs.FundedFees {
+		if fee.PortId == "" || fee.ChannelId == "" {
+			return fmt.Errorf("funded fee %d has empty port or channel id", fee.Sequence)
+		}
+
+		id := fmt.Sprintf("%s/%d", fee.ChannelId, fee.Sequence)
+		if seenFees[id] {
+			return fmt.Errorf("duplicate funded fee %s", id)
+		}
+
+		if err := fee.AckRefund.Validate(); err != nil {
+			return fmt.Errorf("funded fee %s has invalid ack refund: %w", id, err)
+		}
+
+		if err := fee.TimeoutRefund.Validate(); err != nil {
+			return fmt.Errorf("funded fee %s has invalid timeout refund: %w", id, err)
+		}
+
+		seenFees[id] = true
+	}
+
+	return nil
+}
+
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, op := range gs.ScheduledOperations {
+		if err := op.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,52 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
+	// Packets is an array of records of ICS-27 packets sent by the module.
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// Params is the module's parameters.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// Recoveries is an array of ongoing recoveries of interchain accounts whose
+	// channels have been closed.
+	Recoveries []Recovery `protobuf:"bytes,3,rep,name=recoveries,proto3" json:"recoveries"`
+	// NextQueuedPacketId is the id for the next timed out packet to be queued.
+	NextQueuedPacketId uint64 `protobuf:"varint,4,opt,name=next_queued_packet_id,json=nextQueuedPacketId,proto3" json:"next_queued_packet_id,omitempty" yaml:"next_queued_packet_id"`
+	// QueuedPackets is an array of timed out packets that can be resent.
+	QueuedPackets []QueuedPacket `protobuf:"bytes,5,rep,name=queued_packets,json=queuedPackets,proto3" json:"queued_packets"`
+	// FundedTransfers is an array of in-flight ICS-20 transfers that were funded
+	// by the community pool.
+	FundedTransfers []FundedTransfer `protobuf:"bytes,6,rep,name=funded_transfers,json=fundedTransfers,proto3" json:"funded_transfers"`
+	// BalancesQueries is an array of in-flight interchain queries for the
+	// balances of interchain accounts.
+	BalancesQueries []BalancesQuery `protobuf:"bytes,7,rep,name=balances_queries,json=balancesQueries,proto3" json:"balances_queries"`
+	// AccountBalances is an array of the latest known balances of interchain
+	// accounts.
+	AccountBalances []AccountBalances `protobuf:"bytes,8,rep,name=account_balances,json=accountBalances,proto3" json:"account_balances"`
+	// LastBalancesQueryTime is the block time at which the balances of the
+	// interchain accounts were last queried.
+	LastBalancesQueryTime time.Time `protobuf:"bytes,9,opt,name=last_balances_query_time,json=lastBalancesQueryTime,proto3,stdtime" json:"last_balances_query_time" yaml:"last_balances_query_time"`
+	// HostParamsQueries is an array of in-flight interchain queries for the ICA
+	// host params of the chains the interchain accounts are on.
+	HostParamsQueries []HostParamsQuery `protobuf:"bytes,10,rep,name=host_params_queries,json=hostParamsQueries,proto3" json:"host_params_queries"`
+	// HostParams is an array of the latest known ICA host params of the chains
+	// the interchain accounts are on.
+	HostParams []HostParams `protobuf:"bytes,11,rep,name=host_params,json=hostParams,proto3" json:"host_params"`
+	// LastHostParamsQueryTime is the block time at which the ICA host params were
+	// last queried.
+	LastHostParamsQueryTime time.Time `protobuf:"bytes,12,opt,name=last_host_params_query_time,json=lastHostParamsQueryTime,proto3,stdtime" json:"last_host_params_query_time" yaml:"last_host_params_query_time"`
+	// AuthorityPolicies is an array of the policies of the accounts other than
+	// the module's authorities that may send funds and messages.
+	AuthorityPolicies []AuthorityPolicy `protobuf:"bytes,13,rep,name=authority_policies,json=authorityPolicies,proto3" json:"authority_policies"`
+	// AuthoritySpendings is an array of the amounts the accounts with authority
+	// policies have sent in their current epochs.
+	AuthoritySpendings []AuthoritySpending `protobuf:"bytes,14,rep,name=authority_spendings,json=authoritySpendings,proto3" json:"authority_spendings"`
+	// NextScheduledOperationId is the id for the next operation to be scheduled.
+	NextScheduledOperationId uint64 `protobuf:"varint,15,opt,name=next_scheduled_operation_id,json=nextScheduledOperationId,proto3" json:"next_scheduled_operation_id,omitempty" yaml:"next_scheduled_operation_id"`
+	// ScheduledOperations is an array of the operations scheduled to be executed
+	// by the module.
+	ScheduledOperations []ScheduledOperation `protobuf:"bytes,16,rep,name=scheduled_operations,json=scheduledOperations,proto3" json:"scheduled_operations"`
+	// FundedFees is an array of the relayer fees of in-flight packets that were
+	// funded by the community pool.
+	FundedFees []FundedFee `protobuf:"bytes,17,rep,name=funded_fees,json=fundedFees,proto3" json:"funded_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,6 +110,125 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetPackets() []Packet {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRecoveries() []Recovery {
+	if m != nil {
+		return m.Recoveries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextQueuedPacketId() uint64 {
+	if m != nil {
+		return m.NextQueuedPacketId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetQueuedPackets() []QueuedPacket {
+	if m != nil {
+		return m.QueuedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundedTransfers() []FundedTransfer {
+	if m != nil {
+		return m.FundedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalancesQueries() []BalancesQuery {
+	if m != nil {
+		return m.BalancesQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountBalances() []AccountBalances {
+	if m != nil {
+		return m.AccountBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastBalancesQueryTime() time.Time {
+	if m != nil {
+		return m.LastBalancesQueryTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetHostParamsQueries() []HostParamsQuery {
+	if m != nil {
+		return m.HostParamsQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostParams() []HostParams {
+	if m != nil {
+		return m.HostParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastHostParamsQueryTime() time.Time {
+	if m != nil {
+		return m.LastHostParamsQueryTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetAuthorityPolicies() []AuthorityPolicy {
+	if m != nil {
+		return m.AuthorityPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthoritySpendings() []AuthoritySpending {
+	if m != nil {
+		return m.AuthoritySpendings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledOperationId() uint64 {
+	if m != nil {
+		return m.NextScheduledOperationId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledOperations() []ScheduledOperation {
+	if m != nil {
+		return m.ScheduledOperations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundedFees() []FundedFee {
+	if m != nil {
+		return m.FundedFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mars.envoy.v1beta1.GenesisState")
 }
@@ -66,17 +236,53 @@ func init() {
 func init() { proto.RegisterFile("mars/envoy/v1beta1/genesis.proto", fileDescriptor_ba46f8a60020f3c2) }
 
 var fileDescriptor_ba46f8a60020f3c2 = []byte{
-	// 147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4d, 0x2c, 0x2a,
-	0xd6, 0x4f, 0xcd, 0x2b, 0xcb, 0xaf, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0xa9,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x50, 0xe2, 0xe3, 0xe2, 0x71, 0x87, 0x28, 0x0a, 0x2e, 0x49,
-	0x2c, 0x49, 0x75, 0x72, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xad,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x90, 0x41, 0xba, 0x60, 0x43,
-	0x93, 0xf3, 0x73, 0xf4, 0x33, 0x4a, 0x93, 0xf4, 0x2b, 0xa0, 0x36, 0x97, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0xe5, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x63, 0xe1, 0x42, 0x94,
-	0x00, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x4f, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc6, 0xe3, 0x17, 0x5e, 0x68, 0x37, 0xfc, 0x09, 0x0b, 0xa8, 0xab, 0x40, 0x9d, 0x90, 0xaa,
+	0x08, 0xb5, 0xaa, 0x2d, 0xda, 0x4b, 0xc5, 0xad, 0x11, 0xa5, 0xe5, 0x50, 0x15, 0x12, 0x0e, 0x6d,
+	0x55, 0xc9, 0xda, 0xd8, 0x9b, 0xc4, 0xaa, 0xe3, 0x35, 0xde, 0x35, 0x22, 0xb7, 0x1e, 0x7b, 0xe4,
+	0x63, 0x71, 0xe4, 0xd8, 0x13, 0x6d, 0xe1, 0x1b, 0xf0, 0x09, 0xaa, 0x5d, 0xef, 0x12, 0x1b, 0x9c,
+	0xf4, 0x96, 0xcc, 0x3c, 0xf3, 0xdb, 0x99, 0x27, 0xb3, 0x1b, 0x50, 0x1f, 0xe0, 0x98, 0xd9, 0x24,
+	0x3c, 0xa1, 0x43, 0xfb, 0x64, 0xbb, 0x43, 0x38, 0xde, 0xb6, 0x7b, 0x24, 0x24, 0xcc, 0x67, 0x56,
+	0x14, 0x53, 0x4e, 0x21, 0x14, 0x0a, 0x4b, 0x2a, 0x2c, 0xa5, 0xa8, 0xae, 0xf4, 0x68, 0x8f, 0xca,
+	0xb4, 0x2d, 0x3e, 0xa5, 0xca, 0x6a, 0xad, 0x47, 0x69, 0x2f, 0x20, 0xb6, 0xfc, 0xd6, 0x49, 0xba,
+	0x36, 0xf7, 0x07, 0x84, 0x71, 0x3c, 0x88, 0xb4, 0xa0, 0xe0, 0xb0, 0x08, 0xc7, 0x78, 0xa0, 0xce,
+	0xaa, 0x6e, 0x14, 0x08, 0x98, 0xdb, 0x27, 0x5e, 0x12, 0x10, 0x25, 0x31, 0x8b, 0x24, 0x9c, 0xc6,
+	0x2a, 0xdf, 0xf8, 0x53, 0x06, 0x73, 0xef, 0xd2, 0x01, 0xda, 0x1c, 0x73, 0x02, 0x77, 0xc0, 0x6c,
+	0x84, 0xdd, 0x6f, 0x84, 0x33, 0x64, 0xd4, 0xa7, 0xb6, 0xca, 0x2f, 0xab, 0xd6, 0xfd, 0x89, 0xac,
+	0x03, 0x29, 0x69, 0x4e, 0x9f, 0x5f, 0xd6, 0x4a, 0x2d, 0x5d, 0x00, 0x5f, 0x83, 0x99, 0xb4, 0x3f,
+	0xf4, 0x5f, 0xdd, 0x18, 0x5f, 0x2a, 0x14, 0xaa, 0x54, 0xe9, 0x61, 0x13, 0x80, 0x98, 0xb8, 0xf4,
+	0x84, 0xc4, 0x3e, 0x61, 0x68, 0x4a, 0x1e, 0xbc, 0x5e, 0x54, 0xdd, 0x4a, 0x55, 0x43, 0x55, 0x9f,
+	0xa9, 0x82, 0x6d, 0xb0, 0x1a, 0x92, 0x53, 0xee, 0x1c, 0x27, 0x24, 0x21, 0x9e, 0x93, 0x36, 0xe5,
+	0xf8, 0x1e, 0x9a, 0xae, 0x1b, 0x5b, 0xd3, 0xcd, 0xfa, 0xcd, 0x65, 0x6d, 0x7d, 0x88, 0x07, 0xc1,
+	0x4e, 0xa3, 0x50, 0xd6, 0x68, 0x41, 0x11, 0x3f, 0x94, 0xe1, 0x74, 0xbe, 0x7d, 0x0f, 0x7e, 0x00,
+	0x0b, 0x39, 0x21, 0x43, 0xff, 0xcb, 0xe6, 0xea, 0x45, 0xcd, 0x65, 0x6b, 0x55, 0x83, 0xf3, 0xc7,
+	0x99, 0x98, 0xe8, 0xb1, 0xd2, 0x4d, 0x42, 0x8f, 0x78, 0x0e, 0x8f, 0x71, 0xc8, 0xba, 0x24, 0x66,
+	0x68, 0x46, 0x02, 0x1b, 0x45, 0xc0, 0x3d, 0xa9, 0x3d, 0x52, 0x52, 0x85, 0x5c, 0xec, 0xe6, 0xa2,
+	0x0c, 0xb6, 0x40, 0xa5, 0x83, 0x03, 0x1c, 0xba, 0x84, 0x89, 0xa9, 0xa4, 0x85, 0xb3, 0x12, 0xba,
+	0x51, 0x04, 0x6d, 0x2a, 0xed, 0x61, 0x32, 0xf2, 0x71, 0xb1, 0x93, 0x09, 0x0a, 0x33, 0x8f, 0x40,
+	0x05, 0xbb, 0x2e, 0x4d, 0x42, 0xee, 0xe8, 0x14, 0x7a, 0x20, 0x99, 0x4f, 0x8a, 0x98, 0x6f, 0x52,
+	0xad, 0x46, 0x6b, 0x2a, 0xce, 0x87, 0xe1, 0x77, 0x03, 0xa0, 0x00, 0x33, 0xee, 0xe4, 0xfa, 0x1d,
+	0x3a, 0x62, 0xf3, 0xd1, 0x43, 0xb5, 0x33, 0xe9, 0xb5, 0xb0, 0xf4, 0xb5, 0xb0, 0x8e, 0xf4, 0xb5,
+	0x68, 0x3e, 0x17, 0xd4, 0x9b, 0xcb, 0x5a, 0x2d, 0xfd, 0x19, 0xc7, 0x91, 0x1a, 0x67, 0xbf, 0x6a,
+	0x46, 0x6b, 0x55, 0xa4, 0x73, 0xb3, 0x0a, 0x10, 0xfc, 0x0c, 0x96, 0xfb, 0x94, 0x71, 0x27, 0x5d,
+	0xbc, 0x5b, 0xbf, 0xc0, 0xf8, 0xd9, 0xde, 0x53, 0xc6, 0xd3, 0xa5, 0xcd, 0x3a, 0xb6, 0xd4, 0xcf,
+	0x85, 0x85, 0x67, 0x6f, 0x41, 0x39, 0x83, 0x46, 0x65, 0x89, 0x34, 0x27, 0x23, 0xf5, 0x1e, 0x8f,
+	0x68, 0xf0, 0x87, 0x01, 0xd6, 0xe4, 0x68, 0x77, 0xfb, 0x54, 0x3e, 0xcd, 0xfd, 0xd3, 0x27, 0x4b,
+	0xf9, 0xd4, 0xc8, 0xf8, 0x54, 0x0c, 0x4b, 0xad, 0x7a, 0x24, 0x14, 0x77, 0xc6, 0x94, 0x66, 0x7d,
+	0x02, 0x10, 0x27, 0xbc, 0x4f, 0x63, 0x9f, 0x0f, 0x9d, 0x88, 0x06, 0xbe, 0x2b, 0xbc, 0x9a, 0x9f,
+	0xb0, 0x07, 0x5a, 0x7d, 0x20, 0xc4, 0xb7, 0x5e, 0xe1, 0x5c, 0x58, 0x78, 0xf5, 0x15, 0x2c, 0x8f,
+	0xc8, 0x2c, 0x22, 0xa1, 0xe7, 0x87, 0x3d, 0x86, 0x16, 0x24, 0xfa, 0xe9, 0x44, 0x74, 0x5b, 0xa9,
+	0x15, 0x1c, 0xe2, 0xbb, 0x09, 0x06, 0x09, 0x58, 0x93, 0x77, 0x5c, 0x3f, 0x86, 0x9e, 0x43, 0x23,
+	0x12, 0x63, 0xee, 0xd3, 0x50, 0x3c, 0x08, 0x8b, 0xf2, 0x41, 0xd8, 0x1c, 0x39, 0x34, 0x41, 0xdc,
+	0x68, 0x21, 0x91, 0x6d, 0xeb, 0xe4, 0x47, 0x9d, 0xdb, 0xf7, 0xa0, 0x03, 0x56, 0x0a, 0x8a, 0x18,
+	0xaa, 0xc8, 0x29, 0x36, 0x8b, 0xa6, 0xb8, 0xcf, 0x51, 0x63, 0x2c, 0xb3, 0x7b, 0x19, 0x06, 0x77,
+	0x41, 0x59, 0x3d, 0x17, 0x5d, 0x42, 0x18, 0x5a, 0x92, 0xdc, 0xc7, 0xe3, 0x5f, 0x8a, 0x3d, 0x42,
+	0xf4, 0x42, 0x75, 0x75, 0x80, 0x35, 0x77, 0xcf, 0xaf, 0x4c, 0xe3, 0xe2, 0xca, 0x34, 0x7e, 0x5f,
+	0x99, 0xc6, 0xd9, 0xb5, 0x59, 0xba, 0xb8, 0x36, 0x4b, 0x3f, 0xaf, 0xcd, 0xd2, 0x97, 0x67, 0x3d,
+	0x9f, 0xf7, 0x93, 0x8e, 0xe5, 0xd2, 0x81, 0x2d, 0xa0, 0x2f, 0xe4, 0x32, 0xb9, 0x34, 0xb0, 0xfb,
+	0x49, 0xc7, 0x3e, 0x55, 0xff, 0x1b, 0x7c, 0x18, 0x11, 0xd6, 0x99, 0x91, 0xb9, 0x57, 0x7f, 0x07,
+	0x00, 0x59, 0x39, 0xa7, 0x85, 0x03, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -99,6 +305,214 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FundedFees) > 0 {
+		for iNdEx := len(m.FundedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ScheduledOperations) > 0 {
+		for iNdEx := len(m.ScheduledOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NextScheduledOperationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledOperationId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AuthoritySpendings) > 0 {
+		for iNdEx := len(m.AuthoritySpendings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthoritySpendings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AuthorityPolicies) > 0 {
+		for iNdEx := len(m.AuthorityPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorityPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHostParamsQueryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHostParamsQueryTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if len(m.HostParams) > 0 {
+		for iNdEx := len(m.HostParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.HostParamsQueries) > 0 {
+		for iNdEx := len(m.HostParamsQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostParamsQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBalancesQueryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBalancesQueryTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AccountBalances) > 0 {
+		for iNdEx := len(m.AccountBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BalancesQueries) > 0 {
+		for iNdEx := len(m.BalancesQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalancesQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FundedTransfers) > 0 {
+		for iNdEx := len(m.FundedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.QueuedPackets) > 0 {
+		for iNdEx := len(m.QueuedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextQueuedPacketId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextQueuedPacketId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recoveries) > 0 {
+		for iNdEx := len(m.Recoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +533,90 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Recoveries) > 0 {
+		for _, e := range m.Recoveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextQueuedPacketId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextQueuedPacketId))
+	}
+	if len(m.QueuedPackets) > 0 {
+		for _, e := range m.QueuedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundedTransfers) > 0 {
+		for _, e := range m.FundedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalancesQueries) > 0 {
+		for _, e := range m.BalancesQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountBalances) > 0 {
+		for _, e := range m.AccountBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBalancesQueryTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HostParamsQueries) > 0 {
+		for _, e := range m.HostParamsQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostParams) > 0 {
+		for _, e := range m.HostParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHostParamsQueryTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AuthorityPolicies) > 0 {
+		for _, e := range m.AuthorityPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthoritySpendings) > 0 {
+		for _, e := range m.AuthoritySpendings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledOperationId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledOperationId))
+	}
+	if len(m.ScheduledOperations) > 0 {
+		for _, e := range m.ScheduledOperations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundedFees) > 0 {
+		for _, e := range m.FundedFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -157,6 +655,551 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recoveries = append(m.Recoveries, Recovery{})
+			if err := m.Recoveries[len(m.Recoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextQueuedPacketId", wireType)
+			}
+			m.NextQueuedPacketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextQueuedPacketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedPackets = append(m.QueuedPackets, QueuedPacket{})
+			if err := m.QueuedPackets[len(m.QueuedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedTransfers = append(m.FundedTransfers, FundedTransfer{})
+			if err := m.FundedTransfers[len(m.FundedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancesQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalancesQueries = append(m.BalancesQueries, BalancesQuery{})
+			if err := m.BalancesQueries[len(m.BalancesQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountBalances = append(m.AccountBalances, AccountBalances{})
+			if err := m.AccountBalances[len(m.AccountBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBalancesQueryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastBalancesQueryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostParamsQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostParamsQueries = append(m.HostParamsQueries, HostParamsQuery{})
+			if err := m.HostParamsQueries[len(m.HostParamsQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostParams = append(m.HostParams, HostParams{})
+			if err := m.HostParams[len(m.HostParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHostParamsQueryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHostParamsQueryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorityPolicies = append(m.AuthorityPolicies, AuthorityPolicy{})
+			if err := m.AuthorityPolicies[len(m.AuthorityPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthoritySpendings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthoritySpendings = append(m.AuthoritySpendings, AuthoritySpending{})
+			if err := m.AuthoritySpendings[len(m.AuthoritySpendings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledOperationId", wireType)
+			}
+			m.NextScheduledOperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledOperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledOperations = append(m.ScheduledOperations, ScheduledOperation{})
+			if err := m.ScheduledOperations[len(m.ScheduledOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundedFees = append(m.FundedFees, FundedFee{})
+			if err := m.FundedFees[len(m.FundedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
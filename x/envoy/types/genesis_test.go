@@ -0,0 +1,367 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+func getMockGenesisState() types.GenesisState {
+	return types.GenesisState{
+		Packets: []types.Packet{
+			{
+				ChannelId:    testChannelId,
+				Sequence:     1,
+				ConnectionId: testConnectionId,
+				Status:       types.PacketStatusSuccess,
+			},
+			{
+				ChannelId:    testChannelId,
+				Sequence:     2,
+				ConnectionId: testConnectionId,
+				Status:       types.PacketStatusPending,
+			},
+		},
+		Params: types.DefaultParams(),
+		Recoveries: []types.Recovery{
+			{
+				ConnectionId:    "connection-0",
+				ChannelId:       "channel-0",
+				Attempts:        1,
+				NextAttemptTime: time.Unix(20000, 0).UTC(),
+			},
+		},
+		NextQueuedPacketId: 2,
+		QueuedPackets: []types.QueuedPacket{
+			{
+				Id:           1,
+				ConnectionId: testConnectionId,
+				ChannelId:    testChannelId,
+				Sequence:     3,
+				ExpiryTime:   time.Unix(30000, 0).UTC(),
+			},
+		},
+		FundedTransfers: []types.FundedTransfer{
+			{
+				ChannelId:           testChannelId,
+				Sequence:            4,
+				CommunityPoolAmount: sdk.NewCoin("umars", sdk.NewInt(12345)),
+			},
+		},
+		BalancesQueries: []types.BalancesQuery{
+			{
+				ChannelId:    testChannelId,
+				Sequence:     5,
+				ConnectionId: testConnectionId,
+			},
+		},
+		AccountBalances: []types.AccountBalances{
+			{
+				ConnectionId: testConnectionId,
+				Balances:     sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(420))),
+				Height:       12345,
+			},
+		},
+		HostParamsQueries: []types.HostParamsQuery{
+			{
+				ChannelId:    testChannelId,
+				Sequence:     6,
+				ConnectionId: testConnectionId,
+			},
+		},
+		HostParams: []types.HostParams{
+			{
+				ConnectionId:  testConnectionId,
+				HostEnabled:   true,
+				AllowMessages: []string{"*"},
+				Height:        12345,
+			},
+		},
+		AuthorityPolicies: []types.AuthorityPolicy{
+			{
+				Authority:          testSender.String(),
+				AllowedConnections: []string{testConnectionId},
+				AllowedMsgTypeUrls: []string{"/cosmos.gov.v1.MsgVote"},
+				SpendLimit:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))),
+				EpochDuration:      24 * time.Hour,
+			},
+		},
+		AuthoritySpendings: []types.AuthoritySpending{
+			{
+				Authority: testSender.String(),
+				Spent:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+			},
+		},
+		NextScheduledOperationId: 2,
+		ScheduledOperations: []types.ScheduledOperation{
+			{
+				Id: 1,
+				SendFunds: &types.MsgSendFunds{
+					Authority: testAuthority.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+				},
+				StartTime:      time.Unix(20000, 0).UTC(),
+				Interval:       24 * time.Hour,
+				Repetitions:    3,
+				Executions:     1,
+				ReservedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(200))),
+				SpentAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100))),
+			},
+		},
+		FundedFees: []types.FundedFee{
+			{
+				PortId:        "transfer",
+				ChannelId:     testChannelId,
+				Sequence:      1,
+				AckRefund:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2))),
+				TimeoutRefund: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(15))),
+			},
+		},
+	}
+}
+
+func TestValidGenesis(t *testing.T) {
+	gs := getMockGenesisState()
+	require.NoError(t, gs.Validate())
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+}
+
+func TestEmptyChannelId(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Packets[1].ChannelId = ""
+	require.Error(t, gs.Validate())
+}
+
+func TestDuplicatePacket(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Packets[1].Sequence = 1
+	require.Error(t, gs.Validate())
+}
+
+func TestUnspecifiedPacketStatus(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Packets[0].Status = types.PacketStatusUnspecified
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidParams(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Params.TransferTimeout = 0
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Params.MessagesTimeout = -time.Minute
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Params.RecoveryBackoff = 0
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Params.QueueExpiry = 0
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Params.BalancesQueryInterval = -time.Hour
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Params.HostParamsQueryInterval = -time.Hour
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidRecoveries(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Recoveries[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.Recoveries = append(gs.Recoveries, gs.Recoveries[0])
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidQueuedPackets(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.QueuedPackets[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.QueuedPackets = append(gs.QueuedPackets, gs.QueuedPackets[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.NextQueuedPacketId = 1
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidFundedTransfers(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.FundedTransfers[0].ChannelId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.FundedTransfers = append(gs.FundedTransfers, gs.FundedTransfers[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.FundedTransfers[0].CommunityPoolAmount = sdk.NewCoin("umars", sdk.ZeroInt())
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidFundedFees(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.FundedFees[0].PortId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.FundedFees = append(gs.FundedFees, gs.FundedFees[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.FundedFees[0].TimeoutRefund = sdk.Coins{sdk.Coin{Denom: "umars", Amount: sdk.ZeroInt()}}
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidBalancesQueries(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.BalancesQueries[0].ChannelId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.BalancesQueries[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.BalancesQueries = append(gs.BalancesQueries, gs.BalancesQueries[0])
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidAccountBalances(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.AccountBalances[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AccountBalances = append(gs.AccountBalances, gs.AccountBalances[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AccountBalances[0].Balances = sdk.Coins{sdk.Coin{Denom: "uosmo", Amount: sdk.ZeroInt()}}
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidHostParamsQueries(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.HostParamsQueries[0].ChannelId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.HostParamsQueries[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.HostParamsQueries = append(gs.HostParamsQueries, gs.HostParamsQueries[0])
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidHostParams(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.HostParams[0].ConnectionId = ""
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.HostParams = append(gs.HostParams, gs.HostParams[0])
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidAuthorityPolicies(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.AuthorityPolicies[0].Authority = "invalid"
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].AllowedConnections = []string{}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].AllowedConnections = []string{"invalid connection"}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].AllowedConnections = []string{testConnectionId, testConnectionId}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].AllowedMsgTypeUrls = []string{"cosmos.gov.v1.MsgVote"}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].SpendLimit = sdk.Coins{sdk.Coin{Denom: "umars", Amount: sdk.ZeroInt()}}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies[0].EpochDuration = 0
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthorityPolicies = append(gs.AuthorityPolicies, gs.AuthorityPolicies[0])
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidAuthoritySpendings(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.AuthoritySpendings[0].Authority = testAuthority.String()
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthoritySpendings = append(gs.AuthoritySpendings, gs.AuthoritySpendings[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.AuthoritySpendings[0].Spent = sdk.Coins{sdk.Coin{Denom: "umars", Amount: sdk.ZeroInt()}}
+	require.Error(t, gs.Validate())
+}
+
+func TestInvalidScheduledOperations(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.ScheduledOperations[0].SendFunds = nil
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations[0].SendMessages = &types.MsgSendMessages{
+		Authority:    testAuthority.String(),
+		ConnectionId: testConnectionId,
+	}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations[0].SendFunds.Amount = sdk.NewCoins()
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations[0].Interval = 0
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations[0].Executions = 3
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations[0].ReservedAmount = sdk.Coins{sdk.Coin{Denom: "umars", Amount: sdk.ZeroInt()}}
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.ScheduledOperations = append(gs.ScheduledOperations, gs.ScheduledOperations[0])
+	require.Error(t, gs.Validate())
+
+	gs = getMockGenesisState()
+	gs.NextScheduledOperationId = 1
+	require.Error(t, gs.Validate())
+}
@@ -0,0 +1,26 @@
+package types
+
+const (
+	// TypeURLMsgModuleQuerySafe is the type URL of the ICA host module's
+	// MsgModuleQuerySafe, of which our MsgModuleQuerySafe is a copy.
+	TypeURLMsgModuleQuerySafe = "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe"
+
+	// TypeURLMsgModuleQuerySafeResponse is the type URL of the ICA host module's
+	// MsgModuleQuerySafeResponse, of which our MsgModuleQuerySafeResponse is a
+	// copy.
+	TypeURLMsgModuleQuerySafeResponse = "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse"
+
+	// QueryPathAllBalances is the path of the bank module's AllBalances query.
+	QueryPathAllBalances = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+	// QueryPathDelegatorDelegations is the path of the staking module's
+	// DelegatorDelegations query.
+	QueryPathDelegatorDelegations = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+
+	// QueryPathHostParams is the path of the ICA host module's Params query.
+	QueryPathHostParams = "/ibc.applications.interchain_accounts.host.v1.Query/Params"
+
+	// AllowAllHostMessages is the wildcard which, if found in the ICA host's
+	// allow-list, allows interchain accounts to execute all messages.
+	AllowAllHostMessages = "*"
+)
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the module's name
 	ModuleName = "envoy"
@@ -13,3 +18,137 @@ const (
 	// QuerierRoute is the module's querier route
 	QuerierRoute = ModuleName
 )
+
+// Keys for the envoy module substore
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+// - 0x01<len_prefixed_channel_id><uint64_bytes>: Packet
+// - 0x02<connection_id>: Recovery
+// - 0x03: uint64
+// - 0x04<len_prefixed_connection_id><uint64_bytes>: QueuedPacket
+// - 0x05<len_prefixed_channel_id><uint64_bytes>: FundedTransfer
+// - 0x06<len_prefixed_channel_id><uint64_bytes>: BalancesQuery
+// - 0x07<connection_id>: AccountBalances
+// - 0x08: time.Time
+// - 0x09<len_prefixed_channel_id><uint64_bytes>: HostParamsQuery
+// - 0x0a<connection_id>: HostParams
+// - 0x0b: time.Time
+// - 0x0c<authority>: AuthorityPolicy
+// - 0x0d<authority>: AuthoritySpending
+// - 0x0e: uint64
+// - 0x0f<uint64_bytes>: ScheduledOperation
+// - 0x10<len_prefixed_channel_id><uint64_bytes>: FundedFee
+var (
+	KeyParams             = []byte{0x00} // key for the module's parameters
+	KeyPacket             = []byte{0x01} // key for the ICS-27 packet records
+	KeyRecovery           = []byte{0x02} // key for the interchain account recoveries
+	KeyNextQueuedPacketID = []byte{0x03} // key for the next queued packet id
+	KeyQueuedPacket       = []byte{0x04} // key for the timed out ICS-27 packets
+	KeyFundedTransfer     = []byte{0x05} // key for the community pool-funded ICS-20 transfers
+	KeyBalancesQuery      = []byte{0x06} // key for the in-flight interchain balances queries
+	KeyAccountBalances    = []byte{0x07} // key for the latest known interchain account balances
+
+	KeyLastBalancesQueryTime = []byte{0x08} // key for the time of the last interchain balances query
+
+	KeyHostParamsQuery = []byte{0x09} // key for the in-flight interchain host params queries
+	KeyHostParams      = []byte{0x0a} // key for the latest known ICA host params
+
+	KeyLastHostParamsQueryTime = []byte{0x0b} // key for the time of the last interchain host params query
+
+	KeyAuthorityPolicy   = []byte{0x0c} // key for the policies of non-governance authorities
+	KeyAuthoritySpending = []byte{0x0d} // key for the spendings of non-governance authorities in their current epochs
+
+	KeyNextScheduledOperationID = []byte{0x0e} // key for the next scheduled operation id
+	KeyScheduledOperation       = []byte{0x0f} // key for the scheduled operations
+
+	KeyFundedFee = []byte{0x10} // key for the community pool-funded ICS-29 relayer fees
+)
+
+// GetPacketKey creates the key for the packet record of the given channel id
+// and sequence
+func GetPacketKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyPacket...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetRecoveryKey creates the key for the recovery record of the given
+// connection id
+func GetRecoveryKey(connectionID string) []byte {
+	return append(KeyRecovery, []byte(connectionID)...)
+}
+
+// GetQueuedPacketPrefix creates the key prefix for the queued packets of the
+// given connection id
+func GetQueuedPacketPrefix(connectionID string) []byte {
+	key := append([]byte{}, KeyQueuedPacket...)
+	return append(key, address.MustLengthPrefix([]byte(connectionID))...)
+}
+
+// GetQueuedPacketKey creates the key for the queued packet of the given
+// connection id and id
+func GetQueuedPacketKey(connectionID string, id uint64) []byte {
+	return append(GetQueuedPacketPrefix(connectionID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFundedTransferKey creates the key for the funded transfer record of the
+// given channel id and sequence
+func GetFundedTransferKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyFundedTransfer...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetFundedFeeKey creates the key for the funded relayer fee record of the
+// given channel id and sequence
+func GetFundedFeeKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyFundedFee...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetBalancesQueryKey creates the key for the balances query record of the
+// given channel id and sequence
+func GetBalancesQueryKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyBalancesQuery...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetAccountBalancesKey creates the key for the account balances of the given
+// connection id
+func GetAccountBalancesKey(connectionID string) []byte {
+	return append(KeyAccountBalances, []byte(connectionID)...)
+}
+
+// GetHostParamsQueryKey creates the key for the host params query record of
+// the given channel id and sequence
+func GetHostParamsQueryKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyHostParamsQuery...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetHostParamsKey creates the key for the host params of the given connection
+// id
+func GetHostParamsKey(connectionID string) []byte {
+	return append(KeyHostParams, []byte(connectionID)...)
+}
+
+// GetAuthorityPolicyKey creates the key for the policy of the given authority
+func GetAuthorityPolicyKey(authority string) []byte {
+	return append(KeyAuthorityPolicy, []byte(authority)...)
+}
+
+// GetAuthoritySpendingKey creates the key for the spending of the given
+// authority
+func GetAuthoritySpendingKey(authority string) []byte {
+	return append(KeyAuthoritySpending, []byte(authority)...)
+}
+
+// GetScheduledOperationKey creates the key for the scheduled operation of the
+// given id
+func GetScheduledOperationKey(id uint64) []byte {
+	return append(KeyScheduledOperation, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns the module's default parameters.
+func DefaultParams() Params {
+	return Params{
+		TransferTimeout:     15 * time.Minute,
+		MessagesTimeout:     15 * time.Minute,
+		RecoveryMaxAttempts: 5,
+		RecoveryBackoff:     10 * time.Minute,
+		QueueExpiry:         30 * 24 * time.Hour,
+		// the queries are disabled by default, as most host chains don't
+		// support ICS-27 queries yet
+		BalancesQueryInterval:   0,
+		HostParamsQueryInterval: 0,
+	}
+}
+
+// Validate validates the given instance of the module's parameters.
+func (p Params) Validate() error {
+	if p.TransferTimeout <= 0 {
+		return fmt.Errorf("transfer timeout must be positive: %s", p.TransferTimeout)
+	}
+
+	if p.MessagesTimeout <= 0 {
+		return fmt.Errorf("messages timeout must be positive: %s", p.MessagesTimeout)
+	}
+
+	if p.RecoveryBackoff <= 0 {
+		return fmt.Errorf("recovery backoff must be positive: %s", p.RecoveryBackoff)
+	}
+
+	if p.QueueExpiry <= 0 {
+		return fmt.Errorf("queue expiry must be positive: %s", p.QueueExpiry)
+	}
+
+	if p.BalancesQueryInterval < 0 {
+		return fmt.Errorf("balances query interval must not be negative: %s", p.BalancesQueryInterval)
+	}
+
+	if p.HostParamsQueryInterval < 0 {
+		return fmt.Errorf("host params query interval must not be negative: %s", p.HostParamsQueryInterval)
+	}
+
+	return nil
+}
@@ -0,0 +1,656 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mars/envoy/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the envoy module.
+type Params struct {
+	// TransferTimeout is the default timeout for ICS-20 packets sent by
+	// Msg/SendFunds, if the message doesn't specify one.
+	TransferTimeout time.Duration `protobuf:"bytes,1,opt,name=transfer_timeout,json=transferTimeout,proto3,stdduration" json:"transfer_timeout" yaml:"transfer_timeout"`
+	// MessagesTimeout is the default timeout for ICS-27 packets sent by
+	// Msg/SendMessages, if the message doesn't specify one.
+	//
+	// NOTE: ICA channels are ordered, so a timed out packet closes the channel.
+	// Choose a value that gives relayers sufficient time.
+	MessagesTimeout time.Duration `protobuf:"bytes,2,opt,name=messages_timeout,json=messagesTimeout,proto3,stdduration" json:"messages_timeout" yaml:"messages_timeout"`
+	// RecoveryMaxAttempts is the maximum number of times the module attempts to
+	// reopen the channel of an interchain account after it was closed due to a
+	// packet timeout. Setting it to zero disables automatic recovery.
+	RecoveryMaxAttempts uint32 `protobuf:"varint,3,opt,name=recovery_max_attempts,json=recoveryMaxAttempts,proto3" json:"recovery_max_attempts,omitempty" yaml:"recovery_max_attempts"`
+	// RecoveryBackoff is the delay between the first and the second recovery
+	// attempts. The delay is doubled after each subsequent attempt.
+	RecoveryBackoff time.Duration `protobuf:"bytes,4,opt,name=recovery_backoff,json=recoveryBackoff,proto3,stdduration" json:"recovery_backoff" yaml:"recovery_backoff"`
+	// QueueExpiry is how long the payload of a timed out ICS-27 packet is kept in
+	// the store, so that it can be resent via Msg/RetryPackets. Payloads older
+	// than this are pruned.
+	QueueExpiry time.Duration `protobuf:"bytes,5,opt,name=queue_expiry,json=queueExpiry,proto3,stdduration" json:"queue_expiry" yaml:"queue_expiry"`
+	// BalancesQueryInterval is how often the module queries the balances and
+	// delegations of its interchain accounts, by sending ICS-27 query packets.
+	// Setting it to zero disables the queries.
+	//
+	// NOTE: only host chains with ibc-go v7.5, v8.2 or later support ICS-27
+	// queries. Others respond with an error acknowledgement.
+	BalancesQueryInterval time.Duration `protobuf:"bytes,6,opt,name=balances_query_interval,json=balancesQueryInterval,proto3,stdduration" json:"balances_query_interval" yaml:"balances_query_interval"`
+	// HostParamsQueryInterval is how often the module queries the ICA host params
+	// of the chains its interchain accounts are on, by sending ICS-27 query
+	// packets. Setting it to zero disables the queries.
+	//
+	// Msg/SendMessages rejects messages whose type URL is not in the host's
+	// allow-list, if the list is known.
+	HostParamsQueryInterval time.Duration `protobuf:"bytes,7,opt,name=host_params_query_interval,json=hostParamsQueryInterval,proto3,stdduration" json:"host_params_query_interval" yaml:"host_params_query_interval"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01005eb55611f3b1, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTransferTimeout() time.Duration {
+	if m != nil {
+		return m.TransferTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMessagesTimeout() time.Duration {
+	if m != nil {
+		return m.MessagesTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetRecoveryMaxAttempts() uint32 {
+	if m != nil {
+		return m.RecoveryMaxAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRecoveryBackoff() time.Duration {
+	if m != nil {
+		return m.RecoveryBackoff
+	}
+	return 0
+}
+
+func (m *Params) GetQueueExpiry() time.Duration {
+	if m != nil {
+		return m.QueueExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetBalancesQueryInterval() time.Duration {
+	if m != nil {
+		return m.BalancesQueryInterval
+	}
+	return 0
+}
+
+func (m *Params) GetHostParamsQueryInterval() time.Duration {
+	if m != nil {
+		return m.HostParamsQueryInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "mars.envoy.v1beta1.Params")
+}
+
+func init() { proto.RegisterFile("mars/envoy/v1beta1/params.proto", fileDescriptor_01005eb55611f3b1) }
+
+var fileDescriptor_01005eb55611f3b1 = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc6, 0x63, 0x5e, 0x82, 0xe4, 0x82, 0x40, 0x2e, 0x55, 0x4c, 0x84, 0xce, 0x21, 0x2c, 0x55,
+	0xa5, 0xfa, 0x54, 0xd8, 0xd8, 0x88, 0xca, 0xc0, 0x80, 0x04, 0x55, 0x27, 0x24, 0x64, 0x9d, 0xcd,
+	0x3f, 0x8e, 0x85, 0xcf, 0xe7, 0xde, 0x4b, 0x14, 0x0f, 0x8c, 0xdd, 0x19, 0xf9, 0x48, 0x1d, 0x3b,
+	0x32, 0x05, 0x94, 0x7c, 0x83, 0x7e, 0x02, 0x74, 0x2f, 0xae, 0xc0, 0x6a, 0x15, 0x65, 0x4b, 0x9e,
+	0xe7, 0xf1, 0xf3, 0x7b, 0x4e, 0xba, 0xf3, 0x23, 0x4a, 0xb8, 0xc0, 0x50, 0xcd, 0x59, 0x83, 0xe7,
+	0x47, 0x29, 0x48, 0x72, 0x84, 0x6b, 0xc2, 0x09, 0x15, 0x71, 0xcd, 0x99, 0x64, 0x41, 0xa0, 0x03,
+	0xb1, 0x09, 0xc4, 0x2e, 0x30, 0x7c, 0x9a, 0xb3, 0x9c, 0x19, 0x1b, 0xeb, 0x5f, 0x36, 0x39, 0x44,
+	0x39, 0x63, 0x79, 0x09, 0xd8, 0xfc, 0x4b, 0xd5, 0x14, 0x7f, 0x55, 0x9c, 0xc8, 0x82, 0x55, 0xd6,
+	0x1f, 0x9f, 0xf7, 0xfd, 0xfe, 0x47, 0x53, 0x1d, 0x14, 0xfe, 0x13, 0xc9, 0x49, 0x25, 0xa6, 0xc0,
+	0x13, 0x59, 0x50, 0x60, 0x4a, 0x86, 0xde, 0xc8, 0xdb, 0xdf, 0x79, 0xf5, 0x2c, 0xb6, 0x2d, 0x71,
+	0xdb, 0x12, 0x1f, 0xbb, 0x96, 0xc9, 0xcb, 0x8b, 0x65, 0xd4, 0xbb, 0x5a, 0x46, 0x83, 0x86, 0xd0,
+	0xf2, 0xcd, 0xb8, 0x5b, 0x30, 0xfe, 0xf9, 0x3b, 0xf2, 0x4e, 0x1e, 0xb7, 0xf2, 0xa9, 0x55, 0x35,
+	0x8a, 0x82, 0x10, 0x24, 0x07, 0x71, 0x8d, 0xba, 0xb3, 0x25, 0xaa, 0x5b, 0xe0, 0x50, 0xad, 0xdc,
+	0xa2, 0x4e, 0xfd, 0x3d, 0x0e, 0x19, 0x9b, 0x03, 0x6f, 0x12, 0x4a, 0x16, 0x09, 0x91, 0x12, 0x68,
+	0x2d, 0x45, 0x78, 0x77, 0xe4, 0xed, 0x3f, 0x9a, 0x8c, 0xae, 0x96, 0xd1, 0x73, 0x5b, 0x78, 0x63,
+	0x6c, 0x7c, 0xb2, 0xdb, 0xea, 0x1f, 0xc8, 0xe2, 0xad, 0x53, 0xf5, 0x01, 0xae, 0xe3, 0x29, 0xc9,
+	0xbe, 0xb1, 0xe9, 0x34, 0xbc, 0xb7, 0xe5, 0x01, 0xba, 0x05, 0xee, 0x00, 0xad, 0x3c, 0xb1, 0x6a,
+	0xf0, 0xc5, 0x7f, 0x78, 0xa6, 0x40, 0x41, 0x02, 0x8b, 0xba, 0xe0, 0x4d, 0x78, 0x7f, 0x13, 0x26,
+	0x72, 0x98, 0x5d, 0x8b, 0xf9, 0xf7, 0x63, 0x8b, 0xd8, 0x31, 0xd2, 0x3b, 0xa3, 0x04, 0xdf, 0xfd,
+	0x41, 0x4a, 0x4a, 0x52, 0x65, 0x20, 0x92, 0x33, 0xa5, 0xe7, 0x14, 0x95, 0x04, 0x3e, 0x27, 0x65,
+	0xd8, 0xdf, 0x44, 0x3a, 0x70, 0x24, 0x64, 0x49, 0xb7, 0xf4, 0x58, 0xe8, 0x5e, 0xeb, 0x7e, 0xd2,
+	0xe6, 0x7b, 0xe7, 0x05, 0xe7, 0x9e, 0x3f, 0x9c, 0x31, 0x21, 0x13, 0x7b, 0xbf, 0xbb, 0x13, 0x1e,
+	0x6c, 0x9a, 0x70, 0xe8, 0x26, 0xbc, 0xb0, 0x13, 0x6e, 0xaf, 0xb2, 0x2b, 0x06, 0x3a, 0x60, 0xef,
+	0xfb, 0x7f, 0x3b, 0x26, 0xc7, 0x17, 0x2b, 0xe4, 0x5d, 0xae, 0x90, 0xf7, 0x67, 0x85, 0xbc, 0x1f,
+	0x6b, 0xd4, 0xbb, 0x5c, 0xa3, 0xde, 0xaf, 0x35, 0xea, 0x7d, 0x3e, 0xc8, 0x0b, 0x39, 0x53, 0x69,
+	0x9c, 0x31, 0x8a, 0xf5, 0xb3, 0x3b, 0x34, 0x23, 0x32, 0x56, 0xe2, 0x99, 0x4a, 0xf1, 0xc2, 0x3d,
+	0x53, 0xd9, 0xd4, 0x20, 0xd2, 0xbe, 0xf1, 0x5e, 0xff, 0x1d, 0x00, 0xe4, 0x77, 0x00, 0x3c, 0xc1,
+	0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HostParamsQueryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HostParamsQueryInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BalancesQueryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BalancesQueryInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.QueueExpiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.QueueExpiry):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecoveryBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecoveryBackoff):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.RecoveryMaxAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecoveryMaxAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MessagesTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessagesTimeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TransferTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeout):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TransferTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessagesTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	if m.RecoveryMaxAttempts != 0 {
+		n += 1 + sovParams(uint64(m.RecoveryMaxAttempts))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecoveryBackoff)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.QueueExpiry)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BalancesQueryInterval)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HostParamsQueryInterval)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TransferTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MessagesTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryMaxAttempts", wireType)
+			}
+			m.RecoveryMaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryMaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RecoveryBackoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.QueueExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancesQueryInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BalancesQueryInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostParamsQueryInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HostParamsQueryInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// Validate validates the given authority policy.
+//
+// - the authority address must be valid
+//
+// - there must be at least one allowed connection, and each must be a valid,
+// non-duplicate connection id
+//
+// - each allowed message type URL must start with a slash, and must not be
+// duplicate
+//
+// - the spend limit must be valid coins
+//
+// - the epoch duration must be positive
+func (p AuthorityPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	if len(p.AllowedConnections) == 0 {
+		return fmt.Errorf("policy of %s must allow at least one connection", p.Authority)
+	}
+
+	seenConnections := make(map[string]bool)
+	for _, connectionID := range p.AllowedConnections {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return fmt.Errorf("policy of %s has invalid connection id: %w", p.Authority, err)
+		}
+
+		if seenConnections[connectionID] {
+			return fmt.Errorf("policy of %s has duplicate connection %s", p.Authority, connectionID)
+		}
+
+		seenConnections[connectionID] = true
+	}
+
+	seenTypeURLs := make(map[string]bool)
+	for _, typeURL := range p.AllowedMsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) < 2 {
+			return fmt.Errorf("policy of %s has invalid message type URL `%s`", p.Authority, typeURL)
+		}
+
+		if seenTypeURLs[typeURL] {
+			return fmt.Errorf("policy of %s has duplicate message type URL %s", p.Authority, typeURL)
+		}
+
+		seenTypeURLs[typeURL] = true
+	}
+
+	if err := p.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("policy of %s has invalid spend limit: %w", p.Authority, err)
+	}
+
+	if p.EpochDuration <= 0 {
+		return fmt.Errorf("policy of %s has non-positive epoch duration: %s", p.Authority, p.EpochDuration)
+	}
+
+	return nil
+}
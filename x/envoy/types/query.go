@@ -0,0 +1,7 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+func (req QuerySimulateMessagesRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return req.Msg.UnpackInterfaces(unpacker)
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ScheduledOperation{}
+
+// Validate validates the given scheduled operation.
+//
+// - the payload must be valid; see validateSchedule
+//
+// - the number of executions must be smaller than the number of repetitions,
+// as completed operations are deleted
+//
+// - the reserved and spent amounts must be valid
+func (op ScheduledOperation) Validate() error {
+	authority := ""
+	if payload := op.Payload(); payload != nil {
+		authority = payload.GetSigners()[0].String()
+	}
+
+	if err := validateSchedule(authority, op.SendFunds, op.SendMessages, op.Interval, op.Repetitions); err != nil {
+		return fmt.Errorf("scheduled operation %d is invalid: %w", op.Id, err)
+	}
+
+	if op.Executions >= op.Repetitions {
+		return fmt.Errorf("scheduled operation %d has been executed %d times out of %d", op.Id, op.Executions, op.Repetitions)
+	}
+
+	if err := op.ReservedAmount.Validate(); err != nil {
+		return fmt.Errorf("scheduled operation %d has invalid reserved amount: %w", op.Id, err)
+	}
+
+	if err := op.SpentAmount.Validate(); err != nil {
+		return fmt.Errorf("scheduled operation %d has invalid spent amount: %w", op.Id, err)
+	}
+
+	return nil
+}
+
+// Payload returns the message to be executed by the operation, or nil if none
+// is set.
+func (op ScheduledOperation) Payload() sdk.Msg {
+	if op.SendFunds != nil {
+		return op.SendFunds
+	}
+
+	if op.SendMessages != nil {
+		return op.SendMessages
+	}
+
+	return nil
+}
+
+// NextExecutionTime returns the block time from which the next execution of
+// the operation is due.
+func (op ScheduledOperation) NextExecutionTime() time.Time {
+	return op.StartTime.Add(op.Interval * time.Duration(op.Executions))
+}
+
+// ExecutionCost returns the amount of coins a single execution of the
+// operation spends, including relayer fees.
+func (op ScheduledOperation) ExecutionCost() sdk.Coins {
+	if op.SendFunds != nil {
+		return op.SendFunds.Amount.Add(TotalRelayerFees(op.SendFunds.Fee, len(op.SendFunds.Amount))...)
+	}
+
+	if op.SendMessages != nil {
+		return TotalRelayerFees(op.SendMessages.Fee, 1)
+	}
+
+	return sdk.NewCoins()
+}
+
+// ReservedAmountFor returns the amount of coins the given number of executions
+// of the operation are to spend.
+func (op ScheduledOperation) ReservedAmountFor(executions uint32) sdk.Coins {
+	cost := op.ExecutionCost()
+
+	reserved := sdk.NewCoins()
+	for _, coin := range cost {
+		reserved = reserved.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(executions))))
+	}
+
+	return reserved
+}
+
+func (op ScheduledOperation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if op.SendMessages != nil {
+		return op.SendMessages.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// validateSchedule asserts that the given operation schedule is valid.
+//
+// - exactly one of the SendFunds and SendMessages payloads must be provided,
+// and it must be valid
+//
+// - the payload's authority must be the given one
+//
+// - the number of repetitions must be positive
+//
+// - the interval must not be negative, and must be positive if the operation
+// is to be repeated
+func validateSchedule(authority string, sendFunds *MsgSendFunds, sendMessages *MsgSendMessages, interval time.Duration, repetitions uint32) error {
+	var payload interface {
+		sdk.Msg
+		ValidateBasic() error
+	}
+
+	switch {
+	case sendFunds != nil && sendMessages != nil:
+		return ErrInvalidSchedule.Wrap("only one of send funds and send messages can be provided")
+	case sendFunds != nil:
+		payload = sendFunds
+	case sendMessages != nil:
+		payload = sendMessages
+	default:
+		return ErrInvalidSchedule.Wrap("one of send funds and send messages must be provided")
+	}
+
+	if err := payload.ValidateBasic(); err != nil {
+		return ErrInvalidSchedule.Wrapf("invalid payload: %s", err)
+	}
+
+	if signer := payload.GetSigners()[0].String(); signer != authority {
+		return ErrInvalidSchedule.Wrapf("payload authority %s is not %s", signer, authority)
+	}
+
+	if repetitions == 0 {
+		return ErrInvalidSchedule.Wrap("repetitions must be positive")
+	}
+
+	if interval < 0 || (repetitions > 1 && interval == 0) {
+		return ErrInvalidSchedule.Wrapf("interval must be positive for repeated operations: %s", interval)
+	}
+
+	return nil
+}
@@ -0,0 +1,825 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mars/envoy/v1beta1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledOperation is a Msg/SendFunds or Msg/SendMessages payload that is
+// executed by the envoy module's EndBlocker at regular intervals, so that
+// routine outpost operations don't each need their own governance proposal.
+//
+// Exactly one of SendFunds and SendMessages is set. The payload's authority is
+// the one that scheduled the operation, which is typically the x/gov module
+// account.
+//
+// The operation is deleted once it has been executed the given number of
+// repetitions, or when it is cancelled.
+type ScheduledOperation struct {
+	// Id is a unique identifier of the scheduled operation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// SendFunds is the payload of a scheduled ICS-20 transfer.
+	SendFunds *MsgSendFunds `protobuf:"bytes,2,opt,name=send_funds,json=sendFunds,proto3" json:"send_funds,omitempty" yaml:"send_funds"`
+	// SendMessages is the payload of a scheduled ICS-27 tx execution.
+	SendMessages *MsgSendMessages `protobuf:"bytes,3,opt,name=send_messages,json=sendMessages,proto3" json:"send_messages,omitempty" yaml:"send_messages"`
+	// StartTime is the block time from which the operation is first executed.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Interval is the duration between two executions of the operation.
+	Interval time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval"`
+	// Repetitions is the total number of times the operation is to be executed.
+	Repetitions uint32 `protobuf:"varint,6,opt,name=repetitions,proto3" json:"repetitions,omitempty"`
+	// Executions is the number of times the operation has been executed so far,
+	// successfully or not.
+	Executions uint32 `protobuf:"varint,7,opt,name=executions,proto3" json:"executions,omitempty"`
+	// ReservedAmount is the amount of coins that the remaining executions are
+	// to spend, including relayer fees. The coins are not escrowed; like for
+	// Msg/SendFunds, they are drawn from the envoy module account, and then the
+	// community pool if the authority is governance, at the time of each
+	// execution.
+	ReservedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=reserved_amount,json=reservedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amount" yaml:"reserved_amount"`
+	// SpentAmount is the amount of coins that the successful executions have
+	// spent so far, including relayer fees.
+	SpentAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=spent_amount,json=spentAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_amount" yaml:"spent_amount"`
+	// LastError is the error message of the last execution, if it failed.
+	LastError string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" yaml:"last_error"`
+}
+
+func (m *ScheduledOperation) Reset()         { *m = ScheduledOperation{} }
+func (m *ScheduledOperation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledOperation) ProtoMessage()    {}
+func (*ScheduledOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4d566fac79112d5, []int{0}
+}
+func (m *ScheduledOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOperation.Merge(m, src)
+}
+func (m *ScheduledOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOperation proto.InternalMessageInfo
+
+func (m *ScheduledOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledOperation) GetSendFunds() *MsgSendFunds {
+	if m != nil {
+		return m.SendFunds
+	}
+	return nil
+}
+
+func (m *ScheduledOperation) GetSendMessages() *MsgSendMessages {
+	if m != nil {
+		return m.SendMessages
+	}
+	return nil
+}
+
+func (m *ScheduledOperation) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScheduledOperation) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledOperation) GetRepetitions() uint32 {
+	if m != nil {
+		return m.Repetitions
+	}
+	return 0
+}
+
+func (m *ScheduledOperation) GetExecutions() uint32 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *ScheduledOperation) GetReservedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReservedAmount
+	}
+	return nil
+}
+
+func (m *ScheduledOperation) GetSpentAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpentAmount
+	}
+	return nil
+}
+
+func (m *ScheduledOperation) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ScheduledOperation)(nil), "mars.envoy.v1beta1.ScheduledOperation")
+}
+
+func init() { proto.RegisterFile("mars/envoy/v1beta1/schedule.proto", fileDescriptor_e4d566fac79112d5) }
+
+var fileDescriptor_e4d566fac79112d5 = []byte{
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xdb, 0x52, 0x9a, 0x49, 0x5b, 0xc4, 0x50, 0x90, 0x09, 0xc2, 0x36, 0x66, 0x13, 0x21,
+	0xd5, 0x56, 0x81, 0x15, 0x1b, 0x84, 0x29, 0x20, 0x21, 0x55, 0x48, 0x2e, 0x42, 0x88, 0x4d, 0xe4,
+	0xc7, 0xad, 0x63, 0x61, 0x7b, 0xac, 0x99, 0x71, 0x94, 0x7c, 0x00, 0x3b, 0x84, 0xba, 0xe4, 0x1b,
+	0xf8, 0x92, 0x2e, 0xbb, 0x64, 0x95, 0xa2, 0xe4, 0x0f, 0xfa, 0x05, 0x68, 0xc6, 0xe3, 0xd4, 0x6a,
+	0x11, 0x88, 0x55, 0x66, 0xee, 0x3d, 0xe7, 0xe4, 0xdc, 0x33, 0xd7, 0xe8, 0x41, 0x1e, 0x50, 0xe6,
+	0x42, 0x31, 0x26, 0x53, 0x77, 0xbc, 0x17, 0x02, 0x0f, 0xf6, 0x5c, 0x16, 0x8d, 0x20, 0xae, 0x32,
+	0x70, 0x4a, 0x4a, 0x38, 0xc1, 0x58, 0x40, 0x1c, 0x09, 0x71, 0x14, 0xa4, 0x6f, 0x44, 0x84, 0xe5,
+	0x84, 0xb9, 0x61, 0xc0, 0x60, 0xc9, 0x8b, 0x48, 0x5a, 0xd4, 0x9c, 0xfe, 0x4e, 0x42, 0x12, 0x22,
+	0x8f, 0xae, 0x38, 0xa9, 0xaa, 0x91, 0x10, 0x92, 0x64, 0xe0, 0xca, 0x5b, 0x58, 0x1d, 0xb9, 0x71,
+	0x45, 0x03, 0x9e, 0x92, 0x86, 0x65, 0x5e, 0xee, 0xf3, 0x34, 0x07, 0xc6, 0x83, 0xbc, 0x54, 0x80,
+	0x7b, 0x7f, 0x70, 0xcb, 0x27, 0x75, 0xd3, 0xfe, 0xba, 0x8e, 0xf0, 0xa1, 0xb2, 0x1e, 0xbf, 0x2b,
+	0xa1, 0x96, 0xc6, 0xdb, 0x68, 0x25, 0x8d, 0x75, 0xcd, 0xd2, 0x06, 0x6b, 0xfe, 0x4a, 0x1a, 0xe3,
+	0x0f, 0x08, 0x31, 0x28, 0xe2, 0xe1, 0x51, 0x55, 0xc4, 0x4c, 0x5f, 0xb1, 0xb4, 0x41, 0xef, 0xb1,
+	0xe5, 0x5c, 0x9d, 0xd1, 0x39, 0x60, 0xc9, 0x21, 0x14, 0xf1, 0x6b, 0x81, 0xf3, 0x6e, 0x9f, 0xcf,
+	0xcc, 0x9b, 0xd3, 0x20, 0xcf, 0x9e, 0xd9, 0x17, 0x6c, 0xdb, 0xef, 0xb2, 0x06, 0x81, 0x43, 0xb4,
+	0x25, 0x3b, 0x39, 0x30, 0x16, 0x24, 0xc0, 0xf4, 0x55, 0x29, 0xfd, 0xf0, 0x2f, 0xd2, 0x07, 0x0a,
+	0xea, 0xe9, 0xe7, 0x33, 0x73, 0xa7, 0xa5, 0xde, 0x68, 0xd8, 0xfe, 0x26, 0x6b, 0xe1, 0xf0, 0x47,
+	0x84, 0x18, 0x0f, 0x28, 0x1f, 0x8a, 0x60, 0xf4, 0x35, 0xf9, 0x07, 0x7d, 0xa7, 0x4e, 0xcd, 0x69,
+	0x52, 0x73, 0xde, 0x37, 0xa9, 0x79, 0xf7, 0x4f, 0x66, 0x66, 0xa7, 0xe5, 0x7c, 0xc9, 0xb5, 0x8f,
+	0xcf, 0x4c, 0xcd, 0xef, 0xca, 0x82, 0x80, 0xe3, 0xe7, 0x68, 0x23, 0x2d, 0x38, 0xd0, 0x71, 0x90,
+	0xe9, 0xd7, 0xa4, 0xee, 0xdd, 0x2b, 0xba, 0xfb, 0xea, 0xb5, 0xbc, 0x0d, 0x21, 0xfb, 0x5d, 0x28,
+	0x2c, 0x49, 0xd8, 0x42, 0x3d, 0x0a, 0x25, 0xf0, 0x54, 0x20, 0x98, 0xbe, 0x6e, 0x69, 0x83, 0x2d,
+	0xbf, 0x5d, 0xc2, 0x06, 0x42, 0x30, 0x81, 0xa8, 0xaa, 0x01, 0xd7, 0x25, 0xa0, 0x55, 0xc1, 0xdf,
+	0x34, 0x74, 0x83, 0x02, 0x03, 0x3a, 0x86, 0x78, 0x18, 0xe4, 0xa4, 0x2a, 0xb8, 0xbe, 0x61, 0xad,
+	0x4a, 0x2b, 0xf5, 0xba, 0x39, 0x62, 0xdd, 0x96, 0x21, 0xbe, 0x24, 0x69, 0xe1, 0xbd, 0x55, 0x13,
+	0xde, 0xa9, 0x27, 0xbc, 0xc4, 0xb7, 0x7f, 0x9c, 0x99, 0x83, 0x24, 0xe5, 0xa3, 0x2a, 0x74, 0x22,
+	0x92, 0xbb, 0x6a, 0x6b, 0xeb, 0x9f, 0x5d, 0x16, 0x7f, 0x76, 0xf9, 0xb4, 0x04, 0x26, 0xa5, 0x98,
+	0xbf, 0xdd, 0xb0, 0x5f, 0x48, 0x32, 0xfe, 0xa2, 0xa1, 0x4d, 0x56, 0x42, 0xc1, 0x1b, 0x37, 0xdd,
+	0x7f, 0xb9, 0x79, 0xa3, 0xdc, 0xdc, 0x52, 0x79, 0xb7, 0xc8, 0xff, 0x67, 0xa5, 0x27, 0xa9, 0xca,
+	0xc7, 0x53, 0x84, 0xb2, 0x80, 0xf1, 0x21, 0x50, 0x4a, 0xa8, 0x8e, 0x2c, 0x6d, 0xd0, 0x6d, 0xef,
+	0xe3, 0x45, 0xcf, 0xf6, 0xbb, 0xe2, 0xf2, 0x4a, 0x9c, 0xbd, 0xfd, 0x93, 0xb9, 0xa1, 0x9d, 0xce,
+	0x0d, 0xed, 0xd7, 0xdc, 0xd0, 0x8e, 0x17, 0x46, 0xe7, 0x74, 0x61, 0x74, 0x7e, 0x2e, 0x8c, 0xce,
+	0xa7, 0x47, 0x2d, 0x1b, 0x62, 0x39, 0x77, 0xe5, 0x0b, 0x47, 0x24, 0x73, 0x47, 0x55, 0xe8, 0x4e,
+	0xd4, 0xf7, 0x25, 0xed, 0x84, 0xeb, 0xb2, 0xf7, 0xe4, 0xf7, 0x00, 0x71, 0x06, 0x21, 0x08, 0x28,
+	0x04, 0x00, 0x00,
+}
+
+func (m *ScheduledOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SpentAmount) > 0 {
+		for iNdEx := len(m.SpentAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ReservedAmount) > 0 {
+		for iNdEx := len(m.ReservedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Repetitions != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Repetitions))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSchedule(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSchedule(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.SendMessages != nil {
+		{
+			size, err := m.SendMessages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SendFunds != nil {
+		{
+			size, err := m.SendFunds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	if m.SendFunds != nil {
+		l = m.SendFunds.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.SendMessages != nil {
+		l = m.SendMessages.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovSchedule(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovSchedule(uint64(l))
+	if m.Repetitions != 0 {
+		n += 1 + sovSchedule(uint64(m.Repetitions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovSchedule(uint64(m.Executions))
+	}
+	if len(m.ReservedAmount) > 0 {
+		for _, e := range m.ReservedAmount {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if len(m.SpentAmount) > 0 {
+		for _, e := range m.SpentAmount {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SendFunds == nil {
+				m.SendFunds = &MsgSendFunds{}
+			}
+			if err := m.SendFunds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SendMessages == nil {
+				m.SendMessages = &MsgSendMessages{}
+			}
+			if err := m.SendMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repetitions", wireType)
+			}
+			m.Repetitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repetitions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedAmount = append(m.ReservedAmount, types.Coin{})
+			if err := m.ReservedAmount[len(m.ReservedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentAmount = append(m.SpentAmount, types.Coin{})
+			if err := m.SpentAmount[len(m.SpentAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// legacySignerFields is the signer fields of messages that are commonly sent to
+// host chains, but whose proto definitions predate the cosmos.msg.v1.signer
+// option.
+var legacySignerFields = map[string][]string{
+	"/cosmwasm.wasm.v1.MsgStoreCode":            {"sender"},
+	"/cosmwasm.wasm.v1.MsgInstantiateContract":  {"sender"},
+	"/cosmwasm.wasm.v1.MsgInstantiateContract2": {"sender"},
+	"/cosmwasm.wasm.v1.MsgExecuteContract":      {"sender"},
+	"/cosmwasm.wasm.v1.MsgMigrateContract":      {"sender"},
+	"/cosmwasm.wasm.v1.MsgUpdateAdmin":          {"sender"},
+	"/cosmwasm.wasm.v1.MsgClearAdmin":           {"sender"},
+	"/ibc.applications.transfer.v1.MsgTransfer": {"sender"},
+}
+
+// GetSignerAddresses returns the addresses that must sign the given message,
+// decoded from bech32 regardless of their prefix, as the message is to be
+// executed on a host chain which uses a different prefix than Mars Hub.
+//
+// The signer fields are determined by the message's cosmos.msg.v1.signer
+// option. Returns false if the message doesn't have this option and isn't one
+// of the known legacy messages, or if a signer field isn't an address string
+// (e.g. the inputs of bank MsgMultiSend), in which case the signers can't be
+// determined.
+//
+// Returns an error if a signer field doesn't contain a valid bech32 address.
+func GetSignerAddresses(msg proto.Message) ([][]byte, bool, error) {
+	fieldNames, found := getSignerFieldNames(msg)
+	if !found {
+		return nil, false, nil
+	}
+
+	value := reflect.ValueOf(msg)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil, false, nil
+	}
+	value = value.Elem()
+
+	signers := [][]byte{}
+	for _, fieldName := range fieldNames {
+		field, found := getFieldByProtoName(value, fieldName)
+		if !found || field.Kind() != reflect.String {
+			return nil, false, nil
+		}
+
+		_, bz, err := bech32.DecodeAndConvert(field.String())
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid %s address `%s`: %w", fieldName, field.String(), err)
+		}
+
+		signers = append(signers, bz)
+	}
+
+	return signers, true, nil
+}
+
+// getSignerFieldNames returns the names of the given message's signer fields,
+// from its cosmos.msg.v1.signer option if it has one, or from the list of known
+// legacy messages otherwise.
+func getSignerFieldNames(msg proto.Message) ([]string, bool) {
+	if fieldNames, found := legacySignerFields["/"+proto.MessageName(msg)]; found {
+		return fieldNames, true
+	}
+
+	descMsg, ok := msg.(descriptor.Message)
+	if !ok {
+		return nil, false
+	}
+
+	_, msgDesc := descriptor.ForMessage(descMsg)
+	if msgDesc.Options == nil {
+		return nil, false
+	}
+
+	// the option's extension descriptor extends the golang/protobuf message
+	// options type, not gogoproto's, so it can't be read with
+	// proto.GetExtension. instead, we find it in the options' wire encoding.
+	bz, err := proto.Marshal(msgDesc.Options)
+	if err != nil {
+		return nil, false
+	}
+
+	fieldNames := []string{}
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, false
+		}
+		bz = bz[n:]
+
+		if num == protowire.Number(msgservice.E_Signer.Field) && typ == protowire.BytesType {
+			fieldName, n := protowire.ConsumeString(bz)
+			if n < 0 {
+				return nil, false
+			}
+			bz = bz[n:]
+
+			fieldNames = append(fieldNames, fieldName)
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return nil, false
+		}
+		bz = bz[n:]
+	}
+
+	return fieldNames, len(fieldNames) > 0
+}
+
+// getFieldByProtoName finds the field of the given struct whose protobuf tag
+// has the given field name.
+func getFieldByProtoName(value reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		tag := value.Type().Field(i).Tag.Get("protobuf")
+		for _, part := range strings.Split(tag, ",") {
+			if part == "name="+name {
+				return value.Field(i), true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
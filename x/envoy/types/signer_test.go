@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+
+	"github.com/mars-protocol/hub/v2/x/envoy/types"
+)
+
+func TestGetSignerAddresses(t *testing.T) {
+	addr := []byte("interchain_account_addr")
+
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", addr)
+	require.NoError(t, err)
+
+	neutronAddr, err := bech32.ConvertAndEncode("neutron", addr)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msg      proto.Message
+		expFound bool
+		expPass  bool
+	}{
+		{
+			"message with signer option",
+			&govv1.MsgVote{Voter: osmoAddr, ProposalId: 1, Option: govv1.OptionYes},
+			true,
+			true,
+		},
+		{
+			"legacy message",
+			&wasm.MsgExecuteContract{Sender: neutronAddr, Contract: neutronAddr, Msg: []byte("{}")},
+			true,
+			true,
+		},
+		{
+			"signer field is not an address",
+			&banktypes.MsgMultiSend{},
+			false,
+			true,
+		},
+		{
+			"invalid signer address",
+			&govv1.MsgVote{Voter: "interchainAccountAddress", ProposalId: 1, Option: govv1.OptionYes},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signers, found, err := types.GetSignerAddresses(tc.msg)
+
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+
+			if tc.expFound {
+				require.Equal(t, [][]byte{addr}, signers)
+			}
+		})
+	}
+}
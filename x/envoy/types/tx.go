@@ -1,15 +1,31 @@
 package types
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 var (
 	_ sdk.Msg = &MsgRegisterAccount{}
 	_ sdk.Msg = &MsgSendFunds{}
 	_ sdk.Msg = &MsgSendMessages{}
+	_ sdk.Msg = &MsgBroadcastMessages{}
+	_ sdk.Msg = &MsgRetryPackets{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetAuthorityPolicy{}
+	_ sdk.Msg = &MsgRemoveAuthorityPolicy{}
+	_ sdk.Msg = &MsgRegisterAccountWithVersion{}
+	_ sdk.Msg = &MsgCloseAccountChannel{}
+	_ sdk.Msg = &MsgScheduleOperation{}
+	_ sdk.Msg = &MsgCancelScheduledOperation{}
 
 	// IMPORTANT: must implement this interface so that the GetCachedValue
 	// method will work.
@@ -20,6 +36,8 @@ var (
 	// example in gov v1:
 	// https://github.com/cosmos/cosmos-sdk/blob/v0.46.7/x/gov/types/v1/msgs.go#L97
 	_ codectypes.UnpackInterfacesMessage = MsgSendMessages{}
+	_ codectypes.UnpackInterfacesMessage = MsgBroadcastMessages{}
+	_ codectypes.UnpackInterfacesMessage = MsgScheduleOperation{}
 )
 
 //------------------------------------------------------------------------------
@@ -62,6 +80,40 @@ func (m *MsgSendFunds) ValidateBasic() error {
 		return ErrInvalidProposalAmount.Wrap(err.Error())
 	}
 
+	// the timeout, if provided, must be positive
+	if err := validateTimeout(m.Timeout); err != nil {
+		return err
+	}
+
+	// the relayer fee, if provided, must be valid
+	if err := validateRelayerFee(m.Fee); err != nil {
+		return err
+	}
+
+	// the connection id must be provided if and only if a route is provided
+	if len(m.Route) == 0 {
+		if m.ConnectionId != "" {
+			return ErrInvalidProposalRoute.Wrap("connection id must not be provided without a route")
+		}
+
+		return nil
+	}
+
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return ErrInvalidProposalRoute.Wrapf("invalid connection id: %s", err)
+	}
+
+	// each hop must have a receiver and a valid channel id
+	for i, hop := range m.Route {
+		if hop.Receiver == "" {
+			return ErrInvalidProposalRoute.Wrapf("hop %d has empty receiver", i)
+		}
+
+		if err := host.ChannelIdentifierValidator(hop.ChannelId); err != nil {
+			return ErrInvalidProposalRoute.Wrapf("hop %d has invalid channel id: %s", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -93,19 +145,23 @@ func (m *MsgSendMessages) ValidateBasic() error {
 		return ErrInvalidProposalMsg.Wrap("proposal must contain at least one message")
 	}
 
-	// ideally, we want to check each message:
-	//
-	//  1. is valid (run msg.ValidateBasic)
-	//  2. has only one signer
-	//  3. this one signer is the interchain account
-	//
-	// unfortunately, these are not possible:
+	// the timeout, if provided, must be positive
+	if err := validateTimeout(m.Timeout); err != nil {
+		return err
+	}
+
+	// the relayer fee, if provided, must be valid
+	if err := validateRelayerFee(m.Fee); err != nil {
+		return err
+	}
+
+	// we can't run the messages' own ValidateBasic or GetSigners here, as the
+	// signer addresses have the host chain's bech32 prefix, which would cause
+	// them to fail despite the messages being perfectly valid.
 	//
-	//  - for 1 and 2, the signer addresses has the host chain's bech prefix,
-	//    this would cause ValidateBasic and GetSigners to fail, despite the
-	//    message is perfectly valid.
-	//  - for 3, this is a stateful check (we need to query the ICA's address)
-	//    while in ValidateBasic we can only do stateless checks.
+	// checking that the signer is the interchain account requires querying the
+	// account's address, which is a stateful check, so it's done by the
+	// msgServer instead.
 	return nil
 }
 
@@ -119,3 +175,338 @@ func (m *MsgSendMessages) GetSigners() []sdk.AccAddress {
 func (m MsgSendMessages) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, m.Messages)
 }
+
+//------------------------------------------------------------------------------
+// MsgBroadcastMessages
+//------------------------------------------------------------------------------
+
+func (m *MsgBroadcastMessages) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// there must be at least one connection
+	if len(m.Connections) < 1 {
+		return ErrInvalidProposalMsg.Wrap("proposal must contain at least one connection")
+	}
+
+	seen := map[string]bool{}
+	for _, connection := range m.Connections {
+		// the connection id must be valid
+		if err := host.ConnectionIdentifierValidator(connection.ConnectionId); err != nil {
+			return ErrInvalidProposalMsg.Wrapf("invalid connection id: %s", err)
+		}
+
+		// each connection may only appear once, as the interchain account's
+		// channel is ordered and there's one packet per connection
+		if seen[connection.ConnectionId] {
+			return ErrInvalidProposalMsg.Wrapf("duplicate connection id: %s", connection.ConnectionId)
+		}
+
+		seen[connection.ConnectionId] = true
+
+		// the messages must each implement the sdk.Msg interface
+		msgs, err := sdktx.GetMsgs(connection.Messages, sdk.MsgTypeURL(m))
+		if err != nil {
+			return ErrInvalidProposalMsg.Wrap(err.Error())
+		}
+
+		// there must be at least one message per connection
+		if len(msgs) < 1 {
+			return ErrInvalidProposalMsg.Wrapf("proposal must contain at least one message for %s", connection.ConnectionId)
+		}
+	}
+
+	// the timeout, if provided, must be positive
+	if err := validateTimeout(m.Timeout); err != nil {
+		return err
+	}
+
+	// the relayer fee, if provided, must be valid
+	if err := validateRelayerFee(m.Fee); err != nil {
+		return err
+	}
+
+	// as for MsgSendMessages, the messages' signers are checked by the
+	// msgServer instead.
+	return nil
+}
+
+func (m *MsgBroadcastMessages) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgBroadcastMessages) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, connection := range m.Connections {
+		if err := sdktx.UnpackInterfaces(unpacker, connection.Messages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//------------------------------------------------------------------------------
+// MsgRetryPackets
+//------------------------------------------------------------------------------
+
+func (m *MsgRetryPackets) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the packet ids must not contain duplicates
+	seenIds := make(map[uint64]bool)
+	for _, id := range m.Ids {
+		if seenIds[id] {
+			return ErrInvalidProposalPacketIds.Wrapf("duplicate packet id: %d", id)
+		}
+
+		seenIds[id] = true
+	}
+
+	// the timeout, if provided, must be positive
+	return validateTimeout(m.Timeout)
+}
+
+func (m *MsgRetryPackets) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgUpdateParams
+//------------------------------------------------------------------------------
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the params must be valid
+	if err := m.Params.Validate(); err != nil {
+		return ErrInvalidParams.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgSetAuthorityPolicy
+//------------------------------------------------------------------------------
+
+func (m *MsgSetAuthorityPolicy) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the policy must be valid
+	if err := m.Policy.Validate(); err != nil {
+		return ErrInvalidAuthorityPolicy.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgSetAuthorityPolicy) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgRemoveAuthorityPolicy
+//------------------------------------------------------------------------------
+
+func (m *MsgRemoveAuthorityPolicy) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the address whose policy is to be removed must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return ErrInvalidAuthorityPolicy.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+func (m *MsgRemoveAuthorityPolicy) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgRegisterAccountWithVersion
+//------------------------------------------------------------------------------
+
+func (m *MsgRegisterAccountWithVersion) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the connection id must be valid
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return ErrInvalidChannelVersion.Wrapf("invalid connection id: %s", err)
+	}
+
+	// the ordering must be one of the enum names, other than NONE
+	if _, err := ParseChannelOrdering(m.Ordering); err != nil {
+		return err
+	}
+
+	// the encoding must be provided. whether it is supported is checked during
+	// the channel handshake
+	if m.Encoding == "" {
+		return ErrInvalidChannelVersion.Wrap("encoding cannot be empty")
+	}
+
+	// the fee version, if provided, must be the one the fee middleware speaks
+	if m.FeeVersion != "" && m.FeeVersion != ibcfeetypes.Version {
+		return ErrInvalidChannelVersion.Wrapf("unsupported fee version %s, expecting %s", m.FeeVersion, ibcfeetypes.Version)
+	}
+
+	return nil
+}
+
+func (m *MsgRegisterAccountWithVersion) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgCloseAccountChannel
+//------------------------------------------------------------------------------
+
+func (m *MsgCloseAccountChannel) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the connection id must be valid
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid connection id: %s", err)
+	}
+
+	return nil
+}
+
+func (m *MsgCloseAccountChannel) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgScheduleOperation
+//------------------------------------------------------------------------------
+
+func (m *MsgScheduleOperation) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the payload must be valid and executed by the same authority, and the
+	// schedule must be valid
+	return validateSchedule(m.Authority, m.SendFunds, m.SendMessages, m.Interval, m.Repetitions)
+}
+
+func (m *MsgScheduleOperation) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgScheduleOperation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.SendMessages != nil {
+		return m.SendMessages.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+//------------------------------------------------------------------------------
+// MsgCancelScheduledOperation
+//------------------------------------------------------------------------------
+
+func (m *MsgCancelScheduledOperation) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgCancelScheduledOperation) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// Helpers
+//------------------------------------------------------------------------------
+
+// ParseChannelOrdering parses a channel ordering from its enum name, e.g.
+// ORDER_ORDERED. The NONE ordering is not accepted.
+func ParseChannelOrdering(ordering string) (ibcchanneltypes.Order, error) {
+	order, found := ibcchanneltypes.Order_value[ordering]
+	if !found || ibcchanneltypes.Order(order) == ibcchanneltypes.NONE {
+		return ibcchanneltypes.NONE, ErrInvalidChannelVersion.Wrapf("invalid channel ordering: %s", ordering)
+	}
+
+	return ibcchanneltypes.Order(order), nil
+}
+
+// validateTimeout asserts that an optional packet timeout, if provided, is
+// positive. If not provided, the module's default timeout will be used.
+func validateTimeout(timeout *time.Duration) error {
+	if timeout != nil && *timeout <= 0 {
+		return ErrInvalidProposalTimeout.Wrapf("timeout must be positive: %s", timeout)
+	}
+
+	return nil
+}
+
+// validateRelayerFee asserts that an optional relayer fee, if provided, is
+// valid. If not provided, no fee will be paid.
+func validateRelayerFee(fee *RelayerFee) error {
+	if fee == nil {
+		return nil
+	}
+
+	if err := fee.Validate(); err != nil {
+		return ErrInvalidRelayerFee.Wrap(err.Error())
+	}
+
+	return nil
+}
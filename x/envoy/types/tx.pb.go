@@ -14,18 +14,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -149,6 +154,27 @@ type MsgSendFunds struct {
 	// Here we support multiple coins in one proposal. As ICS-20 specs only allow
 	// one denom per packet, we will have one packet per denom.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Timeout is the timeout for the ICS-20 packets, relative to the block time
+	// at which this message is executed. If not provided, the module's default
+	// transfer timeout is used.
+	Timeout *time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// Route is an optional list of hops through which the funds are to be
+	// forwarded by packet-forward-middleware, for outposts that Mars Hub doesn't
+	// have a direct transfer channel with.
+	//
+	// If provided, the channel specified by ChannelId leads to the first
+	// intermediate chain rather than to the outpost. Only the module's
+	// authorities may provide a route, as the route's destination can't be
+	// verified to be the chain of the connection specified by ConnectionId.
+	Route []ForwardHop `protobuf:"bytes,5,rep,name=route,proto3" json:"route"`
+	// ConnectionId identifies the connection on which the interchain account to
+	// be funded is registered. Must be provided if and only if a route is
+	// provided. Otherwise, the connection is the one of the transfer channel.
+	ConnectionId string `protobuf:"bytes,6,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// Fee is an optional ICS-29 fee to incentivize the relaying of each of the
+	// ICS-20 packets. It is paid once per packet, i.e. once per coin. The
+	// transfer channel must be fee-enabled.
+	Fee *RelayerFee `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *MsgSendFunds) Reset()         { *m = MsgSendFunds{} }
@@ -205,15 +231,178 @@ func (m *MsgSendFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgSendFunds) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *MsgSendFunds) GetRoute() []ForwardHop {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *MsgSendFunds) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgSendFunds) GetFee() *RelayerFee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// RelayerFee is an ICS-29 fee paid to the relayers of a packet.
+//
+// The fee is escrowed by the fee middleware when the packet is sent. The recv
+// and ack fees are paid to the relayers once the packet is acknowledged, and
+// the timeout fee is refunded. If the packet times out, the timeout fee is
+// paid to the relayer, and the recv and ack fees are refunded. Refunds are
+// made to the envoy module account; the part of them drawn from the community
+// pool, if any, is then returned to the community pool.
+type RelayerFee struct {
+	// RecvFee is the fee paid to the relayer that delivers the packet to the
+	// counterparty chain.
+	RecvFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recv_fee,json=recvFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fee" yaml:"recv_fee"`
+	// AckFee is the fee paid to the relayer that relays the acknowledgement back
+	// to Mars Hub.
+	AckFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee" yaml:"ack_fee"`
+	// TimeoutFee is the fee paid to the relayer that relays the timeout back to
+	// Mars Hub.
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=timeout_fee,json=timeoutFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fee" yaml:"timeout_fee"`
+}
+
+func (m *RelayerFee) Reset()         { *m = RelayerFee{} }
+func (m *RelayerFee) String() string { return proto.CompactTextString(m) }
+func (*RelayerFee) ProtoMessage()    {}
+func (*RelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{3}
+}
+func (m *RelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerFee.Merge(m, src)
+}
+func (m *RelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerFee proto.InternalMessageInfo
+
+func (m *RelayerFee) GetRecvFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFee
+	}
+	return nil
+}
+
+func (m *RelayerFee) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
+	}
+	return nil
+}
+
+func (m *RelayerFee) GetTimeoutFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFee
+	}
+	return nil
+}
+
+// ForwardHop is a hop in the route of a forwarded ICS-20 transfer.
+type ForwardHop struct {
+	// Receiver is the address on the intermediate chain that receives the funds
+	// before forwarding them. Packet-forward-middleware doesn't actually send
+	// the funds to this address, but requires it to be a valid address.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// ChannelId identifies the transfer channel on the intermediate chain
+	// through which the funds are to be forwarded.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *ForwardHop) Reset()         { *m = ForwardHop{} }
+func (m *ForwardHop) String() string { return proto.CompactTextString(m) }
+func (*ForwardHop) ProtoMessage()    {}
+func (*ForwardHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{4}
+}
+func (m *ForwardHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHop.Merge(m, src)
+}
+func (m *ForwardHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHop proto.InternalMessageInfo
+
+func (m *ForwardHop) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ForwardHop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 // MsgSendFundsResponse is the respones type for the Msg/SendFunds RPC method.
 type MsgSendFundsResponse struct {
+	// Sequences is the sequence numbers of the ICS-20 packets, one per coin, in
+	// the same order as the coins.
+	Sequences []uint64 `protobuf:"varint,1,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
 }
 
 func (m *MsgSendFundsResponse) Reset()         { *m = MsgSendFundsResponse{} }
 func (m *MsgSendFundsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFundsResponse) ProtoMessage()    {}
 func (*MsgSendFundsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eee636e4d7b527ef, []int{3}
+	return fileDescriptor_eee636e4d7b527ef, []int{5}
 }
 func (m *MsgSendFundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,6 +431,13 @@ func (m *MsgSendFundsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendFundsResponse proto.InternalMessageInfo
 
+func (m *MsgSendFundsResponse) GetSequences() []uint64 {
+	if m != nil {
+		return m.Sequences
+	}
+	return nil
+}
+
 // MsgSendMessages is the request type for the Msg/SendMessages RPC method.
 //
 // This message is typically executed via a governance proposal with the gov
@@ -256,13 +452,23 @@ type MsgSendMessages struct {
 	// Messages is an array of one or more messages that are to be executed by the
 	// interchain account.
 	Messages []*types1.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// Timeout is the timeout for the ICS-27 packet, relative to the block time
+	// at which this message is executed. If not provided, the module's default
+	// messages timeout is used.
+	//
+	// A proposal may choose a longer timeout than the default, e.g. if the
+	// relayers on this connection are known to be slow.
+	Timeout *time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// Fee is an optional ICS-29 fee to incentivize the relaying of the ICS-27
+	// packet. The interchain account's channel must be fee-enabled.
+	Fee *RelayerFee `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *MsgSendMessages) Reset()         { *m = MsgSendMessages{} }
 func (m *MsgSendMessages) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessages) ProtoMessage()    {}
 func (*MsgSendMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eee636e4d7b527ef, []int{4}
+	return fileDescriptor_eee636e4d7b527ef, []int{6}
 }
 func (m *MsgSendMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,16 +518,32 @@ func (m *MsgSendMessages) GetMessages() []*types1.Any {
 	return nil
 }
 
+func (m *MsgSendMessages) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *MsgSendMessages) GetFee() *RelayerFee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 // MsgSendMessagesResponse is the response type for the Msg/SendMessages RPC
 // method.
 type MsgSendMessagesResponse struct {
+	// Sequence is the sequence number of the ICS-27 packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *MsgSendMessagesResponse) Reset()         { *m = MsgSendMessagesResponse{} }
 func (m *MsgSendMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessagesResponse) ProtoMessage()    {}
 func (*MsgSendMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eee636e4d7b527ef, []int{5}
+	return fileDescriptor_eee636e4d7b527ef, []int{7}
 }
 func (m *MsgSendMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,547 +572,6334 @@ func (m *MsgSendMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendMessagesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterAccount)(nil), "mars.envoy.v1beta1.MsgRegisterAccount")
-	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "mars.envoy.v1beta1.MsgRegisterAccountResponse")
-	proto.RegisterType((*MsgSendFunds)(nil), "mars.envoy.v1beta1.MsgSendFunds")
-	proto.RegisterType((*MsgSendFundsResponse)(nil), "mars.envoy.v1beta1.MsgSendFundsResponse")
-	proto.RegisterType((*MsgSendMessages)(nil), "mars.envoy.v1beta1.MsgSendMessages")
-	proto.RegisterType((*MsgSendMessagesResponse)(nil), "mars.envoy.v1beta1.MsgSendMessagesResponse")
+func (m *MsgSendMessagesResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("mars/envoy/v1beta1/tx.proto", fileDescriptor_eee636e4d7b527ef) }
-
-var fileDescriptor_eee636e4d7b527ef = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x1b, 0x11, 0x91, 0x6d, 0xa0, 0xc2, 0x0a, 0x34, 0x31, 0xc8, 0x89, 0x8c, 0x84, 0xa2,
-	0xa0, 0xec, 0x26, 0x05, 0x71, 0x88, 0xc4, 0x21, 0x01, 0x21, 0xf5, 0x90, 0x8b, 0x7b, 0x40, 0xe2,
-	0x52, 0xfc, 0xb3, 0x6c, 0x2c, 0xe2, 0xdd, 0xc8, 0xbb, 0x8e, 0xea, 0x1b, 0xe2, 0x09, 0x10, 0x8f,
-	0xc1, 0xa9, 0x42, 0x3c, 0x44, 0xc5, 0xa9, 0xe2, 0xc4, 0x29, 0xa0, 0xe4, 0xd0, 0x33, 0x7d, 0x02,
-	0x64, 0x7b, 0xed, 0xb4, 0x8d, 0xa0, 0x15, 0x9c, 0x36, 0x93, 0xef, 0x9b, 0x99, 0x6f, 0x66, 0xbe,
-	0x04, 0xdc, 0xf5, 0xad, 0x80, 0x23, 0x4c, 0x67, 0x2c, 0x42, 0xb3, 0x9e, 0x8d, 0x85, 0xd5, 0x43,
-	0xe2, 0x00, 0x4e, 0x03, 0x26, 0x98, 0xaa, 0xc6, 0x20, 0x4c, 0x40, 0x28, 0x41, 0x4d, 0x77, 0x18,
-	0xf7, 0x19, 0x47, 0xb6, 0xc5, 0x71, 0x9e, 0xe1, 0x30, 0x8f, 0xa6, 0x39, 0xda, 0xb6, 0xc4, 0x7d,
-	0x4e, 0xd0, 0xac, 0x17, 0x3f, 0x12, 0xa8, 0xa7, 0xc0, 0x7e, 0x12, 0xa1, 0x34, 0x90, 0x50, 0x95,
-	0x30, 0xc2, 0xd2, 0xef, 0xe3, 0x4f, 0x59, 0x02, 0x61, 0x8c, 0x4c, 0x30, 0x4a, 0x22, 0x3b, 0x7c,
-	0x83, 0x2c, 0x1a, 0xa5, 0x90, 0xf1, 0x51, 0x01, 0xea, 0x88, 0x13, 0x13, 0x13, 0x8f, 0x0b, 0x1c,
-	0x0c, 0x1c, 0x87, 0x85, 0x54, 0xa8, 0x5d, 0x50, 0xe2, 0x98, 0xba, 0x38, 0xa8, 0x29, 0x4d, 0xa5,
-	0x55, 0x1e, 0xd6, 0xbe, 0x7d, 0xe9, 0x54, 0x65, 0xa7, 0x81, 0xeb, 0x06, 0x98, 0xf3, 0x3d, 0x11,
-	0x78, 0x94, 0x98, 0x92, 0xa7, 0x3e, 0x05, 0x37, 0x1c, 0x46, 0x29, 0x76, 0x84, 0xc7, 0xe8, 0xbe,
-	0xe7, 0xd6, 0x36, 0xd2, 0xc4, 0xd3, 0x79, 0xa3, 0x1a, 0x59, 0xfe, 0xa4, 0x6f, 0x9c, 0x83, 0x0d,
-	0xb3, 0xb2, 0x8a, 0x77, 0xdd, 0xfe, 0xe6, 0xfb, 0x93, 0xc3, 0xb6, 0xac, 0x65, 0xdc, 0x03, 0xda,
-	0xba, 0x26, 0x13, 0xf3, 0x29, 0xa3, 0x1c, 0x1b, 0xbf, 0x14, 0x50, 0x19, 0x71, 0xb2, 0x87, 0xa9,
-	0xfb, 0x22, 0xa4, 0x2e, 0x57, 0x9f, 0x80, 0xb2, 0x15, 0x8a, 0x31, 0x0b, 0x3c, 0x11, 0x5d, 0xaa,
-	0x77, 0x45, 0x55, 0x1f, 0x03, 0xe0, 0x8c, 0x2d, 0x4a, 0xf1, 0x64, 0xa5, 0xf7, 0xf6, 0xe9, 0xbc,
-	0x71, 0x4b, 0xea, 0xcd, 0x31, 0xc3, 0x2c, 0xcb, 0x60, 0xd7, 0x55, 0x1d, 0x50, 0xb2, 0xfc, 0x58,
-	0x50, 0xad, 0xd8, 0x2c, 0xb6, 0x36, 0x77, 0xea, 0x50, 0xf6, 0x89, 0xef, 0x98, 0x1d, 0x17, 0x3e,
-	0x63, 0x1e, 0x1d, 0x76, 0x8f, 0xe6, 0x8d, 0xc2, 0xa7, 0x1f, 0x8d, 0x16, 0xf1, 0xc4, 0x38, 0xb4,
-	0xa1, 0xc3, 0x7c, 0x79, 0x2e, 0xf9, 0x74, 0xb8, 0xfb, 0x16, 0x89, 0x68, 0x8a, 0x79, 0x92, 0xc0,
-	0x4d, 0x59, 0xba, 0x7f, 0x33, 0x5e, 0xc7, 0x4a, 0xaa, 0x71, 0x07, 0x54, 0xcf, 0x8e, 0x9c, 0xef,
-	0xe2, 0xab, 0x02, 0xb6, 0x24, 0x30, 0xc2, 0x9c, 0x5b, 0x04, 0xff, 0xfb, 0x3a, 0xfe, 0xef, 0x82,
-	0x6a, 0x17, 0x5c, 0xf7, 0xa5, 0x04, 0xb9, 0x99, 0x2a, 0x4c, 0x7d, 0x07, 0x33, 0xdf, 0xc1, 0x01,
-	0x8d, 0xcc, 0x9c, 0xb5, 0x36, 0x64, 0x1d, 0x6c, 0x5f, 0x98, 0x25, 0x9b, 0x73, 0xe7, 0xf3, 0x06,
-	0x28, 0x8e, 0x38, 0x51, 0x3d, 0xb0, 0x75, 0xd1, 0xaa, 0x0f, 0xe0, 0xfa, 0x6f, 0x0b, 0xae, 0xdb,
-	0x47, 0x83, 0x57, 0xe3, 0x65, 0x2d, 0xd5, 0x97, 0xa0, 0xbc, 0xb2, 0x58, 0xf3, 0x0f, 0xc9, 0x39,
-	0x43, 0x6b, 0x5d, 0xc6, 0xc8, 0x0b, 0xbf, 0x06, 0x95, 0x73, 0xf7, 0xba, 0xff, 0x97, 0xcc, 0x8c,
-	0xa4, 0x3d, 0xbc, 0x02, 0x29, 0xeb, 0xa0, 0x5d, 0x7b, 0x77, 0x72, 0xd8, 0x56, 0x86, 0xcf, 0x8f,
-	0x16, 0xba, 0x72, 0xbc, 0xd0, 0x95, 0x9f, 0x0b, 0x5d, 0xf9, 0xb0, 0xd4, 0x0b, 0xc7, 0x4b, 0xbd,
-	0xf0, 0x7d, 0xa9, 0x17, 0x5e, 0xb5, 0xcf, 0x18, 0x32, 0xae, 0xdb, 0x49, 0x2e, 0xe4, 0xb0, 0x09,
-	0x1a, 0x87, 0x36, 0x3a, 0x90, 0xff, 0x62, 0x89, 0x31, 0xed, 0x52, 0x82, 0x3d, 0xfa, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x0a, 0x42, 0xb6, 0x5c, 0xe0, 0x04, 0x00, 0x00,
+// ConnectionMessages is a set of messages that are to be executed by the
+// interchain account on a connection.
+type ConnectionMessages struct {
+	// ConnectionId identifies the connection through which the messages are to
+	// be sent.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// Messages is an array of one or more messages that are to be executed by the
+	// interchain account.
+	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ConnectionMessages) Reset()         { *m = ConnectionMessages{} }
+func (m *ConnectionMessages) String() string { return proto.CompactTextString(m) }
+func (*ConnectionMessages) ProtoMessage()    {}
+func (*ConnectionMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{8}
+}
+func (m *ConnectionMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionMessages.Merge(m, src)
+}
+func (m *ConnectionMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionMessages.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ConnectionMessages proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterAccount creates a new interchain account on the given connection,
-	// or if an interchain account already exists but its channel is closed (due
-	// to a packet having timed out), open a new channel for this account.
-	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
-	// SendFunds is a governance operation for sending funds to an interchain
-	// account via ICS-20.
-	//
-	// The envoy module will first attempt to use the balance held in its own
-	// module account. If the balance is not sufficient, it will attempt to draw
-	// the difference from the community pool.
-	SendFunds(ctx context.Context, in *MsgSendFunds, opts ...grpc.CallOption) (*MsgSendFundsResponse, error)
-	// SendMessages is a governance operation for sending one or more messages to
-	// the host chain to be executed by the interchain account.
-	SendMessages(ctx context.Context, in *MsgSendMessages, opts ...grpc.CallOption) (*MsgSendMessagesResponse, error)
+func (m *ConnectionMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *ConnectionMessages) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgBroadcastMessages is the request type for the Msg/BroadcastMessages RPC
+// method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority, e.g. to make the same parameter change
+// on the contracts of several outposts.
+type MsgBroadcastMessages struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Connections is the messages to be sent through each connection. Each
+	// connection may only appear once.
+	Connections []ConnectionMessages `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections"`
+	// Timeout is the timeout for the ICS-27 packets, relative to the block time
+	// at which this message is executed. If not provided, the module's default
+	// messages timeout is used.
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// Fee is an optional ICS-29 fee to incentivize the relaying of each of the
+	// ICS-27 packets. The interchain accounts' channels must be fee-enabled.
+	Fee *RelayerFee `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
-	out := new(MsgRegisterAccountResponse)
-	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/RegisterAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBroadcastMessages) Reset()         { *m = MsgBroadcastMessages{} }
+func (m *MsgBroadcastMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgBroadcastMessages) ProtoMessage()    {}
+func (*MsgBroadcastMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{9}
+}
+func (m *MsgBroadcastMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBroadcastMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBroadcastMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgBroadcastMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBroadcastMessages.Merge(m, src)
+}
+func (m *MsgBroadcastMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBroadcastMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBroadcastMessages.DiscardUnknown(m)
 }
 
-func (c *msgClient) SendFunds(ctx context.Context, in *MsgSendFunds, opts ...grpc.CallOption) (*MsgSendFundsResponse, error) {
-	out := new(MsgSendFundsResponse)
-	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/SendFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBroadcastMessages proto.InternalMessageInfo
+
+func (m *MsgBroadcastMessages) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SendMessages(ctx context.Context, in *MsgSendMessages, opts ...grpc.CallOption) (*MsgSendMessagesResponse, error) {
-	out := new(MsgSendMessagesResponse)
-	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/SendMessages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBroadcastMessages) GetConnections() []ConnectionMessages {
+	if m != nil {
+		return m.Connections
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterAccount creates a new interchain account on the given connection,
-	// or if an interchain account already exists but its channel is closed (due
-	// to a packet having timed out), open a new channel for this account.
-	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
-	// SendFunds is a governance operation for sending funds to an interchain
-	// account via ICS-20.
-	//
-	// The envoy module will first attempt to use the balance held in its own
-	// module account. If the balance is not sufficient, it will attempt to draw
-	// the difference from the community pool.
-	SendFunds(context.Context, *MsgSendFunds) (*MsgSendFundsResponse, error)
-	// SendMessages is a governance operation for sending one or more messages to
-	// the host chain to be executed by the interchain account.
-	SendMessages(context.Context, *MsgSendMessages) (*MsgSendMessagesResponse, error)
+func (m *MsgBroadcastMessages) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBroadcastMessages) GetFee() *RelayerFee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+// BroadcastResult is the outcome of sending the messages of a broadcast through
+// one connection.
+type BroadcastResult struct {
+	// ConnectionId identifies the connection through which the messages were
+	// sent.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// ChannelId identifies the interchain account's channel on the connection.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// Sequence is the sequence number of the ICS-27 packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
-func (*UnimplementedMsgServer) SendFunds(ctx context.Context, req *MsgSendFunds) (*MsgSendFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendFunds not implemented")
+
+func (m *BroadcastResult) Reset()         { *m = BroadcastResult{} }
+func (m *BroadcastResult) String() string { return proto.CompactTextString(m) }
+func (*BroadcastResult) ProtoMessage()    {}
+func (*BroadcastResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{10}
 }
-func (*UnimplementedMsgServer) SendMessages(ctx context.Context, req *MsgSendMessages) (*MsgSendMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessages not implemented")
+func (m *BroadcastResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *BroadcastResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BroadcastResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BroadcastResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastResult.Merge(m, src)
+}
+func (m *BroadcastResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BroadcastResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastResult.DiscardUnknown(m)
 }
 
-func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mars.envoy.v1beta1.Msg/RegisterAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+var xxx_messageInfo_BroadcastResult proto.InternalMessageInfo
+
+func (m *BroadcastResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SendFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendFunds)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mars.envoy.v1beta1.Msg/SendFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendFunds(ctx, req.(*MsgSendFunds))
+func (m *BroadcastResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SendMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendMessages)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendMessages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mars.envoy.v1beta1.Msg/SendMessages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendMessages(ctx, req.(*MsgSendMessages))
+func (m *BroadcastResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mars.envoy.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAccount",
-			Handler:    _Msg_RegisterAccount_Handler,
-		},
-		{
-			MethodName: "SendFunds",
-			Handler:    _Msg_SendFunds_Handler,
-		},
-		{
-			MethodName: "SendMessages",
-			Handler:    _Msg_SendMessages_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mars/envoy/v1beta1/tx.proto",
+// MsgBroadcastMessagesResponse is the response type for the
+// Msg/BroadcastMessages RPC method.
+type MsgBroadcastMessagesResponse struct {
+	// Results is the outcome of each connection, in the order of the request.
+	Results []BroadcastResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBroadcastMessagesResponse) Reset()         { *m = MsgBroadcastMessagesResponse{} }
+func (m *MsgBroadcastMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBroadcastMessagesResponse) ProtoMessage()    {}
+func (*MsgBroadcastMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{11}
 }
-
-func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBroadcastMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBroadcastMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBroadcastMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBroadcastMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBroadcastMessagesResponse.Merge(m, src)
 }
-
-func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBroadcastMessagesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBroadcastMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBroadcastMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendFunds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBroadcastMessagesResponse proto.InternalMessageInfo
+
+func (m *MsgBroadcastMessagesResponse) GetResults() []BroadcastResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSendFunds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRetryPackets is the request type for the Msg/RetryPackets RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgRetryPackets struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ConnectionId identifies the connection whose queued packets are to be
+	// resent.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// Ids optionally specifies which queued packets are to be resent. If empty,
+	// all queued packets of the connection are resent.
+	//
+	// Packets are resent in the order they are queued, regardless of the order
+	// they are specified here.
+	Ids []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Timeout is the timeout for the ICS-27 packets, relative to the block time
+	// at which this message is executed. If not provided, the module's default
+	// messages timeout is used.
+	Timeout *time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-func (m *MsgSendFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRetryPackets) Reset()         { *m = MsgRetryPackets{} }
+func (m *MsgRetryPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPackets) ProtoMessage()    {}
+func (*MsgRetryPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{12}
+}
+func (m *MsgRetryPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRetryPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPackets.Merge(m, src)
+}
+func (m *MsgRetryPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPackets.DiscardUnknown(m)
 }
 
-func (m *MsgSendFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRetryPackets proto.InternalMessageInfo
+
+func (m *MsgRetryPackets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSendFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRetryPackets) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
 }
 
-func (m *MsgSendFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRetryPackets) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
 }
 
-func (m *MsgSendMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRetryPackets) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSendMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRetryPacketsResponse is the response type for the Msg/RetryPackets RPC
+// method.
+type MsgRetryPacketsResponse struct {
+	// Sequences is the sequence numbers of the resent packets, in the same order
+	// as they were sent.
+	Sequences []uint64 `protobuf:"varint,1,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
 }
 
-func (m *MsgSendMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRetryPacketsResponse) Reset()         { *m = MsgRetryPacketsResponse{} }
+func (m *MsgRetryPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryPacketsResponse) ProtoMessage()    {}
+func (*MsgRetryPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{13}
+}
+func (m *MsgRetryPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRetryPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryPacketsResponse.Merge(m, src)
+}
+func (m *MsgRetryPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryPacketsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRetryPacketsResponse proto.InternalMessageInfo
+
+func (m *MsgRetryPacketsResponse) GetSequences() []uint64 {
+	if m != nil {
+		return m.Sequences
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSendMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParams is the request type for the Msg/UpdateParams RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgUpdateParams struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params is the new parameters of the module. All parameters must be
+	// provided.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSendMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{14}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgRegisterAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *MsgRegisterAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgUpdateParamsResponse is the response type for the Msg/UpdateParams RPC
+// method.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendFunds) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetAuthorityPolicy is the request type for the Msg/SetAuthorityPolicy RPC
+// method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgSetAuthorityPolicy struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Policy is the policy to be set. It replaces the existing policy of the
+	// same account, if any.
+	Policy AuthorityPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetAuthorityPolicy) Reset()         { *m = MsgSetAuthorityPolicy{} }
+func (m *MsgSetAuthorityPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthorityPolicy) ProtoMessage()    {}
+func (*MsgSetAuthorityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{16}
+}
+func (m *MsgSetAuthorityPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthorityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthorityPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetAuthorityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthorityPolicy.Merge(m, src)
+}
+func (m *MsgSetAuthorityPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAuthorityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthorityPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAuthorityPolicy proto.InternalMessageInfo
+
+func (m *MsgSetAuthorityPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetAuthorityPolicy) GetPolicy() AuthorityPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return AuthorityPolicy{}
+}
+
+// MsgSetAuthorityPolicyResponse is the response type for the
+// Msg/SetAuthorityPolicy RPC method.
+type MsgSetAuthorityPolicyResponse struct {
+}
+
+func (m *MsgSetAuthorityPolicyResponse) Reset()         { *m = MsgSetAuthorityPolicyResponse{} }
+func (m *MsgSetAuthorityPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthorityPolicyResponse) ProtoMessage()    {}
+func (*MsgSetAuthorityPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{17}
+}
+func (m *MsgSetAuthorityPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthorityPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthorityPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetAuthorityPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthorityPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetAuthorityPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAuthorityPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthorityPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetAuthorityPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveAuthorityPolicy is the request type for the
+// Msg/RemoveAuthorityPolicy RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgRemoveAuthorityPolicy struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Address is the account whose policy is to be removed.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveAuthorityPolicy) Reset()         { *m = MsgRemoveAuthorityPolicy{} }
+func (m *MsgRemoveAuthorityPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthorityPolicy) ProtoMessage()    {}
+func (*MsgRemoveAuthorityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{18}
+}
+func (m *MsgRemoveAuthorityPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthorityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthorityPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRemoveAuthorityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthorityPolicy.Merge(m, src)
+}
+func (m *MsgRemoveAuthorityPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthorityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthorityPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgSendMessages) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveAuthorityPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveAuthorityPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRemoveAuthorityPolicy) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgRemoveAuthorityPolicyResponse is the response type for the
+// Msg/RemoveAuthorityPolicy RPC method.
+type MsgRemoveAuthorityPolicyResponse struct {
+}
+
+func (m *MsgRemoveAuthorityPolicyResponse) Reset()         { *m = MsgRemoveAuthorityPolicyResponse{} }
+func (m *MsgRemoveAuthorityPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthorityPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveAuthorityPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{19}
+}
+func (m *MsgRemoveAuthorityPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthorityPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthorityPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthorityPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthorityPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveAuthorityPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthorityPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthorityPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAuthorityPolicyResponse proto.InternalMessageInfo
+
+// MsgRegisterAccountWithVersion is the request type for the
+// Msg/RegisterAccountWithVersion RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgRegisterAccountWithVersion struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ConnectionId identifies the connection on which the interchain account is
+	// to be registered.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// Ordering is the ordering of the channel to be opened, in the format of the
+	// ibc.core.channel.v1.Order enum names, e.g. ORDER_ORDERED.
+	Ordering string `protobuf:"bytes,3,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	// Encoding is the format in which messages are to be encoded in the ICS-27
+	// packets, e.g. proto3.
+	Encoding string `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// FeeVersion is the version of the ICS-29 fee middleware to be negotiated
+	// for the channel, e.g. ics29-1. If empty, the channel is opened without
+	// the fee middleware wrapper.
+	FeeVersion string `protobuf:"bytes,5,opt,name=fee_version,json=feeVersion,proto3" json:"fee_version,omitempty"`
+}
+
+func (m *MsgRegisterAccountWithVersion) Reset()         { *m = MsgRegisterAccountWithVersion{} }
+func (m *MsgRegisterAccountWithVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccountWithVersion) ProtoMessage()    {}
+func (*MsgRegisterAccountWithVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{20}
+}
+func (m *MsgRegisterAccountWithVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccountWithVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccountWithVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAccountWithVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccountWithVersion.Merge(m, src)
+}
+func (m *MsgRegisterAccountWithVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccountWithVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccountWithVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAccountWithVersion proto.InternalMessageInfo
+
+func (m *MsgRegisterAccountWithVersion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterAccountWithVersion) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgRegisterAccountWithVersion) GetOrdering() string {
+	if m != nil {
+		return m.Ordering
+	}
+	return ""
+}
+
+func (m *MsgRegisterAccountWithVersion) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *MsgRegisterAccountWithVersion) GetFeeVersion() string {
+	if m != nil {
+		return m.FeeVersion
+	}
+	return ""
+}
+
+// MsgRegisterAccountWithVersionResponse is the response type for the
+// Msg/RegisterAccountWithVersion RPC method.
+type MsgRegisterAccountWithVersionResponse struct {
+}
+
+func (m *MsgRegisterAccountWithVersionResponse) Reset()         { *m = MsgRegisterAccountWithVersionResponse{} }
+func (m *MsgRegisterAccountWithVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccountWithVersionResponse) ProtoMessage()    {}
+func (*MsgRegisterAccountWithVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{21}
+}
+func (m *MsgRegisterAccountWithVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccountWithVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccountWithVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAccountWithVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccountWithVersionResponse.Merge(m, src)
+}
+func (m *MsgRegisterAccountWithVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccountWithVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccountWithVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAccountWithVersionResponse proto.InternalMessageInfo
+
+// MsgCloseAccountChannel is the request type for the Msg/CloseAccountChannel
+// RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgCloseAccountChannel struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ConnectionId identifies the connection of the interchain account whose
+	// channel is to be closed.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *MsgCloseAccountChannel) Reset()         { *m = MsgCloseAccountChannel{} }
+func (m *MsgCloseAccountChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseAccountChannel) ProtoMessage()    {}
+func (*MsgCloseAccountChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{22}
+}
+func (m *MsgCloseAccountChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseAccountChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseAccountChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseAccountChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseAccountChannel.Merge(m, src)
+}
+func (m *MsgCloseAccountChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseAccountChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseAccountChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseAccountChannel proto.InternalMessageInfo
+
+func (m *MsgCloseAccountChannel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCloseAccountChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// MsgCloseAccountChannelResponse is the response type for the
+// Msg/CloseAccountChannel RPC method.
+type MsgCloseAccountChannelResponse struct {
+	// ChannelId is the channel that was closed.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgCloseAccountChannelResponse) Reset()         { *m = MsgCloseAccountChannelResponse{} }
+func (m *MsgCloseAccountChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseAccountChannelResponse) ProtoMessage()    {}
+func (*MsgCloseAccountChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{23}
+}
+func (m *MsgCloseAccountChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseAccountChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseAccountChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseAccountChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseAccountChannelResponse.Merge(m, src)
+}
+func (m *MsgCloseAccountChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseAccountChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseAccountChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseAccountChannelResponse proto.InternalMessageInfo
+
+func (m *MsgCloseAccountChannelResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgScheduleOperation is the request type for the Msg/ScheduleOperation RPC
+// method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgScheduleOperation struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// SendFunds is the payload of the operation, if it is an ICS-20 transfer.
+	// Its authority must be the same as this message's.
+	SendFunds *MsgSendFunds `protobuf:"bytes,2,opt,name=send_funds,json=sendFunds,proto3" json:"send_funds,omitempty" yaml:"send_funds"`
+	// SendMessages is the payload of the operation, if it is an ICS-27 tx
+	// execution. Its authority must be the same as this message's.
+	//
+	// Exactly one of SendFunds and SendMessages must be provided.
+	SendMessages *MsgSendMessages `protobuf:"bytes,3,opt,name=send_messages,json=sendMessages,proto3" json:"send_messages,omitempty" yaml:"send_messages"`
+	// StartTime is the block time from which the operation is first executed.
+	// If it is in the past, the operation is first executed in the same block.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Interval is the duration between two executions of the operation. It must
+	// be positive if the operation is to be repeated.
+	Interval time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval"`
+	// Repetitions is the total number of times the operation is to be executed.
+	// It must be positive.
+	Repetitions uint32 `protobuf:"varint,6,opt,name=repetitions,proto3" json:"repetitions,omitempty"`
+}
+
+func (m *MsgScheduleOperation) Reset()         { *m = MsgScheduleOperation{} }
+func (m *MsgScheduleOperation) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleOperation) ProtoMessage()    {}
+func (*MsgScheduleOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{24}
+}
+func (m *MsgScheduleOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleOperation.Merge(m, src)
+}
+func (m *MsgScheduleOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleOperation proto.InternalMessageInfo
+
+func (m *MsgScheduleOperation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleOperation) GetSendFunds() *MsgSendFunds {
+	if m != nil {
+		return m.SendFunds
+	}
+	return nil
+}
+
+func (m *MsgScheduleOperation) GetSendMessages() *MsgSendMessages {
+	if m != nil {
+		return m.SendMessages
+	}
+	return nil
+}
+
+func (m *MsgScheduleOperation) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgScheduleOperation) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgScheduleOperation) GetRepetitions() uint32 {
+	if m != nil {
+		return m.Repetitions
+	}
+	return 0
+}
+
+// MsgScheduleOperationResponse is the response type for the
+// Msg/ScheduleOperation RPC method.
+type MsgScheduleOperationResponse struct {
+	// Id is the id assigned to the scheduled operation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleOperationResponse) Reset()         { *m = MsgScheduleOperationResponse{} }
+func (m *MsgScheduleOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleOperationResponse) ProtoMessage()    {}
+func (*MsgScheduleOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{25}
+}
+func (m *MsgScheduleOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleOperationResponse.Merge(m, src)
+}
+func (m *MsgScheduleOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleOperationResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleOperationResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledOperation is the request type for the
+// Msg/CancelScheduledOperation RPC method.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgCancelScheduledOperation struct {
+	// Authority is the account executing this message.
+	// It is typically the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id identifies the scheduled operation to be cancelled.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledOperation) Reset()         { *m = MsgCancelScheduledOperation{} }
+func (m *MsgCancelScheduledOperation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledOperation) ProtoMessage()    {}
+func (*MsgCancelScheduledOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{26}
+}
+func (m *MsgCancelScheduledOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledOperation.Merge(m, src)
+}
+func (m *MsgCancelScheduledOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledOperation proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledOperation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledOperationResponse is the response type for the
+// Msg/CancelScheduledOperation RPC method.
+type MsgCancelScheduledOperationResponse struct {
+}
+
+func (m *MsgCancelScheduledOperationResponse) Reset()         { *m = MsgCancelScheduledOperationResponse{} }
+func (m *MsgCancelScheduledOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledOperationResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eee636e4d7b527ef, []int{27}
+}
+func (m *MsgCancelScheduledOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledOperationResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledOperationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAccount)(nil), "mars.envoy.v1beta1.MsgRegisterAccount")
+	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "mars.envoy.v1beta1.MsgRegisterAccountResponse")
+	proto.RegisterType((*MsgSendFunds)(nil), "mars.envoy.v1beta1.MsgSendFunds")
+	proto.RegisterType((*RelayerFee)(nil), "mars.envoy.v1beta1.RelayerFee")
+	proto.RegisterType((*ForwardHop)(nil), "mars.envoy.v1beta1.ForwardHop")
+	proto.RegisterType((*MsgSendFundsResponse)(nil), "mars.envoy.v1beta1.MsgSendFundsResponse")
+	proto.RegisterType((*MsgSendMessages)(nil), "mars.envoy.v1beta1.MsgSendMessages")
+	proto.RegisterType((*MsgSendMessagesResponse)(nil), "mars.envoy.v1beta1.MsgSendMessagesResponse")
+	proto.RegisterType((*ConnectionMessages)(nil), "mars.envoy.v1beta1.ConnectionMessages")
+	proto.RegisterType((*MsgBroadcastMessages)(nil), "mars.envoy.v1beta1.MsgBroadcastMessages")
+	proto.RegisterType((*BroadcastResult)(nil), "mars.envoy.v1beta1.BroadcastResult")
+	proto.RegisterType((*MsgBroadcastMessagesResponse)(nil), "mars.envoy.v1beta1.MsgBroadcastMessagesResponse")
+	proto.RegisterType((*MsgRetryPackets)(nil), "mars.envoy.v1beta1.MsgRetryPackets")
+	proto.RegisterType((*MsgRetryPacketsResponse)(nil), "mars.envoy.v1beta1.MsgRetryPacketsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "mars.envoy.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mars.envoy.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAuthorityPolicy)(nil), "mars.envoy.v1beta1.MsgSetAuthorityPolicy")
+	proto.RegisterType((*MsgSetAuthorityPolicyResponse)(nil), "mars.envoy.v1beta1.MsgSetAuthorityPolicyResponse")
+	proto.RegisterType((*MsgRemoveAuthorityPolicy)(nil), "mars.envoy.v1beta1.MsgRemoveAuthorityPolicy")
+	proto.RegisterType((*MsgRemoveAuthorityPolicyResponse)(nil), "mars.envoy.v1beta1.MsgRemoveAuthorityPolicyResponse")
+	proto.RegisterType((*MsgRegisterAccountWithVersion)(nil), "mars.envoy.v1beta1.MsgRegisterAccountWithVersion")
+	proto.RegisterType((*MsgRegisterAccountWithVersionResponse)(nil), "mars.envoy.v1beta1.MsgRegisterAccountWithVersionResponse")
+	proto.RegisterType((*MsgCloseAccountChannel)(nil), "mars.envoy.v1beta1.MsgCloseAccountChannel")
+	proto.RegisterType((*MsgCloseAccountChannelResponse)(nil), "mars.envoy.v1beta1.MsgCloseAccountChannelResponse")
+	proto.RegisterType((*MsgScheduleOperation)(nil), "mars.envoy.v1beta1.MsgScheduleOperation")
+	proto.RegisterType((*MsgScheduleOperationResponse)(nil), "mars.envoy.v1beta1.MsgScheduleOperationResponse")
+	proto.RegisterType((*MsgCancelScheduledOperation)(nil), "mars.envoy.v1beta1.MsgCancelScheduledOperation")
+	proto.RegisterType((*MsgCancelScheduledOperationResponse)(nil), "mars.envoy.v1beta1.MsgCancelScheduledOperationResponse")
+}
+
+func init() { proto.RegisterFile("mars/envoy/v1beta1/tx.proto", fileDescriptor_eee636e4d7b527ef) }
+
+var fileDescriptor_eee636e4d7b527ef = []byte{
+	// 1540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x77, 0x37, 0x3f, 0xf6, 0x25, 0x6d, 0xbe, 0xf5, 0x37, 0x25, 0x5b, 0x37, 0xd9, 0x8d,
+	0x5c, 0xb5, 0x84, 0x94, 0xee, 0x26, 0xa1, 0x50, 0x1a, 0x09, 0x55, 0xd9, 0x54, 0x11, 0x3d, 0x04,
+	0x2a, 0x17, 0x5a, 0xc4, 0x81, 0xe0, 0xd8, 0x93, 0x8d, 0x95, 0x5d, 0xcf, 0xe2, 0x19, 0x2f, 0x5d,
+	0xc1, 0x01, 0x55, 0xe5, 0xc2, 0xa9, 0x82, 0x0b, 0x12, 0x07, 0xa4, 0x1e, 0x39, 0xf5, 0x80, 0xc4,
+	0xbf, 0xd0, 0x63, 0x85, 0x90, 0x80, 0x4b, 0x8a, 0x5a, 0xa1, 0xde, 0xfb, 0x0f, 0x80, 0x66, 0x3c,
+	0x9e, 0x75, 0xd6, 0x76, 0xf7, 0x47, 0x90, 0x7a, 0x4a, 0xc6, 0xef, 0xf3, 0xe6, 0x7d, 0xde, 0x4f,
+	0xbf, 0x35, 0x9c, 0x6e, 0x98, 0x1e, 0xa9, 0x20, 0xb7, 0x85, 0xdb, 0x95, 0xd6, 0xca, 0x0e, 0xa2,
+	0xe6, 0x4a, 0x85, 0xde, 0x2e, 0x37, 0x3d, 0x4c, 0xb1, 0xaa, 0x32, 0x61, 0x99, 0x0b, 0xcb, 0x42,
+	0xa8, 0x15, 0x2d, 0x4c, 0x1a, 0x98, 0x54, 0x76, 0x4c, 0x82, 0xa4, 0x86, 0x85, 0x1d, 0x37, 0xd0,
+	0xd1, 0x66, 0x85, 0xbc, 0x41, 0x6a, 0x95, 0xd6, 0x0a, 0xfb, 0x23, 0x04, 0xa7, 0x02, 0xc1, 0x36,
+	0x3f, 0x55, 0x82, 0x83, 0x10, 0xcd, 0xd4, 0x70, 0x0d, 0x07, 0xcf, 0xd9, 0x7f, 0xa1, 0x42, 0x0d,
+	0xe3, 0x5a, 0x1d, 0x55, 0xf8, 0x69, 0xc7, 0xdf, 0xad, 0x98, 0x6e, 0x5b, 0x88, 0x8a, 0xdd, 0x22,
+	0xdb, 0xf7, 0x4c, 0xea, 0xe0, 0x90, 0x44, 0xa9, 0x5b, 0x4e, 0x9d, 0x06, 0x22, 0xd4, 0x6c, 0x34,
+	0x43, 0x40, 0x82, 0xdb, 0x4d, 0xd3, 0x33, 0x1b, 0x21, 0xa5, 0x62, 0x02, 0x80, 0x50, 0xec, 0xa1,
+	0x40, 0xae, 0x7f, 0xab, 0x80, 0xba, 0x45, 0x6a, 0x06, 0xaa, 0x39, 0x84, 0x22, 0x6f, 0xdd, 0xb2,
+	0xb0, 0xef, 0x52, 0x75, 0x19, 0xc6, 0x08, 0x72, 0x6d, 0xe4, 0x15, 0x94, 0x05, 0x65, 0x31, 0x5f,
+	0x2d, 0xfc, 0xfa, 0xf3, 0x85, 0x19, 0xe1, 0xeb, 0xba, 0x6d, 0x7b, 0x88, 0x90, 0x1b, 0xd4, 0x73,
+	0xdc, 0x9a, 0x21, 0x70, 0xea, 0x3b, 0x70, 0xcc, 0xc2, 0xae, 0x8b, 0x2c, 0x46, 0x7f, 0xdb, 0xb1,
+	0x0b, 0x99, 0x40, 0xf1, 0xf9, 0x41, 0x69, 0xa6, 0x6d, 0x36, 0xea, 0x6b, 0xfa, 0x21, 0xb1, 0x6e,
+	0x4c, 0x75, 0xce, 0xd7, 0xec, 0xb5, 0xc9, 0x3b, 0xcf, 0x1e, 0x2c, 0x89, 0xbb, 0xf4, 0x39, 0xd0,
+	0xe2, 0x9c, 0x0c, 0x44, 0x9a, 0xd8, 0x25, 0x48, 0xff, 0x33, 0x0b, 0x53, 0x5b, 0xa4, 0x76, 0x03,
+	0xb9, 0xf6, 0xa6, 0xef, 0xda, 0x44, 0x7d, 0x0b, 0xf2, 0xa6, 0x4f, 0xf7, 0xb0, 0xe7, 0xd0, 0x76,
+	0x4f, 0xbe, 0x1d, 0xa8, 0x7a, 0x11, 0xc0, 0xda, 0x33, 0x5d, 0x17, 0xd5, 0x3b, 0x7c, 0x4f, 0x3e,
+	0x3f, 0x28, 0x9d, 0x10, 0x7c, 0xa5, 0x4c, 0x37, 0xf2, 0xe2, 0x70, 0xcd, 0x56, 0x2d, 0x18, 0x33,
+	0x1b, 0x8c, 0x50, 0x21, 0xbb, 0x90, 0x5d, 0x9c, 0x5c, 0x3d, 0x55, 0x16, 0x76, 0x58, 0x25, 0x85,
+	0xe5, 0x55, 0xde, 0xc0, 0x8e, 0x5b, 0x5d, 0x7e, 0x78, 0x50, 0x1a, 0xf9, 0xe9, 0x71, 0x69, 0xb1,
+	0xe6, 0xd0, 0x3d, 0x7f, 0xa7, 0x6c, 0xe1, 0x86, 0x28, 0x18, 0xf1, 0xe7, 0x02, 0xb1, 0xf7, 0x2b,
+	0xb4, 0xdd, 0x44, 0x84, 0x2b, 0x10, 0x43, 0x5c, 0xad, 0x5e, 0x86, 0x71, 0x96, 0x6a, 0xec, 0xd3,
+	0x42, 0x6e, 0x41, 0xe1, 0x56, 0x82, 0x52, 0x28, 0x87, 0xa5, 0x50, 0xbe, 0x2a, 0x4a, 0xa5, 0x9a,
+	0xfb, 0xfe, 0x71, 0x49, 0x31, 0x42, 0xbc, 0xba, 0x06, 0xa3, 0x1e, 0xf6, 0x29, 0x2a, 0x8c, 0x72,
+	0x7a, 0xc5, 0x72, 0xbc, 0xf8, 0xcb, 0x9b, 0xd8, 0xfb, 0xdc, 0xf4, 0xec, 0x77, 0x71, 0xb3, 0x9a,
+	0x63, 0x1c, 0x8d, 0x40, 0x25, 0x9e, 0xc4, 0xb1, 0x41, 0x92, 0xa8, 0x2e, 0x43, 0x76, 0x17, 0xa1,
+	0xc2, 0xf8, 0x82, 0x92, 0x66, 0xd8, 0x40, 0x75, 0xb3, 0x8d, 0xbc, 0x4d, 0x84, 0x0c, 0x06, 0x5d,
+	0x3b, 0xce, 0xd2, 0xde, 0x49, 0x89, 0x7e, 0x37, 0x0b, 0xd0, 0xc1, 0xa8, 0x6d, 0x98, 0xf0, 0x90,
+	0xd5, 0xda, 0x66, 0xb7, 0x2a, 0xbd, 0xa2, 0xbd, 0xc1, 0x3c, 0x79, 0x7e, 0x50, 0x9a, 0x0e, 0x98,
+	0x86, 0x8a, 0xfa, 0x40, 0x09, 0x18, 0x67, 0x6a, 0xcc, 0x74, 0x0b, 0xc6, 0x4d, 0x6b, 0x9f, 0x5b,
+	0xce, 0xf4, 0xb2, 0x5c, 0x15, 0x96, 0x8f, 0x07, 0x96, 0x85, 0x9e, 0x3e, 0x60, 0xe6, 0xad, 0x7d,
+	0x66, 0xf7, 0x8e, 0x02, 0x93, 0x22, 0x95, 0xdc, 0x78, 0xcf, 0x22, 0xdb, 0x14, 0xc6, 0xd5, 0xc0,
+	0x78, 0x44, 0x77, 0x30, 0x02, 0x20, 0x34, 0x37, 0x11, 0xd2, 0x3f, 0x01, 0xe8, 0x94, 0x88, 0xaa,
+	0xf1, 0x2c, 0x20, 0xa7, 0x15, 0x8e, 0x03, 0x43, 0x9e, 0x87, 0xeb, 0x21, 0xfd, 0x22, 0xcc, 0x44,
+	0x3b, 0x38, 0x6c, 0x6d, 0x75, 0x0e, 0xf2, 0x04, 0x7d, 0xe6, 0x23, 0xd7, 0x42, 0x84, 0x27, 0x3c,
+	0x67, 0x74, 0x1e, 0xe8, 0xbf, 0x64, 0x60, 0x5a, 0xa8, 0x6d, 0x21, 0x42, 0xcc, 0x1a, 0x1a, 0xbe,
+	0xf7, 0x8f, 0x36, 0xae, 0xd4, 0x65, 0x98, 0x68, 0x08, 0x0a, 0x22, 0x43, 0x33, 0xb1, 0x06, 0x5d,
+	0x77, 0xdb, 0x86, 0x44, 0x1d, 0xa5, 0xa3, 0x45, 0x5b, 0x8d, 0x0e, 0xdf, 0x56, 0x6f, 0xc2, 0x6c,
+	0x57, 0xe0, 0x64, 0xc8, 0x35, 0x98, 0x08, 0x23, 0xcc, 0xe3, 0x97, 0x33, 0xe4, 0x59, 0xff, 0x5a,
+	0x01, 0x75, 0x43, 0xba, 0x2d, 0x63, 0x1e, 0x8b, 0x9d, 0x32, 0x74, 0xec, 0x32, 0xfd, 0xc4, 0x4e,
+	0xff, 0x21, 0xc3, 0xeb, 0xa5, 0xea, 0x61, 0xd3, 0xb6, 0x4c, 0x42, 0x8f, 0x9c, 0xfd, 0xf7, 0x60,
+	0xb2, 0x43, 0x29, 0x64, 0x71, 0x2e, 0x29, 0xb2, 0x71, 0xf7, 0xc5, 0xc4, 0x8c, 0x5e, 0x10, 0x4d,
+	0x6e, 0x76, 0xb8, 0xe4, 0xe6, 0x86, 0x4f, 0xee, 0x7d, 0x05, 0xa6, 0x65, 0x68, 0x0c, 0x44, 0xfc,
+	0x3a, 0x3d, 0x6a, 0x8a, 0x86, 0x7b, 0x33, 0x46, 0x4b, 0x29, 0xdb, 0x55, 0x4a, 0x16, 0xcc, 0x25,
+	0x65, 0x50, 0x96, 0xe1, 0x06, 0x8c, 0x7b, 0x9c, 0x3a, 0x11, 0x83, 0xfe, 0x4c, 0x52, 0x28, 0xba,
+	0xdc, 0x14, 0xa9, 0x08, 0x35, 0xf5, 0xbf, 0x15, 0x3e, 0x20, 0x0c, 0x44, 0xbd, 0xf6, 0x75, 0xd3,
+	0xda, 0x47, 0xf4, 0xa5, 0x0d, 0x88, 0xff, 0x41, 0xd6, 0xb1, 0x83, 0xd9, 0x90, 0x33, 0xd8, 0xbf,
+	0x47, 0x18, 0x00, 0xb1, 0x8c, 0x5f, 0x82, 0xd9, 0x2e, 0x37, 0xfb, 0x9c, 0xa0, 0xdf, 0x05, 0x01,
+	0xfa, 0xb0, 0x69, 0x9b, 0x14, 0x5d, 0xe7, 0x7b, 0xe2, 0xd0, 0x01, 0x7a, 0x1b, 0xc6, 0x82, 0x4d,
+	0x93, 0x47, 0x66, 0x72, 0x55, 0x4b, 0x4a, 0x58, 0x60, 0x43, 0xe4, 0x49, 0xe0, 0x63, 0xee, 0x9c,
+	0x82, 0xd9, 0x2e, 0x52, 0x72, 0xd7, 0xbb, 0xaf, 0xc0, 0x49, 0x3e, 0xb9, 0xe8, 0x7a, 0x08, 0xbf,
+	0x8e, 0xeb, 0x8e, 0xd5, 0x1e, 0x9a, 0xf6, 0x3a, 0x8c, 0x35, 0xf9, 0x0d, 0x82, 0x76, 0x62, 0x9d,
+	0x75, 0x19, 0x93, 0xfc, 0xf9, 0x29, 0xc6, 0xbf, 0x04, 0xf3, 0x89, 0x1c, 0xa5, 0x17, 0x5f, 0x42,
+	0x81, 0xe7, 0xab, 0x81, 0x5b, 0xe8, 0xbf, 0xf2, 0xa3, 0x00, 0xe3, 0x66, 0x20, 0x0b, 0x2a, 0xd3,
+	0x08, 0x8f, 0x31, 0x7a, 0x3a, 0x2c, 0xa4, 0x59, 0x97, 0x0c, 0xff, 0x51, 0x60, 0x3e, 0xbe, 0x72,
+	0xdf, 0x72, 0xe8, 0xde, 0x4d, 0xe4, 0x11, 0x07, 0xbb, 0x2f, 0xab, 0x8f, 0x34, 0x98, 0xc0, 0x9e,
+	0x8d, 0xd8, 0xad, 0x7c, 0xa6, 0xe4, 0x0d, 0x79, 0x66, 0x32, 0xe4, 0x5a, 0xd8, 0x66, 0xb2, 0x5c,
+	0x20, 0x0b, 0xcf, 0x6a, 0x09, 0x26, 0x77, 0x11, 0xda, 0x6e, 0x05, 0xec, 0xf9, 0xbb, 0x33, 0x6f,
+	0xc0, 0x2e, 0x42, 0xc2, 0x9f, 0x58, 0x94, 0x5e, 0x85, 0xb3, 0x2f, 0x0c, 0x80, 0x0c, 0xd5, 0x8f,
+	0x0a, 0xbc, 0xb2, 0x45, 0x6a, 0x1b, 0x75, 0x4c, 0x90, 0x80, 0x6d, 0x04, 0x23, 0xf0, 0x25, 0xc5,
+	0x28, 0xe6, 0xca, 0x15, 0x28, 0x26, 0x13, 0x94, 0x53, 0x62, 0xfe, 0xd0, 0x7c, 0x0f, 0x76, 0xba,
+	0xc8, 0x7a, 0xf6, 0x7b, 0x36, 0xd8, 0xcf, 0xac, 0x3d, 0x64, 0xfb, 0x75, 0xf4, 0x7e, 0x13, 0x05,
+	0x73, 0x69, 0x68, 0x07, 0x6f, 0x02, 0x10, 0xe4, 0xda, 0xdb, 0xbb, 0x6c, 0xdb, 0x13, 0x8d, 0xb7,
+	0x90, 0xd4, 0x78, 0xd1, 0xad, 0x30, 0xfa, 0xc6, 0xe9, 0x68, 0xeb, 0x6c, 0x9e, 0x09, 0x84, 0xba,
+	0x03, 0xc7, 0xb8, 0x24, 0xb2, 0x8b, 0xa5, 0xf6, 0x74, 0xd7, 0x02, 0x14, 0x8d, 0xee, 0xa1, 0x3b,
+	0x74, 0x63, 0x8a, 0x44, 0x70, 0xea, 0x47, 0x00, 0x84, 0x9a, 0x1e, 0xdd, 0x66, 0xd3, 0x58, 0x8c,
+	0x6e, 0x2d, 0x36, 0xba, 0x3f, 0x08, 0x7f, 0x98, 0x57, 0xe7, 0xc5, 0x3e, 0x1e, 0x32, 0x97, 0xba,
+	0xfa, 0x3d, 0x36, 0xd4, 0xf3, 0xfc, 0x01, 0x83, 0xab, 0x57, 0x60, 0xc2, 0x71, 0x29, 0xf2, 0x5a,
+	0x66, 0xbd, 0x30, 0xda, 0xeb, 0x95, 0x30, 0xc1, 0xae, 0xe5, 0xaf, 0x05, 0xa9, 0xa4, 0x2e, 0xc0,
+	0xa4, 0x87, 0x9a, 0x88, 0x3a, 0xc1, 0x1a, 0xc3, 0x7e, 0xac, 0x1d, 0x33, 0xa2, 0x8f, 0x62, 0xa5,
+	0x51, 0x86, 0xb9, 0xa4, 0xc4, 0xca, 0xc2, 0x38, 0x0e, 0x19, 0x51, 0x10, 0x39, 0x23, 0xe3, 0xd8,
+	0xba, 0x0f, 0xa7, 0x59, 0x29, 0x99, 0xae, 0x85, 0xea, 0xa1, 0x96, 0x7d, 0xf4, 0x7a, 0x08, 0xcc,
+	0x64, 0x42, 0x33, 0x31, 0x9a, 0x67, 0xe1, 0xcc, 0x0b, 0xcc, 0x86, 0x6c, 0x57, 0x7f, 0x03, 0xc8,
+	0x6e, 0x91, 0x9a, 0xea, 0xc0, 0x74, 0xf7, 0x07, 0x8c, 0x73, 0x29, 0x25, 0xd0, 0x85, 0xd3, 0xca,
+	0xfd, 0xe1, 0x64, 0x80, 0x6e, 0x41, 0xbe, 0xf3, 0xe1, 0xa1, 0x67, 0x09, 0x6b, 0x8b, 0xbd, 0x10,
+	0xf2, 0xe2, 0x4f, 0x61, 0xea, 0xd0, 0x0f, 0x9b, 0x7e, 0x6a, 0x58, 0x3b, 0xdf, 0x07, 0x48, 0x5a,
+	0xc0, 0x70, 0x22, 0xbe, 0x41, 0xa7, 0x11, 0x8c, 0x21, 0xb5, 0xe5, 0x7e, 0x91, 0x51, 0x97, 0x0e,
+	0xad, 0x62, 0x67, 0x52, 0x63, 0xdd, 0x01, 0x69, 0xe7, 0xfb, 0x00, 0x45, 0x2d, 0x1c, 0xda, 0x65,
+	0xd2, 0x2c, 0x44, 0x41, 0xda, 0xf9, 0x3e, 0x40, 0xd2, 0x82, 0x07, 0x6a, 0xc2, 0xf2, 0xf1, 0x5a,
+	0x6a, 0xdc, 0xbb, 0xa1, 0xda, 0x4a, 0xdf, 0x50, 0x69, 0xf3, 0x0b, 0x38, 0x99, 0xbc, 0x2b, 0xbc,
+	0x9e, 0x1a, 0x9b, 0x04, 0xb4, 0x76, 0x71, 0x10, 0xb4, 0x34, 0xfe, 0x8d, 0x02, 0xda, 0x0b, 0xd6,
+	0x80, 0x95, 0xfe, 0xfa, 0x25, 0xa2, 0xa2, 0x5d, 0x1e, 0x58, 0x45, 0x92, 0xf1, 0xe1, 0xff, 0x49,
+	0xef, 0xd9, 0xa5, 0x94, 0x1b, 0x13, 0xb0, 0xda, 0x6a, 0xff, 0xd8, 0x68, 0xa7, 0xc4, 0xdf, 0x7d,
+	0xa9, 0xad, 0xdc, 0x8d, 0xd4, 0x96, 0xfb, 0x45, 0x4a, 0x83, 0x77, 0x15, 0x28, 0xa4, 0x0e, 0xd9,
+	0x4a, 0x9a, 0x07, 0x29, 0x0a, 0xda, 0xa5, 0x01, 0x15, 0x42, 0x1a, 0xda, 0xe8, 0x57, 0xcf, 0x1e,
+	0x2c, 0x29, 0xd5, 0xab, 0x0f, 0x9f, 0x14, 0x95, 0x47, 0x4f, 0x8a, 0xca, 0x5f, 0x4f, 0x8a, 0xca,
+	0xbd, 0xa7, 0xc5, 0x91, 0x47, 0x4f, 0x8b, 0x23, 0x7f, 0x3c, 0x2d, 0x8e, 0x7c, 0xbc, 0x14, 0xf9,
+	0x9a, 0xc4, 0x6c, 0x5c, 0xe0, 0xef, 0x29, 0x0b, 0xd7, 0x2b, 0x7b, 0xfe, 0x4e, 0xe5, 0xb6, 0xf8,
+	0xce, 0xcc, 0xbf, 0x2a, 0xed, 0x8c, 0x71, 0xd9, 0x1b, 0xff, 0x0e, 0x00, 0x4e, 0x6a, 0xb5, 0xe1,
+	0x9a, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAccount creates a new interchain account on the given connection,
+	// or if an interchain account already exists but its channel is closed (due
+	// to a packet having timed out), open a new channel for this account.
+	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
+	// SendFunds is a governance operation for sending funds to an interchain
+	// account via ICS-20. Accounts with an authority policy may also send funds
+	// within the policy's limits.
+	//
+	// The envoy module will first attempt to use the balance held in its own
+	// module account. If the balance is not sufficient, it will attempt to draw
+	// the difference from the community pool. The same goes for the optional
+	// ICS-29 relayer fees. Only governance may draw from the community pool;
+	// other authorities are limited to the envoy module account's balance.
+	SendFunds(ctx context.Context, in *MsgSendFunds, opts ...grpc.CallOption) (*MsgSendFundsResponse, error)
+	// SendMessages is a governance operation for sending one or more messages to
+	// the host chain to be executed by the interchain account. Accounts with an
+	// authority policy may also send the messages allowed by the policy.
+	//
+	// Optional ICS-29 relayer fees are paid the same way as in SendFunds.
+	SendMessages(ctx context.Context, in *MsgSendMessages, opts ...grpc.CallOption) (*MsgSendMessagesResponse, error)
+	// BroadcastMessages is a governance operation for sending messages to the
+	// interchain accounts on several connections at once, one ICS-27 packet per
+	// connection. If any of the packets can't be sent, none of them is.
+	BroadcastMessages(ctx context.Context, in *MsgBroadcastMessages, opts ...grpc.CallOption) (*MsgBroadcastMessagesResponse, error)
+	// RetryPackets is a governance operation for resending the payloads of
+	// ICS-27 packets that have timed out, once the interchain account's channel
+	// has been reopened.
+	RetryPackets(ctx context.Context, in *MsgRetryPackets, opts ...grpc.CallOption) (*MsgRetryPacketsResponse, error)
+	// UpdateParams is a governance operation for updating the module's
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAuthorityPolicy is a governance operation for creating or replacing the
+	// policy of an account that may send funds and messages within limits.
+	SetAuthorityPolicy(ctx context.Context, in *MsgSetAuthorityPolicy, opts ...grpc.CallOption) (*MsgSetAuthorityPolicyResponse, error)
+	// RemoveAuthorityPolicy is a governance operation for removing the policy of
+	// an account, revoking its power to send funds and messages.
+	RemoveAuthorityPolicy(ctx context.Context, in *MsgRemoveAuthorityPolicy, opts ...grpc.CallOption) (*MsgRemoveAuthorityPolicyResponse, error)
+	// RegisterAccountWithVersion is a governance operation for creating an
+	// interchain account, or reopening its channel, with a channel version of
+	// governance's choosing. This can be used to add or remove the ICS-29 fee
+	// middleware wrapper of an account's channel.
+	RegisterAccountWithVersion(ctx context.Context, in *MsgRegisterAccountWithVersion, opts ...grpc.CallOption) (*MsgRegisterAccountWithVersionResponse, error)
+	// CloseAccountChannel is a governance operation for closing the channel of
+	// an interchain account, e.g. before reopening it with a different version.
+	CloseAccountChannel(ctx context.Context, in *MsgCloseAccountChannel, opts ...grpc.CallOption) (*MsgCloseAccountChannelResponse, error)
+	// ScheduleOperation is a governance operation for registering a SendFunds or
+	// SendMessages payload to be executed by the module at regular intervals.
+	ScheduleOperation(ctx context.Context, in *MsgScheduleOperation, opts ...grpc.CallOption) (*MsgScheduleOperationResponse, error)
+	// CancelScheduledOperation is a governance operation for cancelling the
+	// remaining executions of a scheduled operation.
+	CancelScheduledOperation(ctx context.Context, in *MsgCancelScheduledOperation, opts ...grpc.CallOption) (*MsgCancelScheduledOperationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
+	out := new(MsgRegisterAccountResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/RegisterAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendFunds(ctx context.Context, in *MsgSendFunds, opts ...grpc.CallOption) (*MsgSendFundsResponse, error) {
+	out := new(MsgSendFundsResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/SendFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendMessages(ctx context.Context, in *MsgSendMessages, opts ...grpc.CallOption) (*MsgSendMessagesResponse, error) {
+	out := new(MsgSendMessagesResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/SendMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BroadcastMessages(ctx context.Context, in *MsgBroadcastMessages, opts ...grpc.CallOption) (*MsgBroadcastMessagesResponse, error) {
+	out := new(MsgBroadcastMessagesResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/BroadcastMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryPackets(ctx context.Context, in *MsgRetryPackets, opts ...grpc.CallOption) (*MsgRetryPacketsResponse, error) {
+	out := new(MsgRetryPacketsResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/RetryPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAuthorityPolicy(ctx context.Context, in *MsgSetAuthorityPolicy, opts ...grpc.CallOption) (*MsgSetAuthorityPolicyResponse, error) {
+	out := new(MsgSetAuthorityPolicyResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/SetAuthorityPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthorityPolicy(ctx context.Context, in *MsgRemoveAuthorityPolicy, opts ...grpc.CallOption) (*MsgRemoveAuthorityPolicyResponse, error) {
+	out := new(MsgRemoveAuthorityPolicyResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/RemoveAuthorityPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAccountWithVersion(ctx context.Context, in *MsgRegisterAccountWithVersion, opts ...grpc.CallOption) (*MsgRegisterAccountWithVersionResponse, error) {
+	out := new(MsgRegisterAccountWithVersionResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/RegisterAccountWithVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseAccountChannel(ctx context.Context, in *MsgCloseAccountChannel, opts ...grpc.CallOption) (*MsgCloseAccountChannelResponse, error) {
+	out := new(MsgCloseAccountChannelResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/CloseAccountChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleOperation(ctx context.Context, in *MsgScheduleOperation, opts ...grpc.CallOption) (*MsgScheduleOperationResponse, error) {
+	out := new(MsgScheduleOperationResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/ScheduleOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledOperation(ctx context.Context, in *MsgCancelScheduledOperation, opts ...grpc.CallOption) (*MsgCancelScheduledOperationResponse, error) {
+	out := new(MsgCancelScheduledOperationResponse)
+	err := c.cc.Invoke(ctx, "/mars.envoy.v1beta1.Msg/CancelScheduledOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAccount creates a new interchain account on the given connection,
+	// or if an interchain account already exists but its channel is closed (due
+	// to a packet having timed out), open a new channel for this account.
+	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
+	// SendFunds is a governance operation for sending funds to an interchain
+	// account via ICS-20. Accounts with an authority policy may also send funds
+	// within the policy's limits.
+	//
+	// The envoy module will first attempt to use the balance held in its own
+	// module account. If the balance is not sufficient, it will attempt to draw
+	// the difference from the community pool. The same goes for the optional
+	// ICS-29 relayer fees. Only governance may draw from the community pool;
+	// other authorities are limited to the envoy module account's balance.
+	SendFunds(context.Context, *MsgSendFunds) (*MsgSendFundsResponse, error)
+	// SendMessages is a governance operation for sending one or more messages to
+	// the host chain to be executed by the interchain account. Accounts with an
+	// authority policy may also send the messages allowed by the policy.
+	//
+	// Optional ICS-29 relayer fees are paid the same way as in SendFunds.
+	SendMessages(context.Context, *MsgSendMessages) (*MsgSendMessagesResponse, error)
+	// BroadcastMessages is a governance operation for sending messages to the
+	// interchain accounts on several connections at once, one ICS-27 packet per
+	// connection. If any of the packets can't be sent, none of them is.
+	BroadcastMessages(context.Context, *MsgBroadcastMessages) (*MsgBroadcastMessagesResponse, error)
+	// RetryPackets is a governance operation for resending the payloads of
+	// ICS-27 packets that have timed out, once the interchain account's channel
+	// has been reopened.
+	RetryPackets(context.Context, *MsgRetryPackets) (*MsgRetryPacketsResponse, error)
+	// UpdateParams is a governance operation for updating the module's
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAuthorityPolicy is a governance operation for creating or replacing the
+	// policy of an account that may send funds and messages within limits.
+	SetAuthorityPolicy(context.Context, *MsgSetAuthorityPolicy) (*MsgSetAuthorityPolicyResponse, error)
+	// RemoveAuthorityPolicy is a governance operation for removing the policy of
+	// an account, revoking its power to send funds and messages.
+	RemoveAuthorityPolicy(context.Context, *MsgRemoveAuthorityPolicy) (*MsgRemoveAuthorityPolicyResponse, error)
+	// RegisterAccountWithVersion is a governance operation for creating an
+	// interchain account, or reopening its channel, with a channel version of
+	// governance's choosing. This can be used to add or remove the ICS-29 fee
+	// middleware wrapper of an account's channel.
+	RegisterAccountWithVersion(context.Context, *MsgRegisterAccountWithVersion) (*MsgRegisterAccountWithVersionResponse, error)
+	// CloseAccountChannel is a governance operation for closing the channel of
+	// an interchain account, e.g. before reopening it with a different version.
+	CloseAccountChannel(context.Context, *MsgCloseAccountChannel) (*MsgCloseAccountChannelResponse, error)
+	// ScheduleOperation is a governance operation for registering a SendFunds or
+	// SendMessages payload to be executed by the module at regular intervals.
+	ScheduleOperation(context.Context, *MsgScheduleOperation) (*MsgScheduleOperationResponse, error)
+	// CancelScheduledOperation is a governance operation for cancelling the
+	// remaining executions of a scheduled operation.
+	CancelScheduledOperation(context.Context, *MsgCancelScheduledOperation) (*MsgCancelScheduledOperationResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+}
+func (*UnimplementedMsgServer) SendFunds(ctx context.Context, req *MsgSendFunds) (*MsgSendFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFunds not implemented")
+}
+func (*UnimplementedMsgServer) SendMessages(ctx context.Context, req *MsgSendMessages) (*MsgSendMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessages not implemented")
+}
+func (*UnimplementedMsgServer) BroadcastMessages(ctx context.Context, req *MsgBroadcastMessages) (*MsgBroadcastMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastMessages not implemented")
+}
+func (*UnimplementedMsgServer) RetryPackets(ctx context.Context, req *MsgRetryPackets) (*MsgRetryPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPackets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetAuthorityPolicy(ctx context.Context, req *MsgSetAuthorityPolicy) (*MsgSetAuthorityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthorityPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAuthorityPolicy(ctx context.Context, req *MsgRemoveAuthorityPolicy) (*MsgRemoveAuthorityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthorityPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAccountWithVersion(ctx context.Context, req *MsgRegisterAccountWithVersion) (*MsgRegisterAccountWithVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccountWithVersion not implemented")
+}
+func (*UnimplementedMsgServer) CloseAccountChannel(ctx context.Context, req *MsgCloseAccountChannel) (*MsgCloseAccountChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccountChannel not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleOperation(ctx context.Context, req *MsgScheduleOperation) (*MsgScheduleOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleOperation not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledOperation(ctx context.Context, req *MsgCancelScheduledOperation) (*MsgCancelScheduledOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOperation not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/RegisterAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/SendFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFunds(ctx, req.(*MsgSendFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/SendMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendMessages(ctx, req.(*MsgSendMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BroadcastMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBroadcastMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BroadcastMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/BroadcastMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BroadcastMessages(ctx, req.(*MsgBroadcastMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/RetryPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPackets(ctx, req.(*MsgRetryPackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAuthorityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthorityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthorityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/SetAuthorityPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthorityPolicy(ctx, req.(*MsgSetAuthorityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthorityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthorityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthorityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/RemoveAuthorityPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthorityPolicy(ctx, req.(*MsgRemoveAuthorityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAccountWithVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccountWithVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccountWithVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/RegisterAccountWithVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccountWithVersion(ctx, req.(*MsgRegisterAccountWithVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseAccountChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseAccountChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseAccountChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/CloseAccountChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseAccountChannel(ctx, req.(*MsgCloseAccountChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleOperation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/ScheduleOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleOperation(ctx, req.(*MsgScheduleOperation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledOperation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.envoy.v1beta1.Msg/CancelScheduledOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledOperation(ctx, req.(*MsgCancelScheduledOperation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mars.envoy.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAccount",
+			Handler:    _Msg_RegisterAccount_Handler,
+		},
+		{
+			MethodName: "SendFunds",
+			Handler:    _Msg_SendFunds_Handler,
+		},
+		{
+			MethodName: "SendMessages",
+			Handler:    _Msg_SendMessages_Handler,
+		},
+		{
+			MethodName: "BroadcastMessages",
+			Handler:    _Msg_BroadcastMessages_Handler,
+		},
+		{
+			MethodName: "RetryPackets",
+			Handler:    _Msg_RetryPackets_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetAuthorityPolicy",
+			Handler:    _Msg_SetAuthorityPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveAuthorityPolicy",
+			Handler:    _Msg_RemoveAuthorityPolicy_Handler,
+		},
+		{
+			MethodName: "RegisterAccountWithVersion",
+			Handler:    _Msg_RegisterAccountWithVersion_Handler,
+		},
+		{
+			MethodName: "CloseAccountChannel",
+			Handler:    _Msg_CloseAccountChannel_Handler,
+		},
+		{
+			MethodName: "ScheduleOperation",
+			Handler:    _Msg_ScheduleOperation_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOperation",
+			Handler:    _Msg_CancelScheduledOperation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mars/envoy/v1beta1/tx.proto",
+}
+
+func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timeout != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		dAtA4 := make([]byte, len(m.Sequences)*10)
+		var j3 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timeout != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBroadcastMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBroadcastMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBroadcastMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BroadcastResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBroadcastMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBroadcastMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBroadcastMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		dAtA13 := make([]byte, len(m.Sequences)*10)
+		var j12 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthorityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthorityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthorityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthorityPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthorityPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthorityPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthorityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthorityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthorityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthorityPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthorityPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthorityPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountWithVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountWithVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountWithVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeVersion) > 0 {
+		i -= len(m.FeeVersion)
+		copy(dAtA[i:], m.FeeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ordering) > 0 {
+		i -= len(m.Ordering)
+		copy(dAtA[i:], m.Ordering)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ordering)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountWithVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountWithVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountWithVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseAccountChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseAccountChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseAccountChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseAccountChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseAccountChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseAccountChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Repetitions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Repetitions))
+		i--
+		dAtA[i] = 0x30
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTx(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTx(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if m.SendMessages != nil {
+		{
+			size, err := m.SendMessages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SendFunds != nil {
+		{
+			size, err := m.SendFunds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSendMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ConnectionMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBroadcastMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BroadcastResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgBroadcastMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRetryPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAuthorityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAuthorityPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAuthorityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAuthorityPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterAccountWithVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ordering)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountWithVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseAccountChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseAccountChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SendFunds != nil {
+		l = m.SendFunds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SendMessages != nil {
+		l = m.SendMessages.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Repetitions != 0 {
+		n += 1 + sovTx(uint64(m.Repetitions))
+	}
+	return n
+}
+
+func (m *MsgScheduleOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, ForwardHop{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &RelayerFee{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &RelayerFee{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBroadcastMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBroadcastMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBroadcastMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, ConnectionMessages{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &RelayerFee{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBroadcastMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBroadcastMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBroadcastMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BroadcastResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAuthorityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthorityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthorityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAuthorityPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthorityPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthorityPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthorityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthorityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthorityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthorityPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthorityPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthorityPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountWithVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountWithVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountWithVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountWithVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountWithVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountWithVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSendMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseAccountChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -913,15 +6922,15 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseAccountChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseAccountChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -949,7 +6958,7 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1004,7 +7013,7 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseAccountChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,12 +7036,44 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseAccountChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseAccountChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1054,7 +7095,7 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,10 +7118,10 @@ func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1117,9 +7158,9 @@ func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendFunds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1129,27 +7170,31 @@ func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.SendFunds == nil {
+				m.SendFunds = &MsgSendFunds{}
+			}
+			if err := m.SendFunds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendMessages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1176,11 +7221,98 @@ func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SendMessages == nil {
+				m.SendMessages = &MsgSendMessages{}
+			}
+			if err := m.SendMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repetitions", wireType)
+			}
+			m.Repetitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repetitions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1202,7 +7334,7 @@ func (m *MsgSendFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFundsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,12 +7357,31 @@ func (m *MsgSendFundsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFundsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1252,7 +7403,7 @@ func (m *MsgSendFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1275,10 +7426,10 @@ func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1314,42 +7465,10 @@ func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1359,26 +7478,11 @@ func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1400,7 +7504,7 @@ func (m *MsgSendMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1423,10 +7527,10 @@ func (m *MsgSendMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
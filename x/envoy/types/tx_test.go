@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +21,23 @@ var (
 	testChannelId    = "channel-0"
 	testValidMsg, _  = codectypes.NewAnyWithValue(govv1.NewMsgVote(testAuthority, 1, govv1.OptionYes, ""))
 	testInvalidMsg   = &codectypes.Any{TypeUrl: "/test.MsgInvalidTest", Value: []byte{}}
+	testTimeout      = time.Hour
+	testZeroTimeout  = time.Duration(0)
+	testPolicy       = types.AuthorityPolicy{
+		Authority:          testSender.String(),
+		AllowedConnections: []string{testConnectionId},
+		SpendLimit:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))),
+		EpochDuration:      24 * time.Hour,
+	}
+	testFee = types.RelayerFee{
+		RecvFee: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10))),
+		AckFee:  sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5))),
+	}
+	testEmptyFee = types.RelayerFee{}
+	testRoute    = []types.ForwardHop{
+		{Receiver: "osmo1pfm", ChannelId: "channel-1"},
+		{Receiver: "juno1pfm", ChannelId: "channel-2"},
+	}
 )
 
 func TestValidateBasic(t *testing.T) {
@@ -83,6 +101,99 @@ func TestValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"MsgSendFunds - custom timeout",
+			&types.MsgSendFunds{
+				Authority: testAuthority.String(),
+				ChannelId: testChannelId,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Timeout:   &testTimeout,
+			},
+			true,
+		},
+		{
+			"MsgSendFunds - timeout is zero",
+			&types.MsgSendFunds{
+				Authority: testAuthority.String(),
+				ChannelId: testChannelId,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Timeout:   &testZeroTimeout,
+			},
+			false,
+		},
+		{
+			"MsgSendFunds - relayer fee",
+			&types.MsgSendFunds{
+				Authority: testAuthority.String(),
+				ChannelId: testChannelId,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Fee:       &testFee,
+			},
+			true,
+		},
+		{
+			"MsgSendFunds - relayer fee is empty",
+			&types.MsgSendFunds{
+				Authority: testAuthority.String(),
+				ChannelId: testChannelId,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Fee:       &testEmptyFee,
+			},
+			false,
+		},
+		{
+			"MsgSendFunds - forwarding route",
+			&types.MsgSendFunds{
+				Authority:    testAuthority.String(),
+				ChannelId:    testChannelId,
+				Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Route:        testRoute,
+				ConnectionId: testConnectionId,
+			},
+			true,
+		},
+		{
+			"MsgSendFunds - forwarding route without connection id",
+			&types.MsgSendFunds{
+				Authority: testAuthority.String(),
+				ChannelId: testChannelId,
+				Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Route:     testRoute,
+			},
+			false,
+		},
+		{
+			"MsgSendFunds - connection id without forwarding route",
+			&types.MsgSendFunds{
+				Authority:    testAuthority.String(),
+				ChannelId:    testChannelId,
+				Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				ConnectionId: testConnectionId,
+			},
+			false,
+		},
+		{
+			"MsgSendFunds - forwarding route hop has empty receiver",
+			&types.MsgSendFunds{
+				Authority:    testAuthority.String(),
+				ChannelId:    testChannelId,
+				Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Route:        []types.ForwardHop{{Receiver: "", ChannelId: "channel-1"}},
+				ConnectionId: testConnectionId,
+			},
+			false,
+		},
+		{
+			"MsgSendFunds - forwarding route hop has invalid channel id",
+			&types.MsgSendFunds{
+				Authority:    testAuthority.String(),
+				ChannelId:    testChannelId,
+				Amount:       sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				Route:        []types.ForwardHop{{Receiver: "osmo1pfm", ChannelId: ""}},
+				ConnectionId: testConnectionId,
+			},
+			false,
+		},
 		{
 			"MsgSendMessages - success",
 			&types.MsgSendMessages{
@@ -92,6 +203,48 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"MsgSendMessages - custom timeout",
+			&types.MsgSendMessages{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Messages:     []*codectypes.Any{testValidMsg},
+				Timeout:      &testTimeout,
+			},
+			true,
+		},
+		{
+			"MsgSendMessages - timeout is zero",
+			&types.MsgSendMessages{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Messages:     []*codectypes.Any{testValidMsg},
+				Timeout:      &testZeroTimeout,
+			},
+			false,
+		},
+		{
+			"MsgSendMessages - relayer fee",
+			&types.MsgSendMessages{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Messages:     []*codectypes.Any{testValidMsg},
+				Fee:          &testFee,
+			},
+			true,
+		},
+		{
+			"MsgSendMessages - relayer fee has invalid coins",
+			&types.MsgSendMessages{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Messages:     []*codectypes.Any{testValidMsg},
+				Fee: &types.RelayerFee{
+					RecvFee: sdk.Coins{sdk.Coin{Denom: "umars", Amount: sdk.NewInt(-1)}},
+				},
+			},
+			false,
+		},
 		{
 			"MsgSendMessages - messages is empty",
 			&types.MsgSendMessages{
@@ -110,6 +263,359 @@ func TestValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"MsgBroadcastMessages - success",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testValidMsg}},
+					{ConnectionId: "connection-1", Messages: []*codectypes.Any{testValidMsg, testValidMsg}},
+				},
+				Timeout: &testTimeout,
+				Fee:     &testFee,
+			},
+			true,
+		},
+		{
+			"MsgBroadcastMessages - connections is empty",
+			&types.MsgBroadcastMessages{
+				Authority:   testAuthority.String(),
+				Connections: []types.ConnectionMessages{},
+			},
+			false,
+		},
+		{
+			"MsgBroadcastMessages - duplicate connections",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testValidMsg}},
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testValidMsg}},
+				},
+			},
+			false,
+		},
+		{
+			"MsgBroadcastMessages - invalid connection id",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: "", Messages: []*codectypes.Any{testValidMsg}},
+				},
+			},
+			false,
+		},
+		{
+			"MsgBroadcastMessages - messages is empty for a connection",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testValidMsg}},
+					{ConnectionId: "connection-1", Messages: []*codectypes.Any{}},
+				},
+			},
+			false,
+		},
+		{
+			"MsgBroadcastMessages - message does not implement sdk.Msg interface",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testInvalidMsg}},
+				},
+			},
+			false,
+		},
+		{
+			"MsgBroadcastMessages - timeout is zero",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+				Connections: []types.ConnectionMessages{
+					{ConnectionId: testConnectionId, Messages: []*codectypes.Any{testValidMsg}},
+				},
+				Timeout: &testZeroTimeout,
+			},
+			false,
+		},
+		{
+			"MsgRetryPackets - success",
+			&types.MsgRetryPackets{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ids:          []uint64{1, 2},
+				Timeout:      &testTimeout,
+			},
+			true,
+		},
+		{
+			"MsgRetryPackets - duplicate ids",
+			&types.MsgRetryPackets{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ids:          []uint64{1, 1},
+			},
+			false,
+		},
+		{
+			"MsgRetryPackets - timeout is zero",
+			&types.MsgRetryPackets{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Timeout:      &testZeroTimeout,
+			},
+			false,
+		},
+		{
+			"MsgUpdateParams - success",
+			&types.MsgUpdateParams{
+				Authority: testAuthority.String(),
+				Params:    types.DefaultParams(),
+			},
+			true,
+		},
+		{
+			"MsgUpdateParams - params are invalid",
+			&types.MsgUpdateParams{
+				Authority: testAuthority.String(),
+				Params:    types.Params{},
+			},
+			false,
+		},
+		{
+			"MsgSetAuthorityPolicy - success",
+			&types.MsgSetAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Policy:    testPolicy,
+			},
+			true,
+		},
+		{
+			"MsgSetAuthorityPolicy - policy is invalid",
+			&types.MsgSetAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Policy:    types.AuthorityPolicy{Authority: testSender.String()},
+			},
+			false,
+		},
+		{
+			"MsgRemoveAuthorityPolicy - success",
+			&types.MsgRemoveAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Address:   testSender.String(),
+			},
+			true,
+		},
+		{
+			"MsgRemoveAuthorityPolicy - address is invalid",
+			&types.MsgRemoveAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Address:   "invalid",
+			},
+			false,
+		},
+		{
+			"MsgRegisterAccountWithVersion - success",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ordering:     "ORDER_ORDERED",
+				Encoding:     "proto3",
+				FeeVersion:   "ics29-1",
+			},
+			true,
+		},
+		{
+			"MsgRegisterAccountWithVersion - success without fee version",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ordering:     "ORDER_UNORDERED",
+				Encoding:     "proto3",
+			},
+			true,
+		},
+		{
+			"MsgRegisterAccountWithVersion - invalid connection id",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: "x",
+				Ordering:     "ORDER_ORDERED",
+				Encoding:     "proto3",
+			},
+			false,
+		},
+		{
+			"MsgRegisterAccountWithVersion - invalid ordering",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ordering:     "ORDER_NONE_UNSPECIFIED",
+				Encoding:     "proto3",
+			},
+			false,
+		},
+		{
+			"MsgRegisterAccountWithVersion - empty encoding",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ordering:     "ORDER_ORDERED",
+			},
+			false,
+		},
+		{
+			"MsgRegisterAccountWithVersion - unsupported fee version",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+				Ordering:     "ORDER_ORDERED",
+				Encoding:     "proto3",
+				FeeVersion:   "ics29-2",
+			},
+			false,
+		},
+		{
+			"MsgCloseAccountChannel - success",
+			&types.MsgCloseAccountChannel{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+			},
+			true,
+		},
+		{
+			"MsgCloseAccountChannel - invalid connection id",
+			&types.MsgCloseAccountChannel{
+				Authority:    testAuthority.String(),
+				ConnectionId: "",
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - send funds",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendFunds: &types.MsgSendFunds{
+					Authority: testAuthority.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				},
+				Interval:    testTimeout,
+				Repetitions: 3,
+			},
+			true,
+		},
+		{
+			"MsgScheduleOperation - send messages once",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendMessages: &types.MsgSendMessages{
+					Authority:    testAuthority.String(),
+					ConnectionId: testConnectionId,
+					Messages:     []*codectypes.Any{testValidMsg},
+				},
+				Repetitions: 1,
+			},
+			true,
+		},
+		{
+			"MsgScheduleOperation - no payload",
+			&types.MsgScheduleOperation{
+				Authority:   testAuthority.String(),
+				Interval:    testTimeout,
+				Repetitions: 3,
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - both payloads",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendFunds: &types.MsgSendFunds{
+					Authority: testAuthority.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				},
+				SendMessages: &types.MsgSendMessages{
+					Authority:    testAuthority.String(),
+					ConnectionId: testConnectionId,
+					Messages:     []*codectypes.Any{testValidMsg},
+				},
+				Interval:    testTimeout,
+				Repetitions: 3,
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - invalid payload",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendMessages: &types.MsgSendMessages{
+					Authority:    testAuthority.String(),
+					ConnectionId: testConnectionId,
+					Messages:     []*codectypes.Any{testInvalidMsg},
+				},
+				Repetitions: 1,
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - payload authority is different",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendFunds: &types.MsgSendFunds{
+					Authority: testSender.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				},
+				Interval:    testTimeout,
+				Repetitions: 3,
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - zero repetitions",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendFunds: &types.MsgSendFunds{
+					Authority: testAuthority.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				},
+				Interval:    testTimeout,
+				Repetitions: 0,
+			},
+			false,
+		},
+		{
+			"MsgScheduleOperation - repeated with zero interval",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+				SendFunds: &types.MsgSendFunds{
+					Authority: testAuthority.String(),
+					ChannelId: testChannelId,
+					Amount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+				},
+				Interval:    testZeroTimeout,
+				Repetitions: 3,
+			},
+			false,
+		},
+		{
+			"MsgCancelScheduledOperation - success",
+			&types.MsgCancelScheduledOperation{
+				Authority: testAuthority.String(),
+				Id:        1,
+			},
+			true,
+		},
+		{
+			"MsgCancelScheduledOperation - invalid authority",
+			&types.MsgCancelScheduledOperation{
+				Authority: "invalid",
+				Id:        1,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -155,6 +661,76 @@ func TestGetSigners(t *testing.T) {
 			},
 			testAuthority,
 		},
+		{
+			"MsgBroadcastMessages",
+			&types.MsgBroadcastMessages{
+				Authority: testAuthority.String(),
+			},
+			testAuthority,
+		},
+		{
+			"MsgRetryPackets",
+			&types.MsgRetryPackets{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+			},
+			testAuthority,
+		},
+		{
+			"MsgUpdateParams",
+			&types.MsgUpdateParams{
+				Authority: testAuthority.String(),
+				Params:    types.DefaultParams(),
+			},
+			testAuthority,
+		},
+		{
+			"MsgSetAuthorityPolicy",
+			&types.MsgSetAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Policy:    testPolicy,
+			},
+			testAuthority,
+		},
+		{
+			"MsgRemoveAuthorityPolicy",
+			&types.MsgRemoveAuthorityPolicy{
+				Authority: testAuthority.String(),
+				Address:   testSender.String(),
+			},
+			testAuthority,
+		},
+		{
+			"MsgRegisterAccountWithVersion",
+			&types.MsgRegisterAccountWithVersion{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+			},
+			testAuthority,
+		},
+		{
+			"MsgCloseAccountChannel",
+			&types.MsgCloseAccountChannel{
+				Authority:    testAuthority.String(),
+				ConnectionId: testConnectionId,
+			},
+			testAuthority,
+		},
+		{
+			"MsgScheduleOperation",
+			&types.MsgScheduleOperation{
+				Authority: testAuthority.String(),
+			},
+			testAuthority,
+		},
+		{
+			"MsgCancelScheduledOperation",
+			&types.MsgCancelScheduledOperation{
+				Authority: testAuthority.String(),
+				Id:        1,
+			},
+			testAuthority,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -14,11 +14,13 @@ import (
 	"github.com/mars-protocol/hub/v2/x/incentives/types"
 )
 
-// BeginBlocker distributes block rewards to validators who have signed the
-// previous block.
+// BeginBlocker prunes expired release records, and distributes block rewards
+// to validators who have signed the previous block.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	k.PruneReleaseRecords(ctx, k.GetParams(ctx))
+
 	ids, totalBlockReward := k.ReleaseBlockReward(ctx, req.LastCommitInfo.Votes)
 
 	if !totalBlockReward.IsZero() {
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		getScheduleCmd(),
 		getSchedulesCmd(),
+		getParamsCmd(),
+		getProjectionCmd(),
+		getScheduleHistoryCmd(),
 	)
 
 	return cmd
@@ -93,3 +97,168 @@ func getSchedulesCmd() *cobra.Command {
 
 	return cmd
 }
+
+func getParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the incentives module's parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	flagEndTime   = "end-time"
+	flagBlocks    = "blocks"
+	flagBlockTime = "block-time"
+)
+
+func getProjectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection",
+		Short: "Query the amount of coins the incentives schedules will release until a future time, and the reward per bonded token",
+		Long: `Query the amount of coins the incentives schedules will release from now until a
+future time, and an estimate of the reward per unit of bonded stake.
+
+The future time is either given by --end-time, as an RFC 3339 timestamp, or by
+--blocks and --block-time, e.g. "--blocks 100800 --block-time 6s" for a week of
+6-second blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryProjectionRequest{}
+
+			endTimeStr, err := cmd.Flags().GetString(flagEndTime)
+			if err != nil {
+				return err
+			}
+
+			if endTimeStr != "" {
+				endTime, err := time.Parse(time.RFC3339, endTimeStr)
+				if err != nil {
+					return err
+				}
+
+				req.EndTime = &endTime
+			}
+
+			req.Blocks, err = cmd.Flags().GetUint64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed(flagBlockTime) {
+				blockTime, err := cmd.Flags().GetDuration(flagBlockTime)
+				if err != nil {
+					return err
+				}
+
+				req.BlockTime = &blockTime
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Projection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagEndTime, "", "end of the projection window, as an RFC 3339 timestamp")
+	cmd.Flags().Uint64(flagBlocks, 0, "length of the projection window in blocks")
+	cmd.Flags().Duration(flagBlockTime, 0, "average block time used to convert --blocks into a duration")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const flagTime = "time"
+
+func getScheduleHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-history [id]",
+		Short: "Query the archive of an incentives schedule, and the amounts it released per epoch",
+		Long: `Query the archive of an incentives schedule, if it has been fully released or
+terminated, and the amounts it released per epoch within the history retention
+window.
+
+With --time, as an RFC 3339 timestamp, only the amount released during the epoch
+containing that time is returned, e.g. "--time 2023-06-01T00:00:00Z" for the
+amount released on June 1st with 24-hour epochs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduleHistoryRequest{Id: id, Pagination: pageReq}
+
+			timeStr, err := cmd.Flags().GetString(flagTime)
+			if err != nil {
+				return err
+			}
+
+			if timeStr != "" {
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return err
+				}
+
+				req.Time = &t
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduleHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTime, "", "only return the amount released during the epoch containing this time, as an RFC 3339 timestamp")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedule-history")
+
+	return cmd
+}
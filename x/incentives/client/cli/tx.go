@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+// GetTxCmd returns the parent command for all incentives module tx commands.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Incentives transaction subcommands",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getSponsorScheduleCmd(),
+	)
+
+	return cmd
+}
+
+func getSponsorScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-schedule [start-time] [end-time] [amount]",
+		Short: "Create an incentives schedule funded by the sender, releasing the amount linearly between the given RFC 3339 times",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSponsorSchedule{
+				Sponsor:   clientCtx.GetFromAddress().String(),
+				StartTime: startTime,
+				EndTime:   endTime,
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,11 +15,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
 	// set module account
 	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 
+	// set params
+	k.SetParams(ctx, gs.Params)
+
 	// set incentives schedules
 	for _, schedule := range gs.Schedules {
 		k.SetSchedule(ctx, schedule)
 	}
 
+	// set archived incentives schedules
+	for _, archived := range gs.ArchivedSchedules {
+		k.SetArchivedSchedule(ctx, archived)
+	}
+
+	// set release records
+	for _, record := range gs.ReleaseRecords {
+		k.SetReleaseRecord(ctx, record)
+	}
+
 	// set next schedule id
 	k.SetNextScheduleID(ctx, gs.NextScheduleId)
 }
@@ -34,8 +47,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	archivedSchedules := []types.ArchivedSchedule{}
+	k.IterateArchivedSchedules(ctx, func(archived types.ArchivedSchedule) bool {
+		archivedSchedules = append(archivedSchedules, archived)
+		return false
+	})
+
+	releaseRecords := []types.ReleaseRecord{}
+	k.IterateReleaseRecords(ctx, func(record types.ReleaseRecord) bool {
+		releaseRecords = append(releaseRecords, record)
+		return false
+	})
+
 	return &types.GenesisState{
-		NextScheduleId: nextScheduleID,
-		Schedules:      schedules,
+		NextScheduleId:    nextScheduleID,
+		Schedules:         schedules,
+		Params:            k.GetParams(ctx),
+		ArchivedSchedules: archivedSchedules,
+		ReleaseRecords:    releaseRecords,
 	}
 }
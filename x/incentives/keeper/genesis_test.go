@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +12,8 @@ import (
 
 	marsapp "github.com/mars-protocol/hub/v2/app"
 	marsapptesting "github.com/mars-protocol/hub/v2/app/testing"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
 )
 
 func setupGenesisTest() (ctx sdk.Context, app *marsapp.MarsApp) {
@@ -46,3 +49,31 @@ func TestExportGenesis(t *testing.T) {
 		require.Equal(t, mockSchedules[idx].TotalAmount, exported.Schedules[idx].TotalAmount)
 	}
 }
+
+func TestExportGenesisHistory(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	gs := mockGenesisState
+	gs.NextScheduleId = 4
+	gs.ArchivedSchedules = []types.ArchivedSchedule{{
+		Schedule:     mockArchivedSchedule,
+		ArchivedTime: time.Unix(9000, 0).UTC(),
+	}}
+	gs.ReleaseRecords = mockReleaseRecords
+
+	app.IncentivesKeeper.InitGenesis(ctx, &gs)
+
+	exported := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Len(t, exported.ArchivedSchedules, 1)
+	require.Equal(t, uint64(3), exported.ArchivedSchedules[0].Schedule.Id)
+	require.Equal(t, mockArchivedSchedule.ReleasedAmount, exported.ArchivedSchedules[0].Schedule.ReleasedAmount)
+
+	// the records are exported in order of their epochs
+	require.Len(t, exported.ReleaseRecords, len(mockReleaseRecords))
+	for idx, record := range exported.ReleaseRecords {
+		require.Equal(t, mockReleaseRecords[idx].ScheduleId, record.ScheduleId)
+		require.Equal(t, mockReleaseRecords[idx].EpochStartTime, record.EpochStartTime.UTC())
+		require.Equal(t, mockReleaseRecords[idx].Amount, record.Amount)
+	}
+}
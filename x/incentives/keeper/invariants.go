@@ -14,15 +14,27 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 }
 
 // TotalUnreleasedIncentives asserts that the incentives module's coin balances
-// match exactly the total amount of unreleased incentives.
+// match exactly the total amount of unreleased incentives, whether the
+// schedules were funded by the community pool or by sponsors, plus the
+// incentives released but pending delivery to the schedules' recipients.
 func TotalUnreleasedIncentives(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		expectedTotal := sdk.NewCoins()
+		communityPoolTotal := sdk.NewCoins()
+		sponsoredTotal := sdk.NewCoins()
+		pendingTotal := sdk.NewCoins()
 		k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
-			expectedTotal = expectedTotal.Add(schedule.TotalAmount.Sub(schedule.ReleasedAmount...)...)
+			unreleased := schedule.TotalAmount.Sub(schedule.ReleasedAmount...)
+			if schedule.Sponsor == "" {
+				communityPoolTotal = communityPoolTotal.Add(unreleased...)
+			} else {
+				sponsoredTotal = sponsoredTotal.Add(unreleased...)
+			}
+			pendingTotal = pendingTotal.Add(schedule.PendingAmount...)
 			return false
 		})
 
+		expectedTotal := communityPoolTotal.Add(sponsoredTotal...).Add(pendingTotal...)
+
 		maccAddr := k.GetModuleAddress()
 		actualTotal := k.bankKeeper.GetAllBalances(ctx, maccAddr)
 
@@ -38,7 +50,10 @@ func TotalUnreleasedIncentives(k Keeper) sdk.Invariant {
 		msg := sdk.FormatInvariant(
 			types.ModuleName,
 			"total-unreleased-incentives",
-			fmt.Sprintf("\tsum of unreleased incentives: %s\n\tmodule account balances: %s", expectedTotal.String(), actualTotal.String()),
+			fmt.Sprintf(
+				"\tsum of unreleased and undelivered incentives: %s\n\tfunded by the community pool: %s\n\tfunded by sponsors: %s\n\tpending delivery to recipients: %s\n\tmodule account balances: %s",
+				expectedTotal.String(), communityPoolTotal.String(), sponsoredTotal.String(), pendingTotal.String(), actualTotal.String(),
+			),
 		)
 
 		return msg, broken
@@ -13,6 +13,7 @@ import (
 	marsapptesting "github.com/mars-protocol/hub/v2/app/testing"
 
 	"github.com/mars-protocol/hub/v2/x/incentives/keeper"
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
 )
 
 func TestUnreleasedIncentivesInvariant(t *testing.T) {
@@ -23,6 +24,22 @@ func TestUnreleasedIncentivesInvariant(t *testing.T) {
 		app.IncentivesKeeper.SetSchedule(ctx, mockSchedule)
 	}
 
+	// the second schedule was funded by a sponsor rather than the community
+	// pool. its unreleased coins are held by the module account all the same
+	sponsoredSchedule := mockSchedulesReleased[1]
+	sponsoredSchedule.Sponsor = marsapptesting.MakeRandomAccounts(1)[0].String()
+	app.IncentivesKeeper.SetSchedule(ctx, sponsoredSchedule)
+
+	// the first schedule has a recipient, and 100 umars it released are yet
+	// to be delivered. they are held by the module account too
+	recipientSchedule := mockSchedulesReleased[0]
+	recipientSchedule.Recipient = &types.Recipient{
+		Address:      marsapptesting.MakeRandomAccounts(1)[0].String(),
+		DeliveryMode: types.DeliveryModeEpoch,
+	}
+	recipientSchedule.PendingAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(100)))
+	app.IncentivesKeeper.SetSchedule(ctx, recipientSchedule)
+
 	invariant := keeper.TotalUnreleasedIncentives(app.IncentivesKeeper)
 
 	// set incorrect balances for the incentives module account
@@ -42,7 +59,10 @@ func TestUnreleasedIncentivesInvariant(t *testing.T) {
 	require.Equal(
 		t,
 		`incentives: total-unreleased-incentives invariant
-	sum of unreleased incentives: 7192uastro,8637umars
+	sum of unreleased and undelivered incentives: 7192uastro,8737umars
+	funded by the community pool: 7192uastro,1279umars
+	funded by sponsors: 7358umars
+	pending delivery to recipients: 100umars
 	module account balances: 456uastro,123umars
 `,
 		msg,
@@ -54,7 +74,7 @@ func TestUnreleasedIncentivesInvariant(t *testing.T) {
 		&banktypes.GenesisState{
 			Balances: []banktypes.Balance{{
 				Address: maccAddr.String(),
-				Coins:   sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(8637)), sdk.NewCoin("uastro", sdk.NewInt(7192))),
+				Coins:   sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(8737)), sdk.NewCoin("uastro", sdk.NewInt(7192))),
 			}},
 		},
 	)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -22,6 +23,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
+	wasmKeeper    types.WasmKeeper
 
 	authority string
 }
@@ -30,7 +32,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, distrKeeper types.DistrKeeper, stakingKeeper types.StakingKeeper,
-	authority string,
+	wasmKeeper types.WasmKeeper, authority string,
 ) Keeper {
 	// ensure incentives module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -44,6 +46,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		stakingKeeper: stakingKeeper,
+		wasmKeeper:    wasmKeeper,
 		authority:     authority,
 	}
 }
@@ -58,6 +61,34 @@ func (k Keeper) GetModuleAddress() sdk.AccAddress {
 	return k.accountKeeper.GetModuleAddress(types.ModuleName)
 }
 
+//------------------------------------------------------------------------------
+// Params
+//------------------------------------------------------------------------------
+
+// GetParams loads the module's parameters.
+//
+// NOTE: the params should have been initialized in genesis, so them being
+// undefined is a fatal error. we have the module panic in this case, instead
+// of returning an error.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		panic("stored incentives module params should not have been nil")
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams saves the provided params to store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
 //------------------------------------------------------------------------------
 // ScheduleId
 //------------------------------------------------------------------------------
@@ -149,3 +180,187 @@ func (k Keeper) GetSchedulePrefixStore(ctx sdk.Context) prefix.Store {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.KeySchedule)
 }
+
+//------------------------------------------------------------------------------
+// ArchivedSchedule
+//------------------------------------------------------------------------------
+
+// GetArchivedSchedule loads the archived incentives schedule of the specified
+// id
+func (k Keeper) GetArchivedSchedule(ctx sdk.Context, id uint64) (archived types.ArchivedSchedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetArchivedScheduleKey(id))
+	if bz == nil {
+		return archived, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &archived)
+
+	return archived, true
+}
+
+// SetArchivedSchedule saves the provided archived incentives schedule to store
+func (k Keeper) SetArchivedSchedule(ctx sdk.Context, archived types.ArchivedSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetArchivedScheduleKey(archived.Schedule.Id), k.cdc.MustMarshal(&archived))
+}
+
+// IterateArchivedSchedules iterates over all archived schedules, calling the
+// callback function with the archived schedule info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateArchivedSchedules(ctx sdk.Context, cb func(types.ArchivedSchedule) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyArchivedSchedule)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var archived types.ArchivedSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &archived)
+
+		if cb(archived) {
+			break
+		}
+	}
+}
+
+// ArchiveSchedule removes the incentives schedule from the active schedules,
+// and saves it together with the current block time to the archive.
+func (k Keeper) ArchiveSchedule(ctx sdk.Context, schedule types.Schedule, terminated bool) {
+	k.DeleteSchedule(ctx, schedule.Id)
+
+	k.SetArchivedSchedule(ctx, types.ArchivedSchedule{
+		Schedule:     schedule,
+		ArchivedTime: ctx.BlockTime(),
+		Terminated:   terminated,
+	})
+}
+
+//------------------------------------------------------------------------------
+// ReleaseRecord
+//------------------------------------------------------------------------------
+
+// GetReleaseRecord loads the release record of the schedule of the specified
+// id in the epoch starting at the specified time
+func (k Keeper) GetReleaseRecord(ctx sdk.Context, epochStartTime time.Time, id uint64) (record types.ReleaseRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetReleaseRecordKey(epochStartTime, id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetReleaseRecord saves the provided release record to store, and indexes it
+// by its schedule
+func (k Keeper) SetReleaseRecord(ctx sdk.Context, record types.ReleaseRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReleaseRecordKey(record.EpochStartTime, record.ScheduleId), k.cdc.MustMarshal(&record))
+	store.Set(types.GetScheduleHistoryKey(record.ScheduleId, record.EpochStartTime), []byte{})
+}
+
+// IterateReleaseRecords iterates over all release records in order of their
+// epochs, calling the callback function with the record info.
+// The iteration stops if the callback returns true.
+func (k Keeper) IterateReleaseRecords(ctx sdk.Context, cb func(types.ReleaseRecord) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyReleaseRecord)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ReleaseRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetReleaseRecordAt loads the release record of the schedule of the specified
+// id in the epoch containing the specified time, i.e. the last one starting no
+// later than the time, provided it hasn't ended by then
+func (k Keeper) GetReleaseRecordAt(ctx sdk.Context, id uint64, t time.Time) (record types.ReleaseRecord, found bool) {
+	store := k.GetScheduleHistoryPrefixStore(ctx, id)
+	iterator := store.ReverseIterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return record, false
+	}
+
+	epochStartTime, err := sdk.ParseTimeBytes(iterator.Key())
+	if err != nil {
+		panic(err)
+	}
+
+	record, found = k.GetReleaseRecord(ctx, epochStartTime, id)
+	if !found || !t.Before(record.EpochEndTime) {
+		return types.ReleaseRecord{}, false
+	}
+
+	return record, true
+}
+
+// GetScheduleHistoryPrefixStore returns a prefix store of the index of the
+// release records of the schedule of the given id, keyed by epoch start time
+func (k Keeper) GetScheduleHistoryPrefixStore(ctx sdk.Context, id uint64) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.GetScheduleHistoryPrefix(id))
+}
+
+// RecordRelease adds the amount released by the schedule of the given id in
+// the current block to the release record of the current epoch. Nothing is
+// recorded if the history retention is zero.
+func (k Keeper) RecordRelease(ctx sdk.Context, params types.Params, id uint64, amount sdk.Coins) {
+	if params.HistoryRetention == 0 || amount.Empty() {
+		return
+	}
+
+	epochStartTime, epochEndTime := params.GetEpoch(ctx.BlockTime())
+
+	record, found := k.GetReleaseRecord(ctx, epochStartTime, id)
+	if !found {
+		record = types.ReleaseRecord{
+			ScheduleId:     id,
+			EpochStartTime: epochStartTime,
+			EpochEndTime:   epochEndTime,
+			Amount:         sdk.NewCoins(),
+		}
+	}
+
+	record.Amount = record.Amount.Add(amount...)
+
+	k.SetReleaseRecord(ctx, record)
+}
+
+// PruneReleaseRecords deletes the release records of the epochs that started
+// longer than the history retention ago. If the retention is zero, all records
+// are deleted.
+func (k Keeper) PruneReleaseRecords(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the records are keyed by epoch start time first, so those to be pruned
+	// are all before the prefix of the cutoff time
+	end := types.GetReleaseRecordPrefix(ctx.BlockTime().Add(-params.HistoryRetention))
+	iterator := store.Iterator(types.KeyReleaseRecord, end)
+
+	records := []types.ReleaseRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ReleaseRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	iterator.Close()
+
+	for _, record := range records {
+		store.Delete(types.GetReleaseRecordKey(record.EpochStartTime, record.ScheduleId))
+		store.Delete(types.GetScheduleHistoryKey(record.ScheduleId, record.EpochStartTime))
+	}
+}
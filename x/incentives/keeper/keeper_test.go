@@ -15,6 +15,7 @@ import (
 var mockGenesisState = types.GenesisState{
 	NextScheduleId: 3,
 	Schedules:      mockSchedules,
+	Params:         types.DefaultParams(),
 }
 
 var mockSchedules = []types.Schedule{{
@@ -23,12 +24,14 @@ var mockSchedules = []types.Schedule{{
 	EndTime:        time.Unix(20000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
 	ReleasedAmount: sdk.NewCoins(),
+	Curve:          types.LinearCurve(),
 }, {
 	Id:             2,
 	StartTime:      time.Unix(15000, 0),
 	EndTime:        time.Unix(30000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000))),
 	ReleasedAmount: sdk.NewCoins(),
+	Curve:          types.LinearCurve(),
 }}
 
 var mockSchedulesReleased = []types.Schedule{{
@@ -37,10 +40,42 @@ var mockSchedulesReleased = []types.Schedule{{
 	EndTime:        time.Unix(20000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
 	ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(11066)), sdk.NewCoin("uastro", sdk.NewInt(62228))),
+	Curve:          types.LinearCurve(),
 }, {
 	Id:             2,
 	StartTime:      time.Unix(15000, 0),
 	EndTime:        time.Unix(30000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000))),
 	ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2642))),
+	Curve:          types.LinearCurve(),
+}}
+
+// mockArchivedSchedule is a schedule that has been fully released, and thus
+// archived
+var mockArchivedSchedule = types.Schedule{
+	Id:             3,
+	StartTime:      time.Unix(5000, 0),
+	EndTime:        time.Unix(8000, 0),
+	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3000))),
+	ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3000))),
+	Curve:          types.LinearCurve(),
+}
+
+// mockReleaseRecords is the amounts released by the mock schedules in hourly
+// epochs, in order of the epochs
+var mockReleaseRecords = []types.ReleaseRecord{{
+	ScheduleId:     3,
+	EpochStartTime: time.Unix(3600, 0).UTC(),
+	EpochEndTime:   time.Unix(7200, 0).UTC(),
+	Amount:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2200))),
+}, {
+	ScheduleId:     3,
+	EpochStartTime: time.Unix(7200, 0).UTC(),
+	EpochEndTime:   time.Unix(10800, 0).UTC(),
+	Amount:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(800))),
+}, {
+	ScheduleId:     1,
+	EpochStartTime: time.Unix(10800, 0).UTC(),
+	EpochEndTime:   time.Unix(14400, 0).UTC(),
+	Amount:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4114)), sdk.NewCoin("uastro", sdk.NewInt(23137))),
 }}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct{ k Keeper }
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k}
+}
+
+// Migrate1to2 migrates the incentives module's store from consensus version 1
+// to 2.
+//
+// Version 2 introduces release curves. Schedules created before then released
+// coins linearly, so here we set their curves to linear.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	schedules := []types.Schedule{}
+	m.k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	for _, schedule := range schedules {
+		if schedule.Curve.Type == types.CurveTypeUnspecified {
+			schedule.Curve = types.LinearCurve()
+			m.k.SetSchedule(ctx, schedule)
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the incentives module's store from consensus version 2
+// to 3.
+//
+// In version 2 the module didn't have any parameters, and rewarded validators
+// whether or not they signed the previous block. Here we initialize the params
+// to the default values, which keep this behavior.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// Migrate3to4 migrates the incentives module's store from consensus version 3
+// to 4.
+//
+// Version 4 introduces the archive of completed schedules and the per-epoch
+// release records, which start empty, as does their index by schedule. Here we
+// set the params that configure the records to their default values, keeping
+// the existing params as is.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+
+	params := m.k.GetParams(ctx)
+	params.EpochDuration = defaultParams.EpochDuration
+	params.HistoryRetention = defaultParams.HistoryRetention
+
+	m.k.SetParams(ctx, params)
+
+	return nil
+}
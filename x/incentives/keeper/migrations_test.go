@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marsapptesting "github.com/mars-protocol/hub/v2/app/testing"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/keeper"
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// in consensus version 1, schedules didn't have curves
+	for _, mockSchedule := range mockSchedules {
+		mockSchedule.Curve = types.Curve{}
+		app.IncentivesKeeper.SetSchedule(ctx, mockSchedule)
+	}
+
+	err := keeper.NewMigrator(app.IncentivesKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	for _, mockSchedule := range mockSchedules {
+		schedule, found := app.IncentivesKeeper.GetSchedule(ctx, mockSchedule.Id)
+		require.True(t, found)
+		require.Equal(t, types.LinearCurve(), schedule.Curve)
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	err := keeper.NewMigrator(app.IncentivesKeeper).Migrate2to3(ctx)
+	require.NoError(t, err)
+
+	// absent validators keep being rewarded, like in consensus version 2
+	require.Equal(t, types.DefaultParams(), app.IncentivesKeeper.GetParams(ctx))
+	require.Equal(t, types.AbsentRewardPolicyPay, app.IncentivesKeeper.GetParams(ctx).AbsentRewardPolicy)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// in consensus version 3, the params didn't configure the release history
+	app.IncentivesKeeper.SetParams(ctx, types.Params{
+		AbsentRewardPolicy: types.AbsentRewardPolicyRedistribute,
+		SponsorMinAmounts:  sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1000))),
+	})
+
+	err := keeper.NewMigrator(app.IncentivesKeeper).Migrate3to4(ctx)
+	require.NoError(t, err)
+
+	// the existing params are kept, and the new ones set to the defaults
+	params := app.IncentivesKeeper.GetParams(ctx)
+	require.Equal(t, types.AbsentRewardPolicyRedistribute, params.AbsentRewardPolicy)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1000))), params.SponsorMinAmounts)
+	require.Equal(t, types.DefaultParams().EpochDuration, params.EpochDuration)
+	require.Equal(t, types.DefaultParams().HistoryRetention, params.HistoryRetention)
+	require.NoError(t, params.Validate())
+}
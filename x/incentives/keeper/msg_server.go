@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,7 +28,7 @@ func (ms msgServer) CreateSchedule(goCtx context.Context, req *types.MsgCreateSc
 		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", ms.k.authority, req.Authority)
 	}
 
-	schedule, err := ms.k.CreateSchedule(ctx, req.StartTime, req.EndTime, req.Amount)
+	schedule, err := ms.k.CreateSchedule(ctx, req.StartTime, req.EndTime, req.Amount, req.GetCurveOrDefault(), req.Target, req.Recipient)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +39,7 @@ func (ms msgServer) CreateSchedule(goCtx context.Context, req *types.MsgCreateSc
 		"amount", schedule.TotalAmount.String(),
 		"startTime", schedule.StartTime.String(),
 		"endTime", schedule.EndTime.String(),
+		"curve", schedule.Curve.Type.String(),
 	)
 
 	return &types.MsgCreateScheduleResponse{}, nil
@@ -63,3 +65,79 @@ func (ms msgServer) TerminateSchedules(goCtx context.Context, req *types.MsgTerm
 
 	return &types.MsgTerminateSchedulesResponse{RefundedAmount: amount}, nil
 }
+
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Authority != ms.k.authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", ms.k.authority, req.Authority)
+	}
+
+	ms.k.SetParams(ctx, req.Params)
+
+	ms.k.Logger(ctx).Info(
+		"updated incentives module params",
+		"absentRewardPolicy", req.Params.AbsentRewardPolicy.String(),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (ms msgServer) SponsorSchedule(goCtx context.Context, req *types.MsgSponsorSchedule) (*types.MsgSponsorScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := ms.k.SponsorSchedule(ctx, sponsorAddr, req.StartTime, req.EndTime, req.Amount, req.GetCurveOrDefault(), req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleSponsored,
+			sdk.NewAttribute(types.AttributeKeySchedule, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySponsor, schedule.Sponsor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, schedule.TotalAmount.String()),
+		),
+	)
+
+	ms.k.Logger(ctx).Info(
+		"incentives schedule sponsored",
+		"id", schedule.Id,
+		"sponsor", schedule.Sponsor,
+		"amount", schedule.TotalAmount.String(),
+		"startTime", schedule.StartTime.String(),
+		"endTime", schedule.EndTime.String(),
+		"curve", schedule.Curve.Type.String(),
+	)
+
+	return &types.MsgSponsorScheduleResponse{Id: schedule.Id}, nil
+}
+
+func (ms msgServer) UpdateSchedule(goCtx context.Context, req *types.MsgUpdateSchedule) (*types.MsgUpdateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Authority != ms.k.authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", ms.k.authority, req.Authority)
+	}
+
+	schedule, toppedUp, refunded, err := ms.k.UpdateSchedule(ctx, req.Id, req.EndTime, req.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ms.k.Logger(ctx).Info(
+		"incentives schedule updated",
+		"id", schedule.Id,
+		"amount", schedule.TotalAmount.String(),
+		"endTime", schedule.EndTime.String(),
+		"toppedUpAmount", toppedUp.String(),
+		"refundedAmount", refunded.String(),
+	)
+
+	return &types.MsgUpdateScheduleResponse{ToppedUpAmount: toppedUp, RefundedAmount: refunded}, nil
+}
@@ -26,6 +26,7 @@ var mockSchedule = types.Schedule{
 	EndTime:        time.Unix(20000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
 	ReleasedAmount: sdk.Coins(nil),
+	Curve:          types.LinearCurve(),
 }
 
 const (
@@ -71,8 +72,10 @@ func TestCreateScheduleProposalPassed(t *testing.T) {
 	_, err := msgServer.CreateSchedule(ctx, req)
 	require.NoError(t, err)
 
-	_, found := app.IncentivesKeeper.GetSchedule(ctx, 1)
+	// the curve defaults to linear if not provided
+	schedule, found := app.IncentivesKeeper.GetSchedule(ctx, 1)
 	require.True(t, found)
+	require.Equal(t, types.LinearCurve(), schedule.Curve)
 }
 
 func TestTerminateSchedulesProposalPassed(t *testing.T) {
@@ -92,6 +95,77 @@ func TestTerminateSchedulesProposalPassed(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestUpdateParamsProposalPassed(t *testing.T) {
+	ctx, app := setupMsgServerTest()
+
+	params := types.Params{AbsentRewardPolicy: types.AbsentRewardPolicyCommunityPool}
+
+	msgServer := keeper.NewMsgServerImpl(app.IncentivesKeeper)
+	req := &types.MsgUpdateParams{
+		Authority: govModuleAccount,
+		Params:    params,
+	}
+	_, err := msgServer.UpdateParams(ctx, req)
+	require.NoError(t, err)
+
+	require.Equal(t, params, app.IncentivesKeeper.GetParams(ctx))
+}
+
+func TestSponsorScheduleSucceeded(t *testing.T) {
+	ctx, app := setupMsgServerTest()
+
+	// fund the sponsor with some of the coins held by the incentives module
+	// account
+	sponsor := marsapptesting.MakeRandomAccounts(1)[0]
+	amount := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000)))
+	err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsor, amount)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.SponsorMinAmounts = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000)))
+	app.IncentivesKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.IncentivesKeeper)
+	req := &types.MsgSponsorSchedule{
+		Sponsor:   sponsor.String(),
+		StartTime: mockSchedule.StartTime,
+		EndTime:   mockSchedule.EndTime,
+		Amount:    amount,
+	}
+	res, err := msgServer.SponsorSchedule(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	schedule, found := app.IncentivesKeeper.GetSchedule(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, req.Sponsor, schedule.Sponsor)
+	require.Equal(t, types.LinearCurve(), schedule.Curve)
+}
+
+func TestUpdateScheduleProposalPassed(t *testing.T) {
+	ctx, app := setupMsgServerTest()
+
+	app.IncentivesKeeper.SetSchedule(ctx, mockSchedule)
+
+	endTime := mockSchedule.EndTime.Add(time.Hour)
+
+	msgServer := keeper.NewMsgServerImpl(app.IncentivesKeeper)
+	req := &types.MsgUpdateSchedule{
+		Authority: govModuleAccount,
+		Id:        1,
+		EndTime:   &endTime,
+	}
+	res, err := msgServer.UpdateSchedule(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.ToppedUpAmount.Empty())
+	require.True(t, res.RefundedAmount.Empty())
+
+	schedule, found := app.IncentivesKeeper.GetSchedule(ctx, 1)
+	require.True(t, found)
+	require.True(t, schedule.EndTime.Equal(endTime))
+	require.Equal(t, mockSchedule.TotalAmount, schedule.TotalAmount)
+}
+
 func TestNotAuthority(t *testing.T) {
 	ctx, app := setupMsgServerTest()
 
@@ -104,4 +178,17 @@ func TestNotAuthority(t *testing.T) {
 	}
 	_, err := msgServer.CreateSchedule(ctx, req)
 	require.Error(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: notGovModuleAccount,
+		Params:    types.DefaultParams(),
+	})
+	require.Error(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateSchedule(ctx, &types.MsgUpdateSchedule{
+		Authority:   notGovModuleAccount,
+		Id:          1,
+		TotalAmount: mockSchedule.TotalAmount,
+	})
+	require.Error(t, err, govtypes.ErrInvalidSigner)
 }
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -62,3 +64,137 @@ func (qs queryServer) Schedules(goCtx context.Context, req *types.QuerySchedules
 
 	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
 }
+
+func (qs queryServer) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: qs.k.GetParams(ctx)}, nil
+}
+
+func (qs queryServer) Projection(goCtx context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var endTime time.Time
+	switch {
+	case req.EndTime != nil && (req.Blocks != 0 || req.BlockTime != nil):
+		return nil, status.Error(codes.InvalidArgument, "end time and blocks are mutually exclusive")
+
+	case req.EndTime != nil:
+		endTime = *req.EndTime
+
+	case req.Blocks != 0 && req.BlockTime != nil && *req.BlockTime > 0:
+		// the window must fit in a duration, i.e. an int64 of nanoseconds
+		if req.Blocks > uint64(math.MaxInt64/int64(*req.BlockTime)) {
+			return nil, status.Errorf(codes.InvalidArgument, "%d blocks of %s overflow the projection window", req.Blocks, *req.BlockTime)
+		}
+
+		endTime = ctx.BlockTime().Add(time.Duration(req.Blocks) * *req.BlockTime)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either end time, or blocks and a positive block time must be provided")
+	}
+
+	if !endTime.After(ctx.BlockTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time %s is not after the current block time %s", endTime, ctx.BlockTime())
+	}
+
+	// as the block reward of a schedule is the amount its curve has unlocked
+	// minus the amount already released, the block reward at the end time is
+	// what the schedule will release from now until then
+	//
+	// schedules with a recipient don't reward stakers, so they are left out of
+	// the reward per bonded token
+	schedules := []types.ScheduleProjection{}
+	total := sdk.NewCoins()
+	stakersTotal := sdk.NewCoins()
+	qs.k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		amount := schedule.GetBlockReward(endTime)
+
+		schedules = append(schedules, types.ScheduleProjection{Id: schedule.Id, Amount: amount})
+		total = total.Add(amount...)
+
+		if schedule.Recipient == nil {
+			stakersTotal = stakersTotal.Add(amount...)
+		}
+
+		return false
+	})
+
+	bondedTokens := qs.k.stakingKeeper.TotalBondedTokens(ctx)
+
+	rewardPerBondedToken := sdk.NewDecCoins()
+	if bondedTokens.IsPositive() {
+		rewardPerBondedToken = sdk.NewDecCoinsFromCoins(stakersTotal...).QuoDec(sdk.NewDecFromInt(bondedTokens))
+	}
+
+	return &types.QueryProjectionResponse{
+		EndTime:              endTime,
+		Schedules:            schedules,
+		Total:                total,
+		BondedTokens:         bondedTokens,
+		RewardPerBondedToken: rewardPerBondedToken,
+	}, nil
+}
+
+func (qs queryServer) ScheduleHistory(goCtx context.Context, req *types.QueryScheduleHistoryRequest) (*types.QueryScheduleHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Id == 0 || req.Id >= qs.k.GetNextScheduleID(ctx) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("incentives schedule not found for id %d", req.Id)
+	}
+
+	var archivedSchedule *types.ArchivedSchedule
+	if archived, found := qs.k.GetArchivedSchedule(ctx, req.Id); found {
+		archivedSchedule = &archived
+	}
+
+	records := []types.ReleaseRecord{}
+
+	// there is at most one record containing the given time, so there is
+	// nothing to paginate
+	if req.Time != nil {
+		if record, found := qs.k.GetReleaseRecordAt(ctx, req.Id, *req.Time); found {
+			records = append(records, record)
+		}
+
+		return &types.QueryScheduleHistoryResponse{
+			ArchivedSchedule: archivedSchedule,
+			ReleaseRecords:   records,
+			Pagination:       &query.PageResponse{Total: uint64(len(records))},
+		}, nil
+	}
+
+	// the records are indexed by schedule, so only those of this schedule are
+	// iterated, in order of their epochs
+	pageRes, err := query.Paginate(qs.k.GetScheduleHistoryPrefixStore(ctx, req.Id), req.Pagination, func(key, _ []byte) error {
+		epochStartTime, err := sdk.ParseTimeBytes(key)
+		if err != nil {
+			return err
+		}
+
+		record, found := qs.k.GetReleaseRecord(ctx, epochStartTime, req.Id)
+		if !found {
+			return sdkerrors.ErrNotFound.Wrapf("release record of schedule %d in epoch starting at %s", req.Id, epochStartTime)
+		}
+
+		records = append(records, record)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryScheduleHistoryResponse{
+		ArchivedSchedule: archivedSchedule,
+		ReleaseRecords:   records,
+		Pagination:       pageRes,
+	}, nil
+}
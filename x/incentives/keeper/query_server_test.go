@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -38,6 +42,12 @@ func TestEmptyQuery(t *testing.T) {
 
 	_, err = queryServer.Schedules(sdk.WrapSDKContext(ctx), nil)
 	require.Errorf(t, err, "empty request")
+
+	_, err = queryServer.Projection(sdk.WrapSDKContext(ctx), nil)
+	require.Errorf(t, err, "empty request")
+
+	_, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), nil)
+	require.Errorf(t, err, "empty request")
 }
 
 func TestQuerySchedule(t *testing.T) {
@@ -79,3 +89,151 @@ func TestQuerySchedules(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(res.Schedules))
 }
+
+func TestQueryParams(t *testing.T) {
+	ctx, app := setupQueryServerTest()
+
+	queryServer := keeper.NewQueryServerImpl(app.IncentivesKeeper)
+
+	res, err := queryServer.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+}
+
+func TestQueryProjection(t *testing.T) {
+	ctx, app := setupQueryServerTest()
+	ctx = ctx.WithBlockTime(time.Unix(12000, 0))
+
+	// by the current block time, schedule 1 has released 20% of its total
+	// amount, while schedule 2 hasn't started yet
+	schedule := mockSchedules[0]
+	schedule.ReleasedAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2469)), sdk.NewCoin("uastro", sdk.NewInt(13884)))
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	queryServer := keeper.NewQueryServerImpl(app.IncentivesKeeper)
+
+	// schedule 1 releases 60% of its total amount between 12000 and 18000,
+	// schedule 2 20% of it
+	expectedSchedules := []types.ScheduleProjection{{
+		Id:     1,
+		Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(7407)), sdk.NewCoin("uastro", sdk.NewInt(41652))),
+	}, {
+		Id:     2,
+		Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2000))),
+	}}
+	expectedTotal := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(9407)), sdk.NewCoin("uastro", sdk.NewInt(41652)))
+
+	endTime := time.Unix(18000, 0)
+	blockTime := 6 * time.Second
+	maxBlockTime := time.Duration(math.MaxInt64)
+
+	for _, req := range []*types.QueryProjectionRequest{
+		{EndTime: &endTime},
+		{Blocks: 1000, BlockTime: &blockTime},
+	} {
+		res, err := queryServer.Projection(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Equal(t, endTime.Unix(), res.EndTime.Unix())
+		require.Equal(t, expectedSchedules, res.Schedules)
+		require.Equal(t, expectedTotal, res.Total)
+
+		bondedTokens := app.StakingKeeper.TotalBondedTokens(ctx)
+		require.Equal(t, bondedTokens, res.BondedTokens)
+		require.Equal(t, sdk.NewDecCoinsFromCoins(expectedTotal...).QuoDec(sdk.NewDecFromInt(bondedTokens)), res.RewardPerBondedToken)
+	}
+
+	// the end time must be after the current block time
+	_, err := queryServer.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{EndTime: &time.Time{}})
+	require.Error(t, err)
+
+	// the end time and blocks are mutually exclusive
+	_, err = queryServer.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{EndTime: &endTime, Blocks: 1000, BlockTime: &blockTime})
+	require.Error(t, err)
+
+	// blocks require a block time
+	_, err = queryServer.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Blocks: 1000})
+	require.Error(t, err)
+	// a window that doesn't fit in a duration is rejected instead of wrapping
+	_, err = queryServer.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Blocks: math.MaxUint64, BlockTime: &blockTime})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.Projection(sdk.WrapSDKContext(ctx), &types.QueryProjectionRequest{Blocks: 2, BlockTime: &maxBlockTime})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryScheduleHistory(t *testing.T) {
+	ctx, app := setupQueryServerTest()
+
+	app.IncentivesKeeper.SetNextScheduleID(ctx, 4)
+	app.IncentivesKeeper.SetArchivedSchedule(ctx, types.ArchivedSchedule{
+		Schedule:     mockArchivedSchedule,
+		ArchivedTime: time.Unix(9000, 0),
+	})
+	for _, record := range mockReleaseRecords {
+		app.IncentivesKeeper.SetReleaseRecord(ctx, record)
+	}
+
+	queryServer := keeper.NewQueryServerImpl(app.IncentivesKeeper)
+
+	// the archived schedule, and its records in order of the epochs
+	res, err := queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{Id: 3})
+	require.NoError(t, err)
+	require.NotNil(t, res.ArchivedSchedule)
+	require.Equal(t, mockArchivedSchedule.ReleasedAmount, res.ArchivedSchedule.Schedule.ReleasedAmount)
+	require.Len(t, res.ReleaseRecords, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2200))), res.ReleaseRecords[0].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(800))), res.ReleaseRecords[1].Amount)
+
+	// paginated, skipping the records of other schedules
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{
+		Id:         1,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.ArchivedSchedule)
+	require.Len(t, res.ReleaseRecords, 1)
+	require.Equal(t, uint64(1), res.ReleaseRecords[0].ScheduleId)
+
+	// the next key continues with the next record of the same schedule
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{
+		Id:         3,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ReleaseRecords, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(2200))), res.ReleaseRecords[0].Amount)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{
+		Id:         3,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ReleaseRecords, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(800))), res.ReleaseRecords[0].Amount)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// the amount released during the epoch containing the given time
+	queryTime := time.Unix(9000, 0)
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{Id: 3, Time: &queryTime})
+	require.NoError(t, err)
+	require.Len(t, res.ReleaseRecords, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(800))), res.ReleaseRecords[0].Amount)
+
+	// the epoch boundary belongs to the next epoch
+	queryTime = time.Unix(7200, 0)
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{Id: 3, Time: &queryTime})
+	require.NoError(t, err)
+	require.Len(t, res.ReleaseRecords, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(800))), res.ReleaseRecords[0].Amount)
+
+	// no record for an epoch in which nothing was released
+	queryTime = time.Unix(20000, 0)
+	res, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{Id: 3, Time: &queryTime})
+	require.NoError(t, err)
+	require.Empty(t, res.ReleaseRecords)
+
+	// schedules that were never created don't exist
+	_, err = queryServer.ScheduleHistory(sdk.WrapSDKContext(ctx), &types.QueryScheduleHistoryRequest{Id: 4})
+	require.Error(t, err)
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+// handleRecipientReward adds the block reward of a schedule with a recipient,
+// which must be held by the incentives module account, to the schedule's
+// pending amount, and delivers the pending amount to the recipient if it is
+// due:
+//
+//   - DELIVERY_MODE_BLOCK: every block
+//   - DELIVERY_MODE_EPOCH: once the epoch in which the pending amount started
+//     to accrue has ended
+//
+// Everything pending is due once the schedule has ended. If the delivery
+// fails, the pending amount is retried at the next block, or in the next
+// epoch, respectively; if the schedule has ended, it is refunded instead.
+// Returns the updated schedule.
+func (k Keeper) handleRecipientReward(ctx sdk.Context, params types.Params, schedule types.Schedule, blockReward sdk.Coins, ended bool) types.Schedule {
+	currentTime := ctx.BlockTime()
+
+	schedule.PendingAmount = schedule.PendingAmount.Add(blockReward...)
+	if schedule.PendingAmount.Empty() {
+		return schedule
+	}
+
+	if schedule.PendingSince == nil {
+		schedule.PendingSince = &currentTime
+	}
+
+	due := ended
+	switch schedule.Recipient.DeliveryMode {
+	case types.DeliveryModeBlock:
+		due = true
+
+	case types.DeliveryModeEpoch:
+		_, epochEndTime := params.GetEpoch(*schedule.PendingSince)
+		due = due || !currentTime.Before(epochEndTime)
+	}
+
+	if !due {
+		return schedule
+	}
+
+	if err := k.deliverPending(ctx, &schedule); err != nil {
+		if ended {
+			// the pending amount won't be retried, and the module account
+			// holds it, so failing to refund it should never happen
+			if err := k.refundPending(ctx, &schedule); err != nil {
+				panic(err)
+			}
+		} else {
+			schedule.PendingSince = &currentTime
+		}
+	}
+
+	return schedule
+}
+
+// deliverPending sends the pending amount of a schedule to its recipient, and
+// notifies the recipient with a sudo call if enabled. On success, the pending
+// amount is cleared; on failure, nothing is sent, and the error is returned.
+//
+// Both events are emitted for auditing purposes.
+func (k Keeper) deliverPending(ctx sdk.Context, schedule *types.Schedule) error {
+	if schedule.PendingAmount.Empty() {
+		return nil
+	}
+
+	// use a cached context, so that a failed delivery, e.g. the recipient
+	// contract rejecting the sudo call, doesn't leave any state changes behind
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	if err := k.sendToRecipient(cacheCtx, schedule.Id, *schedule.Recipient, schedule.PendingAmount); err != nil {
+		k.Logger(ctx).Error(
+			"failed to deliver incentives to recipient",
+			"id", schedule.Id,
+			"recipient", schedule.Recipient.Address,
+			"amount", schedule.PendingAmount.String(),
+			"error", err,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeliveryFailed,
+				sdk.NewAttribute(types.AttributeKeySchedule, strconv.FormatUint(schedule.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, schedule.PendingAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+
+		return err
+	}
+
+	// this also emits the events of the transfer and the sudo call
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncentivesDelivered,
+			sdk.NewAttribute(types.AttributeKeySchedule, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, schedule.PendingAmount.String()),
+		),
+	)
+
+	schedule.PendingAmount = sdk.NewCoins()
+	schedule.PendingSince = nil
+
+	return nil
+}
+
+// sendToRecipient sends the coins from the incentives module account to the
+// recipient, then, if enabled, makes the sudo call carrying the amount, with
+// at most `types.RecipientSudoGasLimit` gas.
+func (k Keeper) sendToRecipient(ctx sdk.Context, id uint64, recipient types.Recipient, amount sdk.Coins) (err error) {
+	// the recipient address has been validated when the schedule was created,
+	// so we can ignore the error here
+	recipientAddr, _ := sdk.AccAddressFromBech32(recipient.Address)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, amount); err != nil {
+		return err
+	}
+
+	if !recipient.Sudo {
+		return nil
+	}
+
+	msg, err := json.Marshal(&types.SudoMsg{
+		IncentivesReceived: &types.IncentivesReceivedMsg{
+			ScheduleID: id,
+			Amount:     amount,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the BeginBlocker's gas meter is infinite, so limit the gas the contract
+	// may consume, and turn running out of it into an error
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(types.RecipientSudoGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.ErrOutOfGas.Wrapf("recipient sudo call ran out of gas in %s", outOfGas.Descriptor)
+		}
+	}()
+
+	_, err = k.wasmKeeper.Sudo(ctx, recipientAddr, msg)
+
+	return err
+}
+
+// refundPending returns the pending amount of a schedule, which can't be
+// delivered to its recipient, to the community pool, or to the sponsor of a
+// sponsored schedule, and clears it.
+func (k Keeper) refundPending(ctx sdk.Context, schedule *types.Schedule) error {
+	if schedule.Sponsor == "" {
+		if err := k.distrKeeper.FundCommunityPool(ctx, schedule.PendingAmount, k.GetModuleAddress()); err != nil {
+			return types.ErrFailedRefundToCommunityPool.Wrap(err.Error())
+		}
+	} else {
+		// the sponsor address has been validated when the schedule was
+		// created, so we can ignore the error here
+		sponsorAddr, _ := sdk.AccAddressFromBech32(schedule.Sponsor)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsorAddr, schedule.PendingAmount); err != nil {
+			return types.ErrFailedRefundToSponsor.Wrap(err.Error())
+		}
+	}
+
+	schedule.PendingAmount = sdk.NewCoins()
+	schedule.PendingSince = nil
+
+	return nil
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"strconv"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/mars-protocol/hub/v2/x/incentives/types"
 )
@@ -14,82 +17,347 @@ func newDecFromInt64(i int64) sdk.Dec {
 	return sdk.NewDecFromInt(sdk.NewInt(i))
 }
 
+// bondedValidator is a validator in the bonded set, its voting power, and
+// whether it signed the previous block
+type bondedValidator struct {
+	validator stakingtypes.ValidatorI
+	power     sdk.Dec
+	signed    bool
+}
+
 // ReleaseBlockReward handles the release of incentives. Returns the total
-// amount of block reward released and the list of relevant schedule ids.
+// amount of block reward released, including that of schedules with a
+// recipient, and the list of relevant schedule ids.
 //
 // `bondedVotes` is a list of {validator address, validator voted on last block
 // flag} for all validators in the bonded set.
 func (k Keeper) ReleaseBlockReward(ctx sdk.Context, bondedVotes []abci.VoteInfo) (ids []uint64, totalBlockReward sdk.Coins) {
 	currentTime := ctx.BlockTime()
+	params := k.GetParams(ctx)
+
+	// collect the schedules first, as delivering rewards to recipients may
+	// call into wasm contracts, which can write to the store
+	schedules := []types.Schedule{}
+	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
 
-	// iterate through all active schedules, sum up all rewards to be released
-	// in this block.
+	// go through all active schedules, sum up all rewards to be released in
+	// this block.
+	//
+	// the rewards of schedules without a target are all split between the
+	// bonded validators in one go; those of schedules with a target are split
+	// separately, between the validators eligible for each. so are those of
+	// sponsored schedules, so that what is withheld from the validators can be
+	// returned to the sponsor. those of schedules with a recipient are sent to
+	// the recipient instead.
 	//
-	// If an incentives schedule has been fully released, delete it from the
-	// store; otherwise, update the released amount and save
+	// update the released amount of each schedule, and record it in the
+	// current epoch's release record. If an incentives schedule has been fully
+	// released, move it from the active schedules to the archive; otherwise,
+	// save it
 	ids = []uint64{}
 	totalBlockReward = sdk.NewCoins()
-	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+	validatorBlockReward := sdk.NewCoins()
+	untargetedBlockReward := sdk.NewCoins()
+	separateSchedules := []types.Schedule{}
+	separateBlockRewards := []sdk.Coins{}
+	for _, schedule := range schedules {
 		blockReward := schedule.GetBlockReward(currentTime)
+		ended := currentTime.After(schedule.EndTime)
 
 		if !blockReward.Empty() {
 			ids = append(ids, schedule.Id)
 			totalBlockReward = totalBlockReward.Add(blockReward...)
+
+			switch {
+			case schedule.Recipient != nil:
+				// sent to the recipient below, instead of the validators
+			case schedule.Target == nil && schedule.Sponsor == "":
+				validatorBlockReward = validatorBlockReward.Add(blockReward...)
+				untargetedBlockReward = untargetedBlockReward.Add(blockReward...)
+			default:
+				validatorBlockReward = validatorBlockReward.Add(blockReward...)
+				separateSchedules = append(separateSchedules, schedule)
+				separateBlockRewards = append(separateBlockRewards, blockReward)
+			}
 		}
 
-		if currentTime.After(schedule.EndTime) {
-			k.DeleteSchedule(ctx, schedule.Id)
+		schedule.ReleasedAmount = schedule.ReleasedAmount.Add(blockReward...)
+		k.RecordRelease(ctx, params, schedule.Id, blockReward)
+
+		if schedule.Recipient != nil {
+			schedule = k.handleRecipientReward(ctx, params, schedule, blockReward, ended)
+		}
+
+		if ended {
+			k.ArchiveSchedule(ctx, schedule, false)
 		} else {
-			schedule.ReleasedAmount = schedule.ReleasedAmount.Add(blockReward...)
 			k.SetSchedule(ctx, schedule)
 		}
+	}
 
-		return false
-	})
-
-	// exit here if there is no coin to be released
-	if totalBlockReward.Empty() {
-		return
+	// exit here if there is no coin to be distributed to validators
+	if validatorBlockReward.Empty() {
+		return ids, totalBlockReward
 	}
 
 	// transfer the coins to distribution module account so that they can be
 	// distributed
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, totalBlockReward)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, validatorBlockReward)
 	if err != nil {
 		panic(err)
 	}
 
-	// sum up the total voting power voted in the last block
-	//
-	// NOTE: The following code is copied from cosmos-sdk's distribution module
-	// without change.
-	// Here the distr module adds up voting power of _all_ validators without
-	// checking whether the validator has signed the previous block or not.
+	// NOTE: by default, we add up voting power of _all_ validators without
+	// checking whether the validator has signed the previous block or not, same
+	// as cosmos-sdk's distribution module does.
 	// In other words, there is no "micro-slashing" for missing single blocks.
-	// We keep this behavior without change.
 	// More on this issue: https://twitter.com/larry0x/status/1588189416257880064
-	totalPower := sdk.ZeroDec()
+	// The module's absent reward policy param can change this behavior.
+	policy := params.AbsentRewardPolicy
+
+	validators := []bondedValidator{}
 	for _, vote := range bondedVotes {
-		totalPower = totalPower.Add(newDecFromInt64(vote.Validator.Power))
+		validators = append(validators, bondedValidator{
+			validator: k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address),
+			power:     newDecFromInt64(vote.Validator.Power),
+			signed:    vote.SignedLastBlock,
+		})
 	}
 
-	// allocate reward to validator who have signed the previous block, pro-rata
-	// to their voting power
-	//
-	// NOTE: AllocateTokensToValidator emits the `reward` event, so we don't
-	// need to emit separate events
-	totalBlockRewardDec := sdk.NewDecCoinsFromCoins(totalBlockReward...)
-	for _, vote := range bondedVotes {
-		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+	if !untargetedBlockReward.Empty() {
+		k.distributeReward(ctx, 0, "", validators, sdk.NewDecCoinsFromCoins(untargetedBlockReward...), nil, policy)
+	}
 
-		power := newDecFromInt64(vote.Validator.Power)
-		reward := totalBlockRewardDec.MulDec(power).QuoDec(totalPower)
+	for i, schedule := range separateSchedules {
+		eligibleValidators := validators
+		var maxShare *sdk.Dec
+		if schedule.Target != nil {
+			eligibleValidators = []bondedValidator{}
+			for _, validator := range validators {
+				if schedule.Target.IsEligible(validator.validator) {
+					eligibleValidators = append(eligibleValidators, validator)
+				}
+			}
 
-		totalPower = totalPower.Sub(power)
-		totalBlockRewardDec = totalBlockRewardDec.Sub(reward)
+			maxShare = schedule.Target.MaxValidatorShare
+		}
 
-		k.distrKeeper.AllocateTokensToValidator(ctx, validator, reward)
+		k.distributeReward(
+			ctx,
+			schedule.Id,
+			schedule.Sponsor,
+			eligibleValidators,
+			sdk.NewDecCoinsFromCoins(separateBlockRewards[i]...),
+			maxShare,
+			policy,
+		)
 	}
 
 	return ids, totalBlockReward
 }
+
+// distributeReward distributes the reward, which must have been sent to the
+// distribution module account, to the given validators according to the
+// absent reward policy. The id is that of the targeted or sponsored schedule
+// whose reward it is, or zero for the other schedules, and the sponsor that of
+// the schedule if it is sponsored.
+//
+//   - ABSENT_REWARD_POLICY_PAY: all validators are rewarded
+//   - ABSENT_REWARD_POLICY_REDISTRIBUTE: only validators who signed the
+//     previous block are rewarded, so the share of absent validators is
+//     redistributed to them
+//   - ABSENT_REWARD_POLICY_COMMUNITY_POOL: the reward is allocated to all
+//     validators, but the share of absent validators is returned to the
+//     community pool instead
+//
+// Whatever can't be allocated to any validator is returned to the community
+// pool, or to the sponsor of a sponsored schedule.
+func (k Keeper) distributeReward(ctx sdk.Context, id uint64, sponsor string, validators []bondedValidator, reward sdk.DecCoins, maxShare *sdk.Dec, policy types.AbsentRewardPolicy) {
+	switch policy {
+	case types.AbsentRewardPolicyPay:
+		withheld, _ := k.allocateToValidators(ctx, validators, reward, maxShare, false)
+		k.withholdReward(ctx, id, sponsor, withheld, types.WithheldReasonTarget)
+
+	case types.AbsentRewardPolicyRedistribute:
+		totalPower := sdk.ZeroDec()
+		absentPower := sdk.ZeroDec()
+		signers := []bondedValidator{}
+		for _, validator := range validators {
+			totalPower = totalPower.Add(validator.power)
+
+			if validator.signed {
+				signers = append(signers, validator)
+			} else {
+				absentPower = absentPower.Add(validator.power)
+			}
+		}
+
+		withheld, _ := k.allocateToValidators(ctx, signers, reward, maxShare, false)
+		k.withholdReward(ctx, id, sponsor, withheld, types.WithheldReasonTarget)
+
+		// the absent validators' pro-rata share of the reward, which has been
+		// redistributed to the signers, is emitted for auditing purposes
+		if absentPower.IsPositive() && len(signers) > 0 {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeIncentivesRedistributed,
+					sdk.NewAttribute(types.AttributeKeySchedule, strconv.FormatUint(id, 10)),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, reward.MulDec(absentPower).QuoDec(totalPower).String()),
+				),
+			)
+		}
+
+	case types.AbsentRewardPolicyCommunityPool:
+		withheld, absent := k.allocateToValidators(ctx, validators, reward, maxShare, true)
+		k.withholdReward(ctx, id, sponsor, withheld, types.WithheldReasonTarget)
+		k.withholdReward(ctx, id, sponsor, absent, types.WithheldReasonAbsent)
+
+	default:
+		// params are validated in genesis and when updated, so this should
+		// never happen
+		panic("unknown incentives absent reward policy " + policy.String())
+	}
+}
+
+// allocateToValidators allocates the reward, which must have been sent to the
+// distribution module account, to the given validators pro-rata to their
+// voting power.
+//
+// If a max share is given, no validator receives more than that share of the
+// reward; the excess is reallocated to the other validators, pro-rata to their
+// voting power. Returns the amount that couldn't be allocated, either because
+// there is no validator or because all of them have hit the cap.
+//
+// If withholdAbsent is true, the amounts allocated to validators that didn't
+// sign the previous block are not given to them, but returned separately.
+//
+// NOTE: AllocateTokensToValidator emits the `reward` event, so we don't need to
+// emit separate events
+func (k Keeper) allocateToValidators(ctx sdk.Context, validators []bondedValidator, reward sdk.DecCoins, maxShare *sdk.Dec, withholdAbsent bool) (withheld, absent sdk.DecCoins) {
+	remaining := reward
+	uncapped := validators
+	absent = sdk.DecCoins{}
+
+	allocate := func(validator bondedValidator, validatorReward sdk.DecCoins) {
+		if withholdAbsent && !validator.signed {
+			absent = absent.Add(validatorReward...)
+			return
+		}
+
+		k.distrKeeper.AllocateTokensToValidator(ctx, validator.validator, validatorReward)
+	}
+
+	if maxShare != nil {
+		// find the validators whose pro-rata share exceeds the cap. capping
+		// them increases the shares of the others, so repeat until none does.
+		// the share of an uncapped validator is its power over the total power
+		// of the uncapped validators, times the share not taken by the capped
+		capReward := reward.MulDec(*maxShare)
+		remainingShare := sdk.OneDec()
+
+		for {
+			uncappedPower := sdk.ZeroDec()
+			for _, validator := range uncapped {
+				uncappedPower = uncappedPower.Add(validator.power)
+			}
+
+			capped := []bondedValidator{}
+			stillUncapped := []bondedValidator{}
+			for _, validator := range uncapped {
+				if validator.power.Mul(remainingShare).GT(maxShare.Mul(uncappedPower)) {
+					capped = append(capped, validator)
+				} else {
+					stillUncapped = append(stillUncapped, validator)
+				}
+			}
+
+			if len(capped) == 0 {
+				break
+			}
+
+			for _, validator := range capped {
+				// guard against rounding making the capped rewards add up to
+				// more than the reward
+				validatorReward := remaining.Intersect(capReward)
+
+				remainingShare = remainingShare.Sub(*maxShare)
+				remaining = remaining.Sub(validatorReward)
+
+				allocate(validator, validatorReward)
+			}
+
+			uncapped = stillUncapped
+		}
+	}
+
+	totalPower := sdk.ZeroDec()
+	for _, validator := range uncapped {
+		totalPower = totalPower.Add(validator.power)
+	}
+
+	// allocate the rest to the uncapped validators, pro-rata to their voting
+	// power, the same way cosmos-sdk's distribution module does. the last
+	// validator receives all that's left, so nothing is lost to rounding
+	for _, validator := range uncapped {
+		if !totalPower.IsPositive() {
+			break
+		}
+
+		validatorReward := remaining.MulDec(validator.power).QuoDec(totalPower)
+
+		totalPower = totalPower.Sub(validator.power)
+		remaining = remaining.Sub(validatorReward)
+
+		allocate(validator, validatorReward)
+	}
+
+	return remaining, absent
+}
+
+// withholdReward adds a reward that is not to be given to any validator, which
+// must have been sent to the distribution module account, to the community
+// pool. The id is that of the targeted or sponsored schedule whose reward it
+// is, or zero for the other schedules. The reason is either that the reward
+// couldn't be allocated to the schedule's target, or that it is the share of
+// absent validators.
+//
+// The reward of a sponsored schedule is returned to the sponsor instead. Only
+// whole coins can be sent, so the decimal remainder still goes to the
+// community pool, as does everything if the sponsor can't receive the coins.
+func (k Keeper) withholdReward(ctx sdk.Context, id uint64, sponsor string, withheld sdk.DecCoins, reason string) {
+	if withheld.IsZero() {
+		return
+	}
+
+	communityPoolAmount := withheld
+	if sponsor != "" {
+		// the sponsor address has been validated when the schedule was
+		// created, so we can ignore the error here
+		sponsorAddr, _ := sdk.AccAddressFromBech32(sponsor)
+		refund, change := withheld.TruncateDecimal()
+		if !refund.Empty() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, sponsorAddr, refund)
+			if err == nil {
+				communityPoolAmount = change
+			} else {
+				k.Logger(ctx).Error("failed to return withheld incentives to sponsor", "schedule", id, "sponsor", sponsor, "err", err)
+			}
+		}
+	}
+
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(communityPoolAmount...)
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncentivesWithheld,
+			sdk.NewAttribute(types.AttributeKeySchedule, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withheld.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
@@ -13,6 +13,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	marsapp "github.com/mars-protocol/hub/v2/app"
 	marsapptesting "github.com/mars-protocol/hub/v2/app/testing"
@@ -218,3 +219,515 @@ func TestDeleteEndedSchedules(t *testing.T) {
 	_, found = keeper.GetSchedule(ctx, 2)
 	require.True(t, found)
 }
+
+//--------------------------------------------------------------------------------------------------
+// Targeted schedules
+//--------------------------------------------------------------------------------------------------
+
+// mockTargetedSchedule releases 1000 umars by time 11000
+func mockTargetedSchedule(target types.Target) types.Schedule {
+	return types.Schedule{
+		Id:             1,
+		StartTime:      time.Unix(10000, 0),
+		EndTime:        time.Unix(20000, 0),
+		TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000))),
+		ReleasedAmount: sdk.NewCoins(),
+		Curve:          types.LinearCurve(),
+		Target:         &target,
+	}
+}
+
+// setupTargetedRewardTest sets up three validators with voting powers 10, 10
+// and 20, and the given schedules. The community pool is empty and the
+// community tax rate is zero.
+func setupTargetedRewardTest(t *testing.T, schedules []types.Schedule) (ctx sdk.Context, app *marsapp.MarsApp, validators []stakingtypes.Validator, votes []abci.VoteInfo) {
+	accts := marsapptesting.MakeRandomAccounts(3)
+	maccAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	totalIncentives := sdk.NewCoins()
+	for _, schedule := range schedules {
+		totalIncentives = totalIncentives.Add(schedule.TotalAmount...)
+	}
+
+	app = marsapptesting.MakeMockApp(
+		accts,
+		[]banktypes.Balance{{
+			Address: maccAddr.String(),
+			Coins:   totalIncentives,
+		}},
+		accts,
+		sdk.NewCoins(),
+	)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(11000, 0)})
+
+	app.DistrKeeper.SetParams(ctx, distrtypes.Params{
+		CommunityTax:        sdk.ZeroDec(),
+		BaseProposerReward:  sdk.ZeroDec(),
+		BonusProposerReward: sdk.ZeroDec(),
+	})
+
+	for _, schedule := range schedules {
+		app.IncentivesKeeper.SetSchedule(ctx, schedule)
+	}
+
+	for i, acct := range accts {
+		val, found := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(acct))
+		require.True(t, found)
+
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+
+		power := int64(10)
+		if i == 2 {
+			power = 20
+		}
+
+		validators = append(validators, val)
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: consAddr, Power: power},
+			SignedLastBlock: true,
+		})
+	}
+
+	return ctx, app, validators, votes
+}
+
+// requireOutstandingRewards asserts each validator's outstanding rewards in
+// umars, and the community pool's.
+func requireOutstandingRewards(t *testing.T, ctx sdk.Context, app *marsapp.MarsApp, validators []stakingtypes.Validator, expected []int64, expectedCommunityPool int64) {
+	for i, val := range validators {
+		rewards := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards
+		require.Equal(t, sdk.NewDec(expected[i]), rewards.AmountOf("umars"), "validator %d", i)
+	}
+
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, sdk.NewDec(expectedCommunityPool), communityPool.AmountOf("umars"))
+}
+
+func TestTargetedValidators(t *testing.T) {
+	accts := marsapptesting.MakeRandomAccounts(1)
+
+	// a target whose validators are not in the bonded set is filled in below
+	schedule := mockTargetedSchedule(types.Target{})
+	ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+
+	// only the first two validators are targeted, and split the reward equally
+	schedule.Target.Validators = []string{validators[0].OperatorAddress, validators[1].OperatorAddress}
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	ids, blockReward := app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+	require.Equal(t, []uint64{1}, ids)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))), blockReward)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{500, 500, 0}, 0)
+
+	// if no bonded validator is targeted, the reward is returned to the
+	// community pool
+	schedule, _ = app.IncentivesKeeper.GetSchedule(ctx, 1)
+	schedule.Target.Validators = []string{sdk.ValAddress(accts[0]).String()}
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	ctx = ctx.WithBlockTime(time.Unix(12000, 0)).WithEventManager(sdk.NewEventManager())
+	_, blockReward = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))), blockReward)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{500, 500, 0}, 1000)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeIncentivesWithheld, events[len(events)-1].Type)
+	require.Equal(t, "1", string(events[len(events)-1].Attributes[0].Value))
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyReason), Value: []byte(types.WithheldReasonTarget)})
+}
+
+func TestTargetExcludesJailedAndHighCommission(t *testing.T) {
+	maxCommissionRate := sdk.NewDecWithPrec(5, 2)
+	schedule := mockTargetedSchedule(types.Target{
+		ExcludeJailed:     true,
+		MaxCommissionRate: &maxCommissionRate,
+	})
+	ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+
+	// the first validator is jailed, the third charges a 10% commission
+	validators[0].Jailed = true
+	app.StakingKeeper.SetValidator(ctx, validators[0])
+
+	validators[2].Commission.Rate = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetValidator(ctx, validators[2])
+
+	_, _ = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{0, 1000, 0}, 0)
+}
+
+func TestTargetMaxValidatorShare(t *testing.T) {
+	// the third validator has half of the voting power, but may only receive
+	// 40% of the reward. the rest is split between the other two
+	maxShare := sdk.NewDecWithPrec(4, 1)
+	schedule := mockTargetedSchedule(types.Target{MaxValidatorShare: &maxShare})
+	ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+
+	_, _ = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{300, 300, 400}, 0)
+
+	// if every validator hits the cap, what's left is returned to the
+	// community pool
+	schedule, _ = app.IncentivesKeeper.GetSchedule(ctx, 1)
+	maxShare = sdk.NewDecWithPrec(2, 1)
+	schedule.Target.MaxValidatorShare = &maxShare
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	ctx = ctx.WithBlockTime(time.Unix(12000, 0))
+	_, _ = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{500, 500, 600}, 400)
+}
+
+//--------------------------------------------------------------------------------------------------
+// Absent validators
+//--------------------------------------------------------------------------------------------------
+
+func TestAbsentRewardPolicy(t *testing.T) {
+	schedule := mockTargetedSchedule(types.Target{})
+	schedule.Target = nil
+
+	testCases := []struct {
+		policy                types.AbsentRewardPolicy
+		expected              []int64
+		expectedCommunityPool int64
+		expectedEvent         string
+		expectedReason        string
+	}{
+		{
+			types.AbsentRewardPolicyPay,
+			[]int64{250, 250, 500},
+			0,
+			"",
+			"",
+		},
+		{
+			types.AbsentRewardPolicyRedistribute,
+			[]int64{500, 500, 0},
+			0,
+			types.EventTypeIncentivesRedistributed,
+			"",
+		},
+		{
+			types.AbsentRewardPolicyCommunityPool,
+			[]int64{250, 250, 0},
+			500,
+			types.EventTypeIncentivesWithheld,
+			types.WithheldReasonAbsent,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+		app.IncentivesKeeper.SetParams(ctx, types.Params{AbsentRewardPolicy: tc.policy})
+
+		// the third validator didn't sign the previous block
+		votes[2].SignedLastBlock = false
+
+		_, blockReward := app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))), blockReward)
+
+		requireOutstandingRewards(t, ctx, app, validators, tc.expected, tc.expectedCommunityPool)
+
+		// the absent validator's share is emitted for auditing
+		event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+		if tc.expectedEvent == "" {
+			require.NotEqual(t, types.EventTypeIncentivesRedistributed, event.Type)
+			require.NotEqual(t, types.EventTypeIncentivesWithheld, event.Type)
+		} else {
+			require.Equal(t, tc.expectedEvent, event.Type)
+			require.Contains(t, event.Attributes, abci.EventAttribute{Key: []byte(sdk.AttributeKeyAmount), Value: []byte("500.000000000000000000umars")})
+
+			// withheld rewards are told apart by their reason
+			if tc.expectedReason != "" {
+				require.Contains(t, event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyReason), Value: []byte(tc.expectedReason)})
+			}
+		}
+	}
+}
+
+func TestAbsentRewardPolicyTargeted(t *testing.T) {
+	// the third validator is capped at 40% of the reward, which is withheld
+	// as it didn't sign the previous block. the other two split the rest
+	maxShare := sdk.NewDecWithPrec(4, 1)
+	schedule := mockTargetedSchedule(types.Target{MaxValidatorShare: &maxShare})
+	ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+	app.IncentivesKeeper.SetParams(ctx, types.Params{AbsentRewardPolicy: types.AbsentRewardPolicyCommunityPool})
+
+	votes[2].SignedLastBlock = false
+
+	_, _ = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{300, 300, 0}, 400)
+}
+
+func TestAbsentRewardPolicySponsored(t *testing.T) {
+	// the absent validator's share of a sponsored schedule's reward is
+	// returned to the sponsor instead of the community pool
+	sponsor := marsapptesting.MakeRandomAccounts(1)[0]
+	schedule := mockTargetedSchedule(types.Target{})
+	schedule.Target = nil
+	schedule.Sponsor = sponsor.String()
+	ctx, app, validators, votes := setupTargetedRewardTest(t, []types.Schedule{schedule})
+	app.IncentivesKeeper.SetParams(ctx, types.Params{AbsentRewardPolicy: types.AbsentRewardPolicyCommunityPool})
+
+	votes[2].SignedLastBlock = false
+
+	_, _ = app.IncentivesKeeper.ReleaseBlockReward(ctx, votes)
+
+	requireOutstandingRewards(t, ctx, app, validators, []int64{250, 250, 0}, 0)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(500))), app.BankKeeper.GetAllBalances(ctx, sponsor))
+}
+
+//--------------------------------------------------------------------------------------------------
+// Release history
+//--------------------------------------------------------------------------------------------------
+
+func TestArchiveEndedSchedules(t *testing.T) {
+	suite := setupRewardTest(t, mockSchedules)
+
+	ctx, keeper := suite.ctx, &suite.app.IncentivesKeeper
+
+	suite.setBlockHeight(1)
+	suite.setBlockTime(13333)
+	_, _ = suite.releaseBlockReward()
+
+	suite.setBlockTime(20001)
+	_, _ = suite.releaseBlockReward()
+
+	// schedule 1 should have been archived with everything released
+	archived, found := keeper.GetArchivedSchedule(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, mockSchedules[0].TotalAmount, archived.Schedule.ReleasedAmount)
+	require.Equal(t, time.Unix(20001, 0).UTC(), archived.ArchivedTime.UTC())
+	require.False(t, archived.Terminated)
+
+	// schedule 2 is still active, so it should NOT have been archived
+	_, found = keeper.GetArchivedSchedule(ctx, 2)
+	require.False(t, found)
+}
+
+func TestReleaseRecords(t *testing.T) {
+	suite := setupRewardTest(t, mockSchedules)
+
+	ctx, keeper := suite.ctx, &suite.app.IncentivesKeeper
+
+	// hourly epochs, kept for two hours
+	params := types.DefaultParams()
+	params.EpochDuration = time.Hour
+	params.HistoryRetention = 2 * time.Hour
+	keeper.SetParams(ctx, params)
+
+	// epoch [10800, 14400): schedule 1 releases 4114 umars + 23137 uastro
+	suite.setBlockHeight(1)
+	suite.setBlockTime(13333)
+	_, _ = suite.releaseBlockReward()
+
+	// epoch [18000, 21600): schedule 1 releases 6952 umars + 39091 uastro,
+	// then the rest of its 12345 umars + 69420 uastro as it ends; schedule 2
+	// releases 2642 umars, then 3334 - 2642 = 692 umars
+	suite.setBlockTime(18964)
+	_, _ = suite.releaseBlockReward()
+
+	suite.setBlockTime(20001)
+	_, _ = suite.releaseBlockReward()
+
+	record, found := keeper.GetReleaseRecord(ctx, time.Unix(10800, 0), 1)
+	require.True(t, found)
+	require.Equal(t, time.Unix(14400, 0).UTC(), record.EpochEndTime.UTC())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4114)), sdk.NewCoin("uastro", sdk.NewInt(23137))), record.Amount)
+
+	_, found = keeper.GetReleaseRecord(ctx, time.Unix(10800, 0), 2)
+	require.False(t, found)
+
+	record, found = keeper.GetReleaseRecord(ctx, time.Unix(18000, 0), 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(8231)), sdk.NewCoin("uastro", sdk.NewInt(46283))), record.Amount)
+
+	record, found = keeper.GetReleaseRecord(ctx, time.Unix(18000, 0), 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3334))), record.Amount)
+
+	// the epoch starting at 10800 started more than two hours ago, so its
+	// record is pruned, while those of the current epoch are kept
+	keeper.PruneReleaseRecords(suite.ctx, params)
+
+	_, found = keeper.GetReleaseRecord(ctx, time.Unix(10800, 0), 1)
+	require.False(t, found)
+
+	_, found = keeper.GetReleaseRecord(ctx, time.Unix(18000, 0), 1)
+	require.True(t, found)
+
+	_, found = keeper.GetReleaseRecord(ctx, time.Unix(18000, 0), 2)
+	require.True(t, found)
+
+	// the index of the pruned record is deleted along with it
+	iterator := keeper.GetScheduleHistoryPrefixStore(ctx, 1).Iterator(nil, nil)
+	require.True(t, iterator.Valid())
+	require.Equal(t, sdk.FormatTimeBytes(time.Unix(18000, 0)), iterator.Key())
+	iterator.Next()
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	// with a zero retention, nothing is recorded and all records are pruned
+	params.HistoryRetention = 0
+	keeper.SetParams(ctx, params)
+
+	suite.setBlockTime(21000)
+	_, _ = suite.releaseBlockReward()
+	keeper.PruneReleaseRecords(suite.ctx, params)
+
+	records := []types.ReleaseRecord{}
+	keeper.IterateReleaseRecords(ctx, func(record types.ReleaseRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	require.Empty(t, records)
+}
+
+//--------------------------------------------------------------------------------------------------
+// Recipients
+//--------------------------------------------------------------------------------------------------
+
+// mockRecipientSchedule is mockSchedules[0] with its rewards going to the
+// given recipient instead of the validators
+func mockRecipientSchedule(recipient types.Recipient) types.Schedule {
+	schedule := mockSchedules[0]
+	schedule.Recipient = &recipient
+	return schedule
+}
+
+func TestRecipientBlockDelivery(t *testing.T) {
+	recipient := marsapptesting.MakeRandomAccounts(1)[0]
+
+	suite := setupRewardTest(t, []types.Schedule{mockRecipientSchedule(types.Recipient{
+		Address:      recipient.String(),
+		DeliveryMode: types.DeliveryModeBlock,
+	})})
+
+	// the released coins are sent to the recipient straight away
+	suite.setBlockHeight(1)
+	suite.setBlockTime(13333)
+	expectedBlockReward := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4114)), sdk.NewCoin("uastro", sdk.NewInt(23137)))
+
+	ids, blockReward := suite.releaseBlockReward()
+	require.Equal(t, []uint64{1}, ids)
+	require.Equal(t, expectedBlockReward, blockReward)
+
+	require.Equal(t, expectedBlockReward, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	schedule, found := suite.app.IncentivesKeeper.GetSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.Equal(t, expectedBlockReward, schedule.ReleasedAmount)
+	require.True(t, schedule.PendingAmount.Empty())
+	require.Nil(t, schedule.PendingSince)
+
+	events := suite.ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeIncentivesDelivered, events[len(events)-1].Type)
+
+	// the validators receive nothing
+	suite.setBlockHeight(2)
+	require.True(t, suite.calculateDelegationReward().IsZero())
+}
+
+func TestRecipientEpochDelivery(t *testing.T) {
+	recipient := marsapptesting.MakeRandomAccounts(1)[0]
+
+	suite := setupRewardTest(t, []types.Schedule{mockRecipientSchedule(types.Recipient{
+		Address:      recipient.String(),
+		DeliveryMode: types.DeliveryModeEpoch,
+	})})
+
+	// hourly epochs
+	params := types.DefaultParams()
+	params.EpochDuration = time.Hour
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+	// the coins released during the epoch [10800, 14400) are accrued
+	suite.setBlockHeight(1)
+	suite.setBlockTime(13333)
+	_, _ = suite.releaseBlockReward()
+
+	suite.setBlockTime(14000)
+	_, _ = suite.releaseBlockReward()
+
+	require.True(t, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient).Empty())
+
+	schedule, found := suite.app.IncentivesKeeper.GetSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4938)), sdk.NewCoin("uastro", sdk.NewInt(27768))), schedule.PendingAmount)
+	require.Equal(t, time.Unix(13333, 0).UTC(), schedule.PendingSince.UTC())
+
+	// once the epoch has ended, they are sent in one go, along with those of
+	// the current block
+	suite.setBlockTime(14400)
+	_, _ = suite.releaseBlockReward()
+
+	expectedDelivered := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5431)), sdk.NewCoin("uastro", sdk.NewInt(30544)))
+	require.Equal(t, expectedDelivered, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	schedule, found = suite.app.IncentivesKeeper.GetSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.True(t, schedule.PendingAmount.Empty())
+
+	// coins pending when the schedule is terminated are delivered, while the
+	// unreleased ones are refunded
+	suite.setBlockTime(15000)
+	_, _ = suite.releaseBlockReward()
+
+	refunded, err := suite.app.IncentivesKeeper.TerminateSchedules(suite.ctx, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6173)), sdk.NewCoin("uastro", sdk.NewInt(34710))), refunded)
+
+	expectedDelivered = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6172)), sdk.NewCoin("uastro", sdk.NewInt(34710)))
+	require.Equal(t, expectedDelivered, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	archived, found := suite.app.IncentivesKeeper.GetArchivedSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.True(t, archived.Terminated)
+	require.True(t, archived.Schedule.PendingAmount.Empty())
+}
+
+func TestRecipientDeliveryFailed(t *testing.T) {
+	// the recipient is not a contract, so the sudo call fails
+	recipient := marsapptesting.MakeRandomAccounts(1)[0]
+
+	suite := setupRewardTest(t, []types.Schedule{mockRecipientSchedule(types.Recipient{
+		Address:      recipient.String(),
+		DeliveryMode: types.DeliveryModeBlock,
+		Sudo:         true,
+	})})
+
+	// the failed delivery is reverted, and kept pending
+	suite.setBlockHeight(1)
+	suite.setBlockTime(13333)
+	_, _ = suite.releaseBlockReward()
+
+	require.True(t, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient).Empty())
+
+	schedule, found := suite.app.IncentivesKeeper.GetSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4114)), sdk.NewCoin("uastro", sdk.NewInt(23137))), schedule.PendingAmount)
+
+	events := suite.ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeDeliveryFailed, events[len(events)-1].Type)
+
+	// once the schedule has ended, what can't be delivered is refunded to the
+	// community pool
+	suite.setBlockTime(20001)
+	_, _ = suite.releaseBlockReward()
+
+	require.True(t, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient).Empty())
+
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(mockSchedules[0].TotalAmount...), communityPool)
+
+	archived, found := suite.app.IncentivesKeeper.GetArchivedSchedule(suite.ctx, 1)
+	require.True(t, found)
+	require.Equal(t, mockSchedules[0].TotalAmount, archived.Schedule.ReleasedAmount)
+	require.True(t, archived.Schedule.PendingAmount.Empty())
+}
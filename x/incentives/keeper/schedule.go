@@ -12,33 +12,89 @@ import (
 // CreateSchedule upon a successful CreateIncentivesScheduleProposal, withdraws
 // appropriate amount of funds from the community pool, and initializes a new
 // schedule in module store. Returns the new schedule that was created.
-func (k Keeper) CreateSchedule(ctx sdk.Context, startTime, endTime time.Time, amount sdk.Coins) (schedule types.Schedule, err error) {
+//
+// The schedule's rewards go to the validators, or to the recipient if given.
+func (k Keeper) CreateSchedule(ctx sdk.Context, startTime, endTime time.Time, amount sdk.Coins, curve types.Curve, target *types.Target, recipient *types.Recipient) (schedule types.Schedule, err error) {
+	// the sudo call can only be made to a contract
+	if recipient != nil && recipient.Sudo {
+		// the recipient address has been validated in ValidateBasic, so we can
+		// ignore the error here
+		recipientAddr, _ := sdk.AccAddressFromBech32(recipient.Address)
+		if !k.wasmKeeper.HasContractInfo(ctx, recipientAddr) {
+			return types.Schedule{}, types.ErrInvalidRecipient.Wrapf("recipient %s is not a contract, so can't be notified with sudo calls", recipient.Address)
+		}
+	}
+
+	schedule = k.initSchedule(ctx, startTime, endTime, amount, curve, target, recipient, "")
+
+	maccAddr := k.GetModuleAddress()
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, maccAddr); err != nil {
+		return types.Schedule{}, types.ErrFailedWithdrawFromCommunityPool.Wrap(err.Error())
+	}
+
+	return schedule, nil
+}
+
+// SponsorSchedule upon a successful MsgSponsorSchedule, asserts that the
+// amount is allowed by the module's params, withdraws it from the sponsor, and
+// initializes a new schedule in module store. Returns the new schedule that was
+// created.
+func (k Keeper) SponsorSchedule(ctx sdk.Context, sponsor sdk.AccAddress, startTime, endTime time.Time, amount sdk.Coins, curve types.Curve, target *types.Target) (schedule types.Schedule, err error) {
+	params := k.GetParams(ctx)
+
+	for _, coin := range amount {
+		minAmount := params.SponsorMinAmounts.AmountOf(coin.Denom)
+		if minAmount.IsZero() {
+			return types.Schedule{}, types.ErrInvalidSponsorship.Wrapf("denom %s is not allowed", coin.Denom)
+		}
+
+		if coin.Amount.LT(minAmount) {
+			return types.Schedule{}, types.ErrInvalidSponsorship.Wrapf("amount %s is less than the minimum %s%s", coin, minAmount, coin.Denom)
+		}
+	}
+
+	schedule = k.initSchedule(ctx, startTime, endTime, amount, curve, target, nil, sponsor.String())
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsor, types.ModuleName, amount); err != nil {
+		return types.Schedule{}, types.ErrFailedWithdrawFromSponsor.Wrap(err.Error())
+	}
+
+	return schedule, nil
+}
+
+// initSchedule initializes a new schedule with the next schedule id in module
+// store. The recipient is nil for schedules rewarding validators, and the
+// sponsor is empty for schedules funded by the community pool.
+func (k Keeper) initSchedule(ctx sdk.Context, startTime, endTime time.Time, amount sdk.Coins, curve types.Curve, target *types.Target, recipient *types.Recipient, sponsor string) types.Schedule {
 	id := k.IncrementNextScheduleID(ctx)
 
-	schedule = types.Schedule{
+	schedule := types.Schedule{
 		Id:             id,
 		StartTime:      startTime,
 		EndTime:        endTime,
 		TotalAmount:    amount,
 		ReleasedAmount: sdk.NewCoins(),
+		Curve:          curve,
+		Target:         target,
+		Sponsor:        sponsor,
+		Recipient:      recipient,
 	}
 
 	k.SetSchedule(ctx, schedule)
 
-	maccAddr := k.GetModuleAddress()
-	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, maccAddr); err != nil {
-		return types.Schedule{}, types.ErrFailedWithdrawFromCommunityPool.Wrap(err.Error())
-	}
-
-	return schedule, nil
+	return schedule
 }
 
 // TerminateSchedules upon a successful TerminateIncentivesScheduleProposal,
-// deletes the schedules specified by the proposal from module store, and
-// returns the unreleased funds to the community pool. Returns the funds that
-// ware returned.
+// moves the schedules specified by the proposal to the archive, and
+// returns the unreleased funds to the community pool, or to the sponsors of
+// sponsored schedules. Returns the funds that ware returned.
+//
+// Funds released but not yet delivered to the recipients of schedules with
+// one are delivered, or returned as well if the delivery fails.
 func (k Keeper) TerminateSchedules(ctx sdk.Context, ids []uint64) (amount sdk.Coins, err error) {
 	amount = sdk.NewCoins()
+	communityPoolAmount := sdk.NewCoins()
 
 	for _, id := range ids {
 		schedule, found := k.GetSchedule(ctx, id)
@@ -46,15 +102,157 @@ func (k Keeper) TerminateSchedules(ctx sdk.Context, ids []uint64) (amount sdk.Co
 			return sdk.NewCoins(), sdkerrors.ErrKeyNotFound.Wrapf("incentives schedule with id %d does not exist", id)
 		}
 
-		amount = amount.Add(schedule.TotalAmount.Sub(schedule.ReleasedAmount...)...)
+		refund := schedule.TotalAmount.Sub(schedule.ReleasedAmount...)
+
+		// coins released but not yet delivered to the recipient are delivered
+		// now. if that fails, they are refunded along with the unreleased ones
+		if schedule.Recipient != nil {
+			if err := k.deliverPending(ctx, &schedule); err != nil {
+				refund = refund.Add(schedule.PendingAmount...)
+				schedule.PendingAmount = sdk.NewCoins()
+				schedule.PendingSince = nil
+			}
+		}
+
+		amount = amount.Add(refund...)
 
-		k.DeleteSchedule(ctx, id)
+		if schedule.Sponsor == "" {
+			communityPoolAmount = communityPoolAmount.Add(refund...)
+		} else {
+			// the sponsor address has been validated when the schedule was
+			// created, so we can ignore the error here
+			sponsorAddr, _ := sdk.AccAddressFromBech32(schedule.Sponsor)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsorAddr, refund); err != nil {
+				return sdk.NewCoins(), types.ErrFailedRefundToSponsor.Wrap(err.Error())
+			}
+		}
+
+		k.ArchiveSchedule(ctx, schedule, true)
 	}
 
 	maccAddr := k.GetModuleAddress()
-	if err := k.distrKeeper.FundCommunityPool(ctx, amount, maccAddr); err != nil {
+	if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolAmount, maccAddr); err != nil {
 		return sdk.NewCoins(), types.ErrFailedRefundToCommunityPool.Wrap(err.Error())
 	}
 
 	return amount, nil
 }
+
+// UpdateSchedule upon a successful MsgUpdateSchedule, amends the end time
+// and/or the total amount of the schedule of the given id. A nil end time or
+// an empty total amount leaves it unchanged.
+//
+// Coins in excess of the current total amount are withdrawn from the community
+// pool, while unreleased coins no longer needed are refunded to the community
+// pool, or the sponsor. Returns the updated schedule and the funds that were
+// withdrawn and refunded.
+//
+// If the schedule has already started, it is re-based at the block time: its
+// start time is reset to the block time and its released amount recorded as
+// the base amount, so that its curve releases the rest of the new total amount
+// over the time left until the new end time. A cliff not yet reached is
+// shortened so that it still ends at the same time.
+//
+// Schedules with a step curve can't be updated, as their steps are fixed, and
+// neither can those with an exponential curve, as re-basing would restart the
+// decay, releasing the rest at the initial rate again.
+func (k Keeper) UpdateSchedule(ctx sdk.Context, id uint64, endTime *time.Time, totalAmount sdk.Coins) (schedule types.Schedule, toppedUp, refunded sdk.Coins, err error) {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return types.Schedule{}, nil, nil, sdkerrors.ErrKeyNotFound.Wrapf("incentives schedule with id %d does not exist", id)
+	}
+
+	if schedule.Curve.Type == types.CurveTypeStep || schedule.Curve.Type == types.CurveTypeExponential {
+		return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrapf("schedules with a %s can't be updated", schedule.Curve.Type)
+	}
+
+	if endTime != nil {
+		if endTime.Before(schedule.EndTime) {
+			return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrapf("end time %s is earlier than the current end time %s", endTime, schedule.EndTime)
+		}
+
+		schedule.EndTime = *endTime
+	}
+
+	toppedUp = sdk.NewCoins()
+	refunded = sdk.NewCoins()
+
+	if !totalAmount.Empty() {
+		if !totalAmount.IsAllGTE(schedule.ReleasedAmount) {
+			return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrapf("total amount %s is less than the released amount %s", totalAmount, schedule.ReleasedAmount)
+		}
+
+		for _, coin := range totalAmount {
+			if current := schedule.TotalAmount.AmountOf(coin.Denom); coin.Amount.GT(current) {
+				toppedUp = toppedUp.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(current)))
+			}
+		}
+
+		for _, coin := range schedule.TotalAmount {
+			if updated := totalAmount.AmountOf(coin.Denom); coin.Amount.GT(updated) {
+				refunded = refunded.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(updated)))
+			}
+		}
+
+		// sponsored schedules are refunded to the sponsor, so they can't hold
+		// coins from the community pool
+		if schedule.Sponsor != "" && !toppedUp.Empty() {
+			return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrap("sponsored schedules can't be topped up from the community pool")
+		}
+
+		schedule.TotalAmount = totalAmount
+	}
+
+	currentTime := ctx.BlockTime()
+	if !schedule.EndTime.After(currentTime) {
+		return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrapf("end time %s is not after the current time %s", schedule.EndTime, currentTime)
+	}
+
+	if currentTime.After(schedule.StartTime) {
+		if schedule.Curve.Cliff != nil {
+			elapsed := currentTime.Sub(schedule.StartTime)
+			if cliff := *schedule.Curve.Cliff - elapsed; cliff > 0 {
+				schedule.Curve.Cliff = &cliff
+			} else {
+				schedule.Curve.Type = types.CurveTypeLinear
+				schedule.Curve.Cliff = nil
+			}
+		}
+
+		schedule.StartTime = currentTime
+		schedule.BaseAmount = schedule.ReleasedAmount
+	}
+
+	// the curve must still be valid for the schedule's new duration, e.g. the
+	// cliff must be shorter than the schedule
+	if err := schedule.Curve.Validate(schedule.EndTime.Sub(schedule.StartTime), schedule.TotalAmount); err != nil {
+		return types.Schedule{}, nil, nil, types.ErrInvalidScheduleUpdate.Wrap(err.Error())
+	}
+
+	k.SetSchedule(ctx, schedule)
+
+	maccAddr := k.GetModuleAddress()
+
+	if !toppedUp.Empty() {
+		if err := k.distrKeeper.DistributeFromFeePool(ctx, toppedUp, maccAddr); err != nil {
+			return types.Schedule{}, nil, nil, types.ErrFailedWithdrawFromCommunityPool.Wrap(err.Error())
+		}
+	}
+
+	if !refunded.Empty() {
+		if schedule.Sponsor == "" {
+			if err := k.distrKeeper.FundCommunityPool(ctx, refunded, maccAddr); err != nil {
+				return types.Schedule{}, nil, nil, types.ErrFailedRefundToCommunityPool.Wrap(err.Error())
+			}
+		} else {
+			// the sponsor address has been validated when the schedule was
+			// created, so we can ignore the error here
+			sponsorAddr, _ := sdk.AccAddressFromBech32(schedule.Sponsor)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsorAddr, refunded); err != nil {
+				return types.Schedule{}, nil, nil, types.ErrFailedRefundToSponsor.Wrap(err.Error())
+			}
+		}
+	}
+
+	return schedule, toppedUp, refunded, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -45,6 +46,9 @@ func TestCreateSchedule(t *testing.T) {
 		mockSchedules[1].StartTime,
 		mockSchedules[1].EndTime,
 		mockSchedules[1].TotalAmount,
+		mockSchedules[1].Curve,
+		mockSchedules[1].Target,
+		mockSchedules[1].Recipient,
 	)
 	require.NoError(t, err)
 
@@ -72,6 +76,27 @@ func TestCreateSchedule(t *testing.T) {
 	require.Equal(t, sdk.DecCoins(nil), feePool.CommunityPool)
 }
 
+func TestCreateScheduleRecipientNotContract(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// only contracts can be notified with sudo calls
+	_, err := app.IncentivesKeeper.CreateSchedule(
+		ctx,
+		mockSchedules[1].StartTime,
+		mockSchedules[1].EndTime,
+		mockSchedules[1].TotalAmount,
+		mockSchedules[1].Curve,
+		nil,
+		&types.Recipient{
+			Address:      marsapptesting.MakeRandomAccounts(1)[0].String(),
+			DeliveryMode: types.DeliveryModeBlock,
+			Sudo:         true,
+		},
+	)
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+}
+
 func TestTerminateSchedule(t *testing.T) {
 	accts := marsapptesting.MakeRandomAccounts(1)
 	maccAddr := authtypes.NewModuleAddress(types.ModuleName)
@@ -114,6 +139,14 @@ func TestTerminateSchedule(t *testing.T) {
 	_, found = app.IncentivesKeeper.GetSchedule(ctx, 2)
 	require.False(t, found)
 
+	// and archived as terminated, with the amounts they had released
+	for _, mockSchedule := range mockSchedulesReleased {
+		archived, found := app.IncentivesKeeper.GetArchivedSchedule(ctx, mockSchedule.Id)
+		require.True(t, found)
+		require.True(t, archived.Terminated)
+		require.Equal(t, mockSchedule.ReleasedAmount, archived.Schedule.ReleasedAmount)
+	}
+
 	// the incentives module account should have been deducted balance
 	balances := app.BankKeeper.GetAllBalances(ctx, maccAddr)
 	require.Equal(t, sdk.NewCoins(), balances)
@@ -126,3 +159,238 @@ func TestTerminateSchedule(t *testing.T) {
 	feePool := app.DistrKeeper.GetFeePool(ctx)
 	require.Equal(t, sdk.NewDecCoinsFromCoins(amount...), feePool.CommunityPool)
 }
+
+func TestSponsorSchedule(t *testing.T) {
+	accts := marsapptesting.MakeRandomAccounts(2)
+	sponsor := accts[1]
+	maccAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	app := marsapptesting.MakeMockApp(
+		accts,
+		[]banktypes.Balance{{
+			Address: sponsor.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(10000)), sdk.NewCoin("uatom", sdk.NewInt(10000))),
+		}},
+		accts[:1],
+		sdk.NewCoins(),
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// schedules can only be sponsored in uastro, with a minimum of 1000
+	params := types.DefaultParams()
+	params.SponsorMinAmounts = sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1000)))
+	app.IncentivesKeeper.SetParams(ctx, params)
+
+	sponsorSchedule := func(amount sdk.Coins) (types.Schedule, error) {
+		return app.IncentivesKeeper.SponsorSchedule(
+			ctx,
+			sponsor,
+			mockSchedules[1].StartTime,
+			mockSchedules[1].EndTime,
+			amount,
+			types.LinearCurve(),
+			nil,
+		)
+	}
+
+	// the denom must be allowed
+	_, err := sponsorSchedule(sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1000)), sdk.NewCoin("uatom", sdk.NewInt(1000))))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// the amount must not be less than the minimum
+	_, err = sponsorSchedule(sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(999))))
+	require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+
+	// the sponsor must have sufficient balance
+	_, err = sponsorSchedule(sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(10001))))
+	require.ErrorIs(t, err, types.ErrFailedWithdrawFromSponsor)
+
+	schedule, err := sponsorSchedule(sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(4000))))
+	require.NoError(t, err)
+	require.Equal(t, sponsor.String(), schedule.Sponsor)
+
+	// the incentives module account should have been funded by the sponsor
+	balances := app.BankKeeper.GetAllBalances(ctx, maccAddr)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(4000))), balances)
+
+	balances = app.BankKeeper.GetAllBalances(ctx, sponsor)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(6000)), sdk.NewCoin("uatom", sdk.NewInt(10000))), balances)
+
+	// the unreleased amount is refunded to the sponsor, not the community pool,
+	// upon termination
+	schedule.ReleasedAmount = sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(1500)))
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, schedule.ReleasedAmount))
+
+	amount, err := app.IncentivesKeeper.TerminateSchedules(ctx, []uint64{schedule.Id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(2500))), amount)
+
+	balances = app.BankKeeper.GetAllBalances(ctx, sponsor)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(8500)), sdk.NewCoin("uatom", sdk.NewInt(10000))), balances)
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	require.True(t, feePool.CommunityPool.IsZero())
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	accts := marsapptesting.MakeRandomAccounts(1)
+	maccAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// the incentives module account holds the unreleased coins of the
+	// schedule, the community pool 1000 umars to top it up with
+	schedule := mockSchedulesReleased[0]
+	app := marsapptesting.MakeMockApp(
+		accts,
+		[]banktypes.Balance{{
+			Address: maccAddr.String(),
+			Coins:   schedule.TotalAmount.Sub(schedule.ReleasedAmount...),
+		}},
+		accts,
+		sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))),
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(19000, 0)})
+
+	app.IncentivesKeeper.SetNextScheduleID(ctx, 2)
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	// the schedule must exist
+	_, _, _, err := app.IncentivesKeeper.UpdateSchedule(ctx, 2, nil, schedule.TotalAmount)
+	require.Error(t, err)
+
+	// the end time can't be brought forward
+	earlierEndTime := schedule.EndTime.Add(-time.Second)
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, 1, &earlierEndTime, nil)
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	// coins already released can't be clawed back
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, 1, nil, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(69420))))
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, 1, nil, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(62227)), sdk.NewCoin("umars", sdk.NewInt(12345))))
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	// the community pool only has 1000 umars. a failed tx is reverted, so we
+	// use a cache context
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(cacheCtx, 1, nil, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(69420)), sdk.NewCoin("umars", sdk.NewInt(13346))))
+	require.ErrorIs(t, err, types.ErrFailedWithdrawFromCommunityPool)
+
+	// extend the end time, top up umars and reduce uastro
+	endTime := time.Unix(25000, 0)
+	totalAmount := sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(65000)), sdk.NewCoin("umars", sdk.NewInt(13345)))
+	updated, toppedUp, refunded, err := app.IncentivesKeeper.UpdateSchedule(ctx, 1, &endTime, totalAmount)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))), toppedUp)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(4420))), refunded)
+
+	schedule, found := app.IncentivesKeeper.GetSchedule(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, updated.TotalAmount, schedule.TotalAmount)
+	require.Equal(t, endTime.Unix(), schedule.EndTime.Unix())
+	require.Equal(t, totalAmount, schedule.TotalAmount)
+	require.Equal(t, mockSchedulesReleased[0].ReleasedAmount, schedule.ReleasedAmount)
+
+	// the schedule is re-based at the block time
+	require.Equal(t, int64(19000), schedule.StartTime.Unix())
+	require.Equal(t, mockSchedulesReleased[0].ReleasedAmount, schedule.BaseAmount)
+
+	// the incentives module account should hold the new unreleased amount
+	balances := app.BankKeeper.GetAllBalances(ctx, maccAddr)
+	require.Equal(t, totalAmount.Sub(schedule.ReleasedAmount...), balances)
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("uastro", sdk.NewInt(4420))), feePool.CommunityPool)
+
+	// the unreleased 2279 umars and 2772 uastro are released over the 6000
+	// seconds left, neither paying out a backlog nor pausing
+	require.True(t, schedule.GetBlockReward(time.Unix(19000, 0)).Empty())
+	require.Equal(
+		t,
+		sdk.NewCoins(sdk.NewCoin("uastro", sdk.NewInt(277)), sdk.NewCoin("umars", sdk.NewInt(227))),
+		schedule.GetBlockReward(time.Unix(19600, 0)),
+	)
+	require.Equal(t, totalAmount.Sub(schedule.ReleasedAmount...), schedule.GetBlockReward(time.Unix(25001, 0)))
+}
+
+func TestUpdateScheduleCliff(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(12000, 0)})
+
+	// the cliff ends at 14000, after the update, so it is shortened to keep
+	// its end time
+	cliff := 4000 * time.Second
+	schedule := mockSchedules[0]
+	schedule.StartTime = time.Unix(10000, 0)
+	schedule.Curve = types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &cliff}
+	app.IncentivesKeeper.SetSchedule(ctx, schedule)
+
+	endTime := schedule.EndTime.Add(time.Hour)
+	updated, _, _, err := app.IncentivesKeeper.UpdateSchedule(ctx, schedule.Id, &endTime, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.CurveTypeCliffLinear, updated.Curve.Type)
+	require.Equal(t, 2000*time.Second, *updated.Curve.Cliff)
+
+	// once the cliff has been reached, the curve becomes linear
+	ctx = ctx.WithBlockTime(time.Unix(15000, 0))
+	endTime = endTime.Add(time.Hour)
+	updated, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, schedule.Id, &endTime, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.LinearCurve(), updated.Curve)
+}
+
+func TestUpdateScheduleInvalid(t *testing.T) {
+	app := marsapptesting.MakeSimpleMockApp()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// sponsored schedules can't be topped up from the community pool
+	sponsored := mockSchedules[0]
+	sponsored.Sponsor = marsapptesting.MakeRandomAccounts(1)[0].String()
+	app.IncentivesKeeper.SetSchedule(ctx, sponsored)
+
+	_, _, _, err := app.IncentivesKeeper.UpdateSchedule(ctx, sponsored.Id, nil, sponsored.TotalAmount.Add(sdk.NewCoin("umars", sdk.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	// schedules with a step curve can't be updated, not even extended
+	stepped := mockSchedules[1]
+	stepped.Curve = types.Curve{
+		Type:  types.CurveTypeStep,
+		Steps: []types.Step{{Offset: 0, Amount: stepped.TotalAmount}},
+	}
+	app.IncentivesKeeper.SetSchedule(ctx, stepped)
+
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, stepped.Id, nil, stepped.TotalAmount.Add(sdk.NewCoin("umars", sdk.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	endTime := stepped.EndTime.Add(time.Hour)
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, stepped.Id, &endTime, nil)
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	// nor can schedules with an exponential curve, as re-basing would restart
+	// the decay
+	period := time.Hour
+	decayFactor := sdk.NewDecWithPrec(5, 1)
+	exponential := mockSchedules[1]
+	exponential.Curve = types.Curve{
+		Type:        types.CurveTypeExponential,
+		Period:      &period,
+		DecayFactor: &decayFactor,
+	}
+	app.IncentivesKeeper.SetSchedule(ctx, exponential)
+
+	endTime = exponential.EndTime.Add(time.Hour)
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, exponential.Id, &endTime, nil)
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, exponential.Id, nil, exponential.TotalAmount.Add(sdk.NewCoin("umars", sdk.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+
+	// a schedule that has ended can't be updated, as it is about to be
+	// archived
+	ended := mockSchedules[0]
+	app.IncentivesKeeper.SetSchedule(ctx, ended)
+
+	ctx = ctx.WithBlockTime(ended.EndTime)
+	_, _, _, err = app.IncentivesKeeper.UpdateSchedule(ctx, ended.Id, nil, ended.TotalAmount)
+	require.ErrorIs(t, err, types.ErrInvalidScheduleUpdate)
+}
@@ -11,6 +11,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateSchedule{},
 		&MsgTerminateSchedules{},
+		&MsgUpdateParams{},
+		&MsgSponsorSchedule{},
+		&MsgUpdateSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
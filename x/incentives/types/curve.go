@@ -0,0 +1,180 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LinearCurve returns a curve releasing coins at a constant rate, which is the
+// default for schedules that don't specify one.
+func LinearCurve() Curve {
+	return Curve{Type: CurveTypeLinear}
+}
+
+// Validate asserts that the curve is valid for a schedule of the given
+// duration and total amount:
+//
+//   - the type must be specified, and only the fields relevant to it set
+//   - the cliff must be positive and shorter than the schedule
+//   - the steps' offsets must be strictly increasing and within the schedule,
+//     and their amounts must add up to the total amount
+//   - the period must be positive and the decay factor strictly between zero
+//     and one
+func (c Curve) Validate(duration time.Duration, totalAmount sdk.Coins) error {
+	if c.Cliff != nil && c.Type != CurveTypeCliffLinear {
+		return ErrInvalidCurve.Wrapf("cliff can only be set for %s", CurveTypeCliffLinear)
+	}
+
+	if len(c.Steps) > 0 && c.Type != CurveTypeStep {
+		return ErrInvalidCurve.Wrapf("steps can only be set for %s", CurveTypeStep)
+	}
+
+	if (c.Period != nil || c.DecayFactor != nil) && c.Type != CurveTypeExponential {
+		return ErrInvalidCurve.Wrapf("period and decay factor can only be set for %s", CurveTypeExponential)
+	}
+
+	switch c.Type {
+	case CurveTypeLinear:
+		return nil
+
+	case CurveTypeCliffLinear:
+		if c.Cliff == nil || *c.Cliff <= 0 || *c.Cliff >= duration {
+			return ErrInvalidCurve.Wrap("cliff must be positive and shorter than the schedule")
+		}
+
+		return nil
+
+	case CurveTypeStep:
+		return validateSteps(c.Steps, duration, totalAmount)
+
+	case CurveTypeExponential:
+		if c.Period == nil || *c.Period <= 0 {
+			return ErrInvalidCurve.Wrap("period must be positive")
+		}
+
+		if c.DecayFactor == nil || c.DecayFactor.IsNil() || !c.DecayFactor.IsPositive() || c.DecayFactor.GTE(sdk.OneDec()) {
+			return ErrInvalidCurve.Wrap("decay factor must be greater than zero and less than one")
+		}
+
+		return nil
+
+	default:
+		return ErrInvalidCurve.Wrapf("unknown curve type %s", c.Type)
+	}
+}
+
+func validateSteps(steps []Step, duration time.Duration, totalAmount sdk.Coins) error {
+	if len(steps) == 0 {
+		return ErrInvalidCurve.Wrap("steps cannot be empty")
+	}
+
+	sum := sdk.NewCoins()
+	for i, step := range steps {
+		if step.Offset < 0 || step.Offset > duration {
+			return ErrInvalidCurve.Wrapf("step %d offset %s is not within the schedule", i, step.Offset)
+		}
+
+		if i > 0 && step.Offset <= steps[i-1].Offset {
+			return ErrInvalidCurve.Wrapf("step %d offset %s is not after the previous step's", i, step.Offset)
+		}
+
+		if step.Amount.Empty() {
+			return ErrInvalidCurve.Wrapf("step %d amount cannot be empty", i)
+		}
+
+		if err := step.Amount.Validate(); err != nil {
+			return ErrInvalidCurve.Wrapf("step %d amount is invalid: %s", i, err)
+		}
+
+		sum = sum.Add(step.Amount...)
+	}
+
+	if !sum.IsEqual(totalAmount) {
+		return ErrInvalidCurve.Wrapf("steps add up to %s, not the total amount %s", sum, totalAmount)
+	}
+
+	return nil
+}
+
+// unlockedAmount returns the cumulative amount of coins that the curve has
+// unlocked after the given time has elapsed since the schedule's start, for a
+// schedule of the given duration and total amount. The elapsed time must be
+// between zero and the duration.
+//
+// The fraction of the total amount to be unlocked is computed with sdk.Dec,
+// i.e. 18 decimal places, every multiplication and division being rounded to
+// that precision. The total amount is multiplied before it is divided, and the
+// resulting amount of each coin is truncated, i.e. rounded down to an integer.
+// The truncated remainders are not lost, as the block reward is the unlocked
+// amount minus the amount already released; they are merely released in later
+// blocks, and everything is released once the end time has passed.
+func (c Curve) unlockedAmount(elapsed, duration time.Duration, totalAmount sdk.Coins) sdk.Coins {
+	totalAmountDec := sdk.NewDecCoinsFromCoins(totalAmount...)
+
+	var unlockedDec sdk.DecCoins
+
+	switch c.Type {
+	case CurveTypeLinear:
+		unlockedDec = totalAmountDec.MulDec(durationToSecondsDec(elapsed)).QuoDec(durationToSecondsDec(duration))
+
+	case CurveTypeCliffLinear:
+		if elapsed < *c.Cliff {
+			return sdk.NewCoins()
+		}
+
+		unlockedDec = totalAmountDec.MulDec(durationToSecondsDec(elapsed)).QuoDec(durationToSecondsDec(duration))
+
+	case CurveTypeStep:
+		// step amounts are exact, so there is nothing to truncate
+		unlocked := sdk.NewCoins()
+		for _, step := range c.Steps {
+			if step.Offset > elapsed {
+				break
+			}
+
+			unlocked = unlocked.Add(step.Amount...)
+		}
+
+		return unlocked
+
+	case CurveTypeExponential:
+		weightElapsed := exponentialWeight(elapsed, *c.Period, *c.DecayFactor)
+		weightTotal := exponentialWeight(duration, *c.Period, *c.DecayFactor)
+
+		unlockedDec = totalAmountDec.MulDec(weightElapsed).QuoDec(weightTotal)
+
+	default:
+		// curves are validated in genesis and when schedules are created, so
+		// this should never happen
+		panic("unknown incentives schedule curve type " + c.Type.String())
+	}
+
+	unlocked, _ := unlockedDec.TruncateDecimal()
+
+	return unlocked
+}
+
+// exponentialWeight returns the weight of the coins that an exponential curve
+// releases during the given elapsed time, the release rate of the first period
+// being one per period.
+//
+// After k full periods and a fraction q of the next one, the weight is
+// S(k) + q * (S(k+1) - S(k)), where S(k) = (1 - r^k) / (1 - r) is the weight of
+// the first k periods and r the decay factor. Interpolating between S(k) and
+// S(k+1) ensures that the weight is continuous at period boundaries despite
+// rounding.
+func exponentialWeight(elapsed, period time.Duration, decayFactor sdk.Dec) sdk.Dec {
+	fullPeriods := elapsed / period
+	fraction := durationToSecondsDec(elapsed - fullPeriods*period).Quo(durationToSecondsDec(period))
+
+	one := sdk.OneDec()
+	periodsWeight := func(k uint64) sdk.Dec {
+		return one.Sub(decayFactor.Power(k)).Quo(one.Sub(decayFactor))
+	}
+
+	weightBefore := periodsWeight(uint64(fullPeriods))
+	weightAfter := periodsWeight(uint64(fullPeriods) + 1)
+
+	return weightBefore.Add(fraction.Mul(weightAfter.Sub(weightBefore)))
+}
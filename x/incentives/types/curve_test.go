@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+func TestValidateCurve(t *testing.T) {
+	duration := 10000 * time.Second
+	totalAmount := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000)))
+
+	cliff := 2500 * time.Second
+	zero := time.Duration(0)
+	period := 2500 * time.Second
+	halving := sdk.NewDecWithPrec(5, 1)
+	one := sdk.OneDec()
+
+	testCases := []struct {
+		name    string
+		curve   types.Curve
+		expPass bool
+	}{
+		{
+			"linear",
+			types.LinearCurve(),
+			true,
+		},
+		{
+			"unspecified type",
+			types.Curve{},
+			false,
+		},
+		{
+			"linear with a cliff",
+			types.Curve{Type: types.CurveTypeLinear, Cliff: &cliff},
+			false,
+		},
+		{
+			"cliff linear",
+			types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &cliff},
+			true,
+		},
+		{
+			"cliff linear without a cliff",
+			types.Curve{Type: types.CurveTypeCliffLinear},
+			false,
+		},
+		{
+			"cliff linear with a zero cliff",
+			types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &zero},
+			false,
+		},
+		{
+			"cliff linear with a cliff as long as the schedule",
+			types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &duration},
+			false,
+		},
+		{
+			"step",
+			types.Curve{Type: types.CurveTypeStep, Steps: []types.Step{
+				{Offset: 0, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4000)))},
+				{Offset: duration, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6000)))},
+			}},
+			true,
+		},
+		{
+			"step without steps",
+			types.Curve{Type: types.CurveTypeStep},
+			false,
+		},
+		{
+			"step with steps out of order",
+			types.Curve{Type: types.CurveTypeStep, Steps: []types.Step{
+				{Offset: cliff, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4000)))},
+				{Offset: cliff, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6000)))},
+			}},
+			false,
+		},
+		{
+			"step with a step after the end time",
+			types.Curve{Type: types.CurveTypeStep, Steps: []types.Step{
+				{Offset: duration + 1, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000)))},
+			}},
+			false,
+		},
+		{
+			"step with amounts not adding up to the total",
+			types.Curve{Type: types.CurveTypeStep, Steps: []types.Step{
+				{Offset: 0, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4000)))},
+				{Offset: duration, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5000)))},
+			}},
+			false,
+		},
+		{
+			"step with an empty amount",
+			types.Curve{Type: types.CurveTypeStep, Steps: []types.Step{
+				{Offset: 0, Amount: sdk.NewCoins()},
+				{Offset: duration, Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000)))},
+			}},
+			false,
+		},
+		{
+			"exponential",
+			types.Curve{Type: types.CurveTypeExponential, Period: &period, DecayFactor: &halving},
+			true,
+		},
+		{
+			"exponential without a period",
+			types.Curve{Type: types.CurveTypeExponential, DecayFactor: &halving},
+			false,
+		},
+		{
+			"exponential with a decay factor of one",
+			types.Curve{Type: types.CurveTypeExponential, Period: &period, DecayFactor: &one},
+			false,
+		},
+		{
+			"exponential with steps",
+			types.Curve{
+				Type:        types.CurveTypeExponential,
+				Period:      &period,
+				DecayFactor: &halving,
+				Steps:       []types.Step{{Offset: 0, Amount: totalAmount}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.curve.Validate(duration, totalAmount)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidCurve, tc.name)
+		}
+	}
+}
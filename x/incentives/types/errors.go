@@ -9,4 +9,12 @@ var (
 	ErrInvalidProposalAuthority        = errors.Register(ModuleName, 5, "invalid incentives proposal authority")
 	ErrInvalidProposalIds              = errors.Register(ModuleName, 6, "invalid incentives proposal ids")
 	ErrInvalidProposalStartEndTimes    = errors.Register(ModuleName, 7, "invalid incentives proposal start and end times")
+	ErrInvalidCurve                    = errors.Register(ModuleName, 8, "invalid incentives schedule curve")
+	ErrInvalidTarget                   = errors.Register(ModuleName, 9, "invalid incentives schedule target")
+	ErrInvalidParams                   = errors.Register(ModuleName, 10, "invalid incentives module params")
+	ErrInvalidSponsorship              = errors.Register(ModuleName, 11, "invalid incentives schedule sponsorship")
+	ErrFailedWithdrawFromSponsor       = errors.Register(ModuleName, 12, "failed to withdraw funds from sponsor")
+	ErrFailedRefundToSponsor           = errors.Register(ModuleName, 13, "failed to return funds to sponsor")
+	ErrInvalidScheduleUpdate           = errors.Register(ModuleName, 14, "invalid incentives schedule update")
+	ErrInvalidRecipient                = errors.Register(ModuleName, 15, "invalid incentives schedule recipient")
 )
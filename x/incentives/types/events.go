@@ -1,6 +1,22 @@
 package types
 
 const (
-	EventTypeIncentivesReleased = "incentives_released"
-	AttributeKeySchedules       = "schedules"
+	EventTypeIncentivesReleased      = "incentives_released"
+	EventTypeIncentivesWithheld      = "incentives_withheld"
+	EventTypeIncentivesRedistributed = "incentives_redistributed"
+	EventTypeScheduleSponsored       = "incentives_schedule_sponsored"
+	EventTypeIncentivesDelivered     = "incentives_delivered"
+	EventTypeDeliveryFailed          = "incentives_delivery_failed"
+
+	AttributeKeySchedules = "schedules"
+	AttributeKeySchedule  = "schedule"
+	AttributeKeyReason    = "reason"
+	AttributeKeySponsor   = "sponsor"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyError     = "error"
+
+	// reasons for withholding a reward from validators and returning it to
+	// the community pool
+	WithheldReasonTarget = "target"
+	WithheldReasonAbsent = "absent"
 )
@@ -1,19 +1,26 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesisState returns the default genesis state of the incentives module
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		NextScheduleId: 1,
-		Schedules:      []Schedule{},
+		NextScheduleId:    1,
+		Schedules:         []Schedule{},
+		Params:            DefaultParams(),
+		ArchivedSchedules: []ArchivedSchedule{},
+		ReleaseRecords:    []ReleaseRecord{},
 	}
 }
 
 // ValidateGenesis validates the given instance of the incentives module's
 // genesis state.
 //
-// for each schedule,
+// the params must be valid, and for each schedule,
 //
 // - the id must be smaller than the next schedule id
 //
@@ -24,7 +31,29 @@ func DefaultGenesisState() *GenesisState {
 // - the total amount must be non-zero
 //
 // - the released amount must be equal or smaller than the total amount
+//
+// - the curve must be valid for the schedule's duration and total amount
+//
+// - the target, if set, must be valid
+//
+// - the sponsor, if set, must be a valid address
+//
+// - the recipient, if set, must be valid, and the target not set
+//
+// - the pending amount must be equal or smaller than the released amount
+//
+// each archived schedule's id must be smaller than the next schedule id and not
+// be that of an active or another archived schedule, and its released amount
+// must not exceed its total amount.
+//
+// each release record's schedule id must be smaller than the next schedule id,
+// there must be no other record for the same schedule and epoch, the epoch end
+// time must be after the start time, and the amount must be valid and non-zero.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	seenIds := make(map[uint64]bool)
 	for _, schedule := range gs.Schedules {
 		if schedule.Id >= gs.NextScheduleId {
@@ -47,8 +76,85 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("incentives schedule %d total amount is not all greater or equal than released amount", schedule.Id)
 		}
 
+		if err := schedule.Curve.Validate(schedule.EndTime.Sub(schedule.StartTime), schedule.TotalAmount); err != nil {
+			return fmt.Errorf("incentives schedule %d has invalid curve: %s", schedule.Id, err)
+		}
+
+		if schedule.Target != nil {
+			if err := schedule.Target.Validate(); err != nil {
+				return fmt.Errorf("incentives schedule %d has invalid target: %s", schedule.Id, err)
+			}
+		}
+
+		if schedule.Sponsor != "" {
+			if _, err := sdk.AccAddressFromBech32(schedule.Sponsor); err != nil {
+				return fmt.Errorf("incentives schedule %d has invalid sponsor: %s", schedule.Id, err)
+			}
+		}
+
+		if schedule.Recipient != nil {
+			if schedule.Target != nil {
+				return fmt.Errorf("incentives schedule %d has both a recipient and a target", schedule.Id)
+			}
+
+			if err := schedule.Recipient.Validate(); err != nil {
+				return fmt.Errorf("incentives schedule %d has invalid recipient: %s", schedule.Id, err)
+			}
+		}
+
+		if !schedule.ReleasedAmount.IsAllGTE(schedule.BaseAmount) {
+			return fmt.Errorf("incentives schedule %d released amount is not all greater or equal than base amount", schedule.Id)
+		}
+
+		if !schedule.ReleasedAmount.IsAllGTE(schedule.PendingAmount) {
+			return fmt.Errorf("incentives schedule %d released amount is not all greater or equal than pending amount", schedule.Id)
+		}
+
 		seenIds[schedule.Id] = true
 	}
 
+	for _, archived := range gs.ArchivedSchedules {
+		id := archived.Schedule.Id
+		if id >= gs.NextScheduleId {
+			return fmt.Errorf("archived incentives schedule id %d is not smaller than next schedule id %d", id, gs.NextScheduleId)
+		}
+
+		if seenIds[id] {
+			return fmt.Errorf("archived incentives schedule has duplicate id %d", id)
+		}
+
+		if !archived.Schedule.TotalAmount.IsAllGTE(archived.Schedule.ReleasedAmount) {
+			return fmt.Errorf("archived incentives schedule %d total amount is not all greater or equal than released amount", id)
+		}
+
+		seenIds[id] = true
+	}
+
+	seenRecords := make(map[string]bool)
+	for _, record := range gs.ReleaseRecords {
+		if record.ScheduleId >= gs.NextScheduleId {
+			return fmt.Errorf("release record schedule id %d is not smaller than next schedule id %d", record.ScheduleId, gs.NextScheduleId)
+		}
+
+		key := string(GetReleaseRecordKey(record.EpochStartTime, record.ScheduleId))
+		if seenRecords[key] {
+			return fmt.Errorf("duplicate release record for incentives schedule %d and epoch starting at %s", record.ScheduleId, record.EpochStartTime)
+		}
+
+		if !record.EpochEndTime.After(record.EpochStartTime) {
+			return fmt.Errorf("release record of incentives schedule %d epoch end time is not after start time", record.ScheduleId)
+		}
+
+		if record.Amount.Empty() {
+			return fmt.Errorf("release record of incentives schedule %d has zero amount", record.ScheduleId)
+		}
+
+		if err := record.Amount.Validate(); err != nil {
+			return fmt.Errorf("release record of incentives schedule %d has invalid amount: %s", record.ScheduleId, err)
+		}
+
+		seenRecords[key] = true
+	}
+
 	return nil
 }
@@ -29,6 +29,14 @@ type GenesisState struct {
 	NextScheduleId uint64 `protobuf:"varint,1,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty" yaml:"next_schedule_id"`
 	// Schedules is an array of active incentives schedules
 	Schedules []Schedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+	// Params is the module's parameters
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ArchivedSchedules is an array of incentives schedules that have been fully
+	// released or terminated
+	ArchivedSchedules []ArchivedSchedule `protobuf:"bytes,4,rep,name=archived_schedules,json=archivedSchedules,proto3" json:"archived_schedules" yaml:"archived_schedules"`
+	// ReleaseRecords is an array of the amounts released by incentives
+	// schedules per epoch, within the history retention window
+	ReleaseRecords []ReleaseRecord `protobuf:"bytes,5,rep,name=release_records,json=releaseRecords,proto3" json:"release_records" yaml:"release_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +86,27 @@ func (m *GenesisState) GetSchedules() []Schedule {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetArchivedSchedules() []ArchivedSchedule {
+	if m != nil {
+		return m.ArchivedSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReleaseRecords() []ReleaseRecord {
+	if m != nil {
+		return m.ReleaseRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mars.incentives.v1beta1.GenesisState")
 }
@@ -87,24 +116,31 @@ func init() {
 }
 
 var fileDescriptor_eb28b18334d44e0f = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x4d, 0x2c, 0x2a,
-	0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x07, 0x29, 0xd3, 0x43, 0x28, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x94, 0x71, 0x99, 0x5a, 0x5c, 0x92, 0x5f,
-	0x94, 0x0a, 0x51, 0xa4, 0x34, 0x87, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x4b, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x2b, 0x97, 0x40, 0x5e, 0x6a, 0x45, 0x49, 0x7c, 0x71, 0x72, 0x46, 0x6a, 0x4a, 0x69,
-	0x4e, 0x6a, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x8b, 0x93, 0xf4, 0xa7, 0x7b, 0xf2,
-	0xe2, 0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xe8, 0x2a, 0x94, 0x82, 0xf8, 0x40, 0x42, 0xc1, 0x50,
-	0x11, 0xcf, 0x14, 0x21, 0x57, 0x2e, 0x4e, 0x98, 0x7c, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0xa2, 0x1e, 0x0e, 0xf7, 0xeb, 0xc1, 0xf4, 0x39, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x84,
-	0xd0, 0xe9, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x20, 0x73, 0x75, 0xc1, 0xfe, 0x49,
-	0xce, 0xcf, 0xd1, 0xcf, 0x28, 0x4d, 0xd2, 0xaf, 0x40, 0xf6, 0x77, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x58, 0x81, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x4e, 0xee, 0xd8, 0x6d, 0x01,
-	0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6a, 0xe2, 0x50,
+	0x14, 0xc7, 0x93, 0xd1, 0x11, 0x26, 0x0e, 0xce, 0x4c, 0x18, 0x6a, 0x6a, 0x21, 0xd1, 0xf4, 0x03,
+	0xbb, 0x68, 0x2e, 0xda, 0x5d, 0xa1, 0x8b, 0x06, 0xa4, 0xb8, 0x2b, 0x71, 0xd7, 0x4d, 0xb8, 0x49,
+	0x0e, 0x49, 0x20, 0xc9, 0x95, 0x7b, 0xaf, 0xa2, 0xf4, 0x25, 0xfa, 0x58, 0x76, 0xe7, 0xb2, 0x2b,
+	0x29, 0xfa, 0x06, 0x3e, 0x41, 0xc9, 0x87, 0x55, 0x2c, 0xd9, 0x85, 0x93, 0xdf, 0xff, 0xf7, 0x3f,
+	0x87, 0x44, 0xba, 0x8c, 0x31, 0x65, 0x28, 0x4c, 0x5c, 0x48, 0x78, 0x38, 0x05, 0x86, 0xa6, 0x3d,
+	0x07, 0x38, 0xee, 0x21, 0x1f, 0x12, 0x60, 0x21, 0x33, 0xc6, 0x94, 0x70, 0x22, 0x37, 0x53, 0xcc,
+	0xd8, 0x63, 0x46, 0x81, 0xb5, 0xfe, 0xfb, 0xc4, 0x27, 0x19, 0x83, 0xd2, 0xa7, 0x1c, 0x6f, 0x5d,
+	0x94, 0x59, 0xc7, 0x98, 0xe2, 0xb8, 0x90, 0xb6, 0xce, 0xcb, 0x28, 0xc6, 0x09, 0x85, 0x1c, 0xd2,
+	0xdf, 0x2a, 0xd2, 0xef, 0xc7, 0x7c, 0x97, 0x11, 0xc7, 0x1c, 0xe4, 0x81, 0xf4, 0x37, 0x81, 0x19,
+	0xb7, 0x99, 0x1b, 0x80, 0x37, 0x89, 0xc0, 0x0e, 0x3d, 0x45, 0x6c, 0x8b, 0xdd, 0xaa, 0x79, 0xb6,
+	0x5d, 0x69, 0xcd, 0x39, 0x8e, 0xa3, 0x3b, 0xfd, 0x98, 0xd0, 0xad, 0x46, 0x3a, 0x1a, 0x15, 0x93,
+	0xa1, 0x27, 0x0f, 0xa4, 0x5f, 0xbb, 0xf7, 0x4c, 0xf9, 0xd1, 0xae, 0x74, 0xeb, 0xfd, 0x8e, 0x51,
+	0x72, 0xa5, 0xb1, 0xcb, 0x99, 0xd5, 0xc5, 0x4a, 0x13, 0xac, 0x7d, 0x52, 0xbe, 0x97, 0x6a, 0xf9,
+	0x4d, 0x4a, 0xa5, 0x2d, 0x76, 0xeb, 0x7d, 0xad, 0xd4, 0xf1, 0x94, 0x61, 0x85, 0xa1, 0x08, 0xc9,
+	0x2f, 0x92, 0x8c, 0xa9, 0x1b, 0x84, 0x53, 0xf0, 0xec, 0xfd, 0x3a, 0xd5, 0x6c, 0x9d, 0xeb, 0x52,
+	0xd5, 0x43, 0x11, 0xf9, 0x5a, 0xab, 0x93, 0x4a, 0xb7, 0x2b, 0xed, 0x34, 0xbf, 0xfe, 0xbb, 0x52,
+	0xb7, 0xfe, 0xe1, 0xa3, 0x10, 0x93, 0x89, 0xf4, 0x87, 0x42, 0x04, 0x98, 0x81, 0x4d, 0xc1, 0x25,
+	0xd4, 0x63, 0xca, 0xcf, 0xac, 0xf9, 0xaa, 0xb4, 0xd9, 0xca, 0x79, 0x2b, 0xc3, 0x4d, 0xb5, 0xa8,
+	0x3d, 0xc9, 0x6b, 0x8f, 0x64, 0xba, 0xd5, 0xa0, 0x87, 0x38, 0x33, 0x87, 0x8b, 0xb5, 0x2a, 0x2e,
+	0xd7, 0xaa, 0xf8, 0xb1, 0x56, 0xc5, 0xd7, 0x8d, 0x2a, 0x2c, 0x37, 0xaa, 0xf0, 0xbe, 0x51, 0x85,
+	0x67, 0xe4, 0x87, 0x3c, 0x98, 0x38, 0x86, 0x4b, 0x62, 0x94, 0x76, 0xdf, 0x64, 0x1f, 0xdf, 0x25,
+	0x11, 0x0a, 0x26, 0x0e, 0x9a, 0x1d, 0xfe, 0x24, 0x7c, 0x3e, 0x06, 0xe6, 0xd4, 0x32, 0xe0, 0xf6,
+	0x73, 0x00, 0x78, 0x21, 0x44, 0xdf, 0xc0, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +163,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReleaseRecords) > 0 {
+		for iNdEx := len(m.ReleaseRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ArchivedSchedules) > 0 {
+		for iNdEx := len(m.ArchivedSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Schedules) > 0 {
 		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +249,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ArchivedSchedules) > 0 {
+		for _, e := range m.ArchivedSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReleaseRecords) > 0 {
+		for _, e := range m.ReleaseRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -266,6 +354,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedSchedules = append(m.ArchivedSchedules, ArchivedSchedule{})
+			if err := m.ArchivedSchedules[len(m.ArchivedSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseRecords = append(m.ReleaseRecords, ReleaseRecord{})
+			if err := m.ReleaseRecords[len(m.ReleaseRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -21,6 +21,7 @@ func getMockGenesisState() types.GenesisState {
 				EndTime:        time.Unix(20000, 0),
 				TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(10000))),
 				ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(7500))),
+				Curve:          types.LinearCurve(),
 			},
 			{
 
@@ -29,8 +30,10 @@ func getMockGenesisState() types.GenesisState {
 				EndTime:        time.Unix(25000, 0),
 				TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(20000)), sdk.NewCoin("uastro", sdk.NewInt(30000))),
 				ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5000)), sdk.NewCoin("uastro", sdk.NewInt(7500))),
+				Curve:          types.LinearCurve(),
 			},
 		},
+		Params: types.DefaultParams(),
 	}
 }
 
@@ -70,8 +73,147 @@ func TestReleasedAmountGreaterThanTotal(t *testing.T) {
 	require.EqualError(t, gs.Validate(), "incentives schedule 3 total amount is not all greater or equal than released amount")
 }
 
+func TestBaseAmountGreaterThanReleased(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Schedules[1].BaseAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5001)))
+
+	require.EqualError(t, gs.Validate(), "incentives schedule 3 released amount is not all greater or equal than base amount")
+}
+
 func TestValidGenesis(t *testing.T) {
 	gs := getMockGenesisState()
 
 	require.NoError(t, gs.Validate())
 }
+
+func TestInvalidCurve(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Schedules[1].Curve = types.Curve{}
+
+	require.EqualError(t, gs.Validate(), "incentives schedule 3 has invalid curve: unknown curve type CURVE_TYPE_UNSPECIFIED: invalid incentives schedule curve")
+}
+
+func TestInvalidTarget(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Schedules[1].Target = &types.Target{Validators: []string{"larry"}}
+
+	require.ErrorContains(t, gs.Validate(), "incentives schedule 3 has invalid target")
+}
+
+func TestInvalidSponsor(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Schedules[1].Sponsor = "larry"
+
+	require.ErrorContains(t, gs.Validate(), "incentives schedule 3 has invalid sponsor")
+}
+
+func TestInvalidParams(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Params.AbsentRewardPolicy = types.AbsentRewardPolicyUnspecified
+
+	require.EqualError(t, gs.Validate(), "invalid absent reward policy: ABSENT_REWARD_POLICY_UNSPECIFIED")
+}
+
+func TestInvalidEpochParams(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Params.EpochDuration = 0
+
+	require.EqualError(t, gs.Validate(), "epoch duration must be positive: 0s")
+
+	gs = getMockGenesisState()
+	gs.Params.HistoryRetention = time.Hour
+
+	require.EqualError(t, gs.Validate(), "history retention must be either zero or no shorter than the epoch duration: 1h0m0s")
+
+	gs = getMockGenesisState()
+	gs.Params.HistoryRetention = 0
+
+	require.NoError(t, gs.Validate())
+}
+
+func TestInvalidArchivedSchedule(t *testing.T) {
+	archived := types.ArchivedSchedule{
+		Schedule: types.Schedule{
+			Id:             1,
+			StartTime:      time.Unix(5000, 0),
+			EndTime:        time.Unix(8000, 0),
+			TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3000))),
+			ReleasedAmount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3000))),
+			Curve:          types.LinearCurve(),
+		},
+		ArchivedTime: time.Unix(9000, 0),
+	}
+
+	gs := getMockGenesisState()
+	gs.ArchivedSchedules = []types.ArchivedSchedule{archived}
+
+	require.NoError(t, gs.Validate())
+
+	gs.ArchivedSchedules[0].Schedule.Id = 4
+
+	require.EqualError(t, gs.Validate(), "archived incentives schedule id 4 is not smaller than next schedule id 4")
+
+	gs.ArchivedSchedules[0].Schedule.Id = 2
+
+	require.EqualError(t, gs.Validate(), "archived incentives schedule has duplicate id 2")
+
+	gs.ArchivedSchedules[0].Schedule.Id = 1
+	gs.ArchivedSchedules[0].Schedule.ReleasedAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3001)))
+
+	require.EqualError(t, gs.Validate(), "archived incentives schedule 1 total amount is not all greater or equal than released amount")
+}
+
+func TestInvalidReleaseRecord(t *testing.T) {
+	record := types.ReleaseRecord{
+		ScheduleId:     2,
+		EpochStartTime: time.Unix(10800, 0),
+		EpochEndTime:   time.Unix(14400, 0),
+		Amount:         sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(3000))),
+	}
+
+	gs := getMockGenesisState()
+	gs.ReleaseRecords = []types.ReleaseRecord{record}
+
+	require.NoError(t, gs.Validate())
+
+	gs.ReleaseRecords[0].ScheduleId = 4
+
+	require.EqualError(t, gs.Validate(), "release record schedule id 4 is not smaller than next schedule id 4")
+
+	gs.ReleaseRecords = []types.ReleaseRecord{record, record}
+
+	require.ErrorContains(t, gs.Validate(), "duplicate release record for incentives schedule 2")
+
+	gs.ReleaseRecords = []types.ReleaseRecord{record}
+	gs.ReleaseRecords[0].EpochEndTime = record.EpochStartTime
+
+	require.EqualError(t, gs.Validate(), "release record of incentives schedule 2 epoch end time is not after start time")
+
+	gs.ReleaseRecords = []types.ReleaseRecord{record}
+	gs.ReleaseRecords[0].Amount = sdk.NewCoins()
+
+	require.EqualError(t, gs.Validate(), "release record of incentives schedule 2 has zero amount")
+}
+
+func TestInvalidRecipient(t *testing.T) {
+	gs := getMockGenesisState()
+	gs.Schedules[1].Recipient = &types.Recipient{Address: "larry", DeliveryMode: types.DeliveryModeBlock}
+
+	require.ErrorContains(t, gs.Validate(), "incentives schedule 3 has invalid recipient")
+
+	gs = getMockGenesisState()
+	gs.Schedules[1].Recipient = &types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeBlock}
+	gs.Schedules[1].Target = &types.Target{ExcludeJailed: true}
+
+	require.EqualError(t, gs.Validate(), "incentives schedule 3 has both a recipient and a target")
+
+	gs = getMockGenesisState()
+	gs.Schedules[1].Recipient = &types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeEpoch}
+	gs.Schedules[1].PendingAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5001)))
+
+	require.EqualError(t, gs.Validate(), "incentives schedule 3 released amount is not all greater or equal than pending amount")
+
+	gs.Schedules[1].PendingAmount = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5000)))
+
+	require.NoError(t, gs.Validate())
+}
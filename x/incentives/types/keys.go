@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the incentives module's name
@@ -21,12 +25,56 @@ const (
 //
 // - 0x00: uint64
 // - 0x01<uint64_bytes>: Schedule
+// - 0x02: Params
+// - 0x03<time_bytes><uint64_bytes>: ReleaseRecord
+// - 0x04<uint64_bytes>: ArchivedSchedule
+// - 0x05<uint64_bytes><time_bytes>: []byte{}
 var (
-	KeyNextScheduleID = []byte{0x00} // key for the the next schedule id
-	KeySchedule       = []byte{0x01} // key for the incentives schedules
+	KeyNextScheduleID   = []byte{0x00} // key for the the next schedule id
+	KeySchedule         = []byte{0x01} // key for the incentives schedules
+	KeyParams           = []byte{0x02} // key for the module's parameters
+	KeyReleaseRecord    = []byte{0x03} // key for the release records
+	KeyArchivedSchedule = []byte{0x04} // key for the archived schedules
+	KeyScheduleHistory  = []byte{0x05} // key for the index of release records by schedule
 )
 
 // GetScheduleKey creates the key for the incentives schedule of the given id
 func GetScheduleKey(id uint64) []byte {
 	return append(KeySchedule, sdk.Uint64ToBigEndian(id)...)
 }
+
+// GetReleaseRecordKey creates the key for the release record of the schedule
+// of the given id in the epoch starting at the given time.
+//
+// The records are keyed by epoch start time first, so that those of expired
+// epochs can be pruned by iterating from the start of the prefix.
+func GetReleaseRecordKey(epochStartTime time.Time, id uint64) []byte {
+	return append(GetReleaseRecordPrefix(epochStartTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetReleaseRecordPrefix creates the prefix of the keys of the release records
+// in the epoch starting at the given time
+func GetReleaseRecordPrefix(epochStartTime time.Time) []byte {
+	return append(KeyReleaseRecord, sdk.FormatTimeBytes(epochStartTime)...)
+}
+
+// GetScheduleHistoryKey creates the key indexing the release record of the
+// schedule of the given id in the epoch starting at the given time.
+//
+// The index is keyed by schedule id first, so that the history of a schedule
+// can be iterated without going through the records of the others.
+func GetScheduleHistoryKey(id uint64, epochStartTime time.Time) []byte {
+	return append(GetScheduleHistoryPrefix(id), sdk.FormatTimeBytes(epochStartTime)...)
+}
+
+// GetScheduleHistoryPrefix creates the prefix of the keys indexing the release
+// records of the schedule of the given id
+func GetScheduleHistoryPrefix(id uint64) []byte {
+	return append(KeyScheduleHistory, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetArchivedScheduleKey creates the key for the archived schedule of the
+// given id
+func GetArchivedScheduleKey(id uint64) []byte {
+	return append(KeyArchivedSchedule, sdk.Uint64ToBigEndian(id)...)
+}
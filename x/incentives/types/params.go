@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultParams returns the module's default parameters.
+func DefaultParams() Params {
+	return Params{
+		// rewarding absent validators is how the module has always behaved
+		AbsentRewardPolicy: AbsentRewardPolicyPay,
+		// SponsorMinAmounts is left empty, so sponsoring schedules is disabled
+		// until governance allows some denoms
+
+		// daily release totals, kept for 30 days
+		EpochDuration:    24 * time.Hour,
+		HistoryRetention: 30 * 24 * time.Hour,
+	}
+}
+
+// Validate validates the given instance of the module's parameters.
+func (p Params) Validate() error {
+	switch p.AbsentRewardPolicy {
+	case AbsentRewardPolicyPay, AbsentRewardPolicyRedistribute, AbsentRewardPolicyCommunityPool:
+	default:
+		return fmt.Errorf("invalid absent reward policy: %s", p.AbsentRewardPolicy)
+	}
+
+	// the coins must be valid (unique denoms, non-zero amount, and sorted
+	// alphabetically)
+	if err := p.SponsorMinAmounts.Validate(); err != nil {
+		return fmt.Errorf("invalid sponsor min amounts: %s", err)
+	}
+
+	if p.EpochDuration <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", p.EpochDuration)
+	}
+
+	// records are pruned once their epoch started longer than the retention
+	// ago, so a shorter retention would prune the current epoch's records
+	if p.HistoryRetention < 0 || (p.HistoryRetention > 0 && p.HistoryRetention < p.EpochDuration) {
+		return fmt.Errorf("history retention must be either zero or no shorter than the epoch duration: %s", p.HistoryRetention)
+	}
+
+	return nil
+}
+
+// GetEpoch returns the start and end times of the epoch containing the given
+// time. Epochs are aligned to multiples of the epoch duration since the zero
+// time, e.g. to midnight UTC for 24-hour epochs.
+func (p Params) GetEpoch(t time.Time) (startTime, endTime time.Time) {
+	startTime = t.UTC().Truncate(p.EpochDuration)
+	return startTime, startTime.Add(p.EpochDuration)
+}
@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mars/incentives/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AbsentRewardPolicy defines what happens to the block reward of validators in
+// the bonded set that didn't sign the previous block.
+type AbsentRewardPolicy int32
+
+const (
+	// ABSENT_REWARD_POLICY_UNSPECIFIED is the default value. It is not a valid
+	// policy.
+	AbsentRewardPolicyUnspecified AbsentRewardPolicy = 0
+	// ABSENT_REWARD_POLICY_PAY means absent validators are rewarded the same as
+	// those who signed, like cosmos-sdk's distribution module does. In other
+	// words, there is no "micro-slashing" for missing single blocks.
+	AbsentRewardPolicyPay AbsentRewardPolicy = 1
+	// ABSENT_REWARD_POLICY_REDISTRIBUTE means only validators who signed are
+	// rewarded; the share of absent validators is redistributed to them.
+	AbsentRewardPolicyRedistribute AbsentRewardPolicy = 2
+	// ABSENT_REWARD_POLICY_COMMUNITY_POOL means only validators who signed are
+	// rewarded; the share of absent validators is returned to the community
+	// pool, or to the sponsor of a sponsored schedule.
+	AbsentRewardPolicyCommunityPool AbsentRewardPolicy = 3
+)
+
+var AbsentRewardPolicy_name = map[int32]string{
+	0: "ABSENT_REWARD_POLICY_UNSPECIFIED",
+	1: "ABSENT_REWARD_POLICY_PAY",
+	2: "ABSENT_REWARD_POLICY_REDISTRIBUTE",
+	3: "ABSENT_REWARD_POLICY_COMMUNITY_POOL",
+}
+
+var AbsentRewardPolicy_value = map[string]int32{
+	"ABSENT_REWARD_POLICY_UNSPECIFIED":    0,
+	"ABSENT_REWARD_POLICY_PAY":            1,
+	"ABSENT_REWARD_POLICY_REDISTRIBUTE":   2,
+	"ABSENT_REWARD_POLICY_COMMUNITY_POOL": 3,
+}
+
+func (x AbsentRewardPolicy) String() string {
+	return proto.EnumName(AbsentRewardPolicy_name, int32(x))
+}
+
+func (AbsentRewardPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6ef822d8447cfff, []int{0}
+}
+
+// Params defines the parameters of the incentives module.
+type Params struct {
+	// AbsentRewardPolicy defines what happens to the block reward of validators
+	// that didn't sign the previous block.
+	AbsentRewardPolicy AbsentRewardPolicy `protobuf:"varint,1,opt,name=absent_reward_policy,json=absentRewardPolicy,proto3,enum=mars.incentives.v1beta1.AbsentRewardPolicy" json:"absent_reward_policy,omitempty" yaml:"absent_reward_policy"`
+	// SponsorMinAmounts is the denoms that incentives schedules can be sponsored
+	// in via Msg/SponsorSchedule, and the minimum amount of each. If empty,
+	// sponsoring schedules is disabled.
+	SponsorMinAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sponsor_min_amounts,json=sponsorMinAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sponsor_min_amounts" yaml:"sponsor_min_amounts"`
+	// EpochDuration is the length of the epochs over which the amounts released
+	// by each incentives schedule are totalled in release records, e.g. 24 hours
+	// for daily totals. Epochs are aligned to multiples of the duration since
+	// the zero time, e.g. to midnight UTC for 24 hours.
+	EpochDuration time.Duration `protobuf:"bytes,3,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration" yaml:"epoch_duration"`
+	// HistoryRetention is how long release records are kept after their epoch
+	// started. If zero, no release record is kept.
+	HistoryRetention time.Duration `protobuf:"bytes,4,opt,name=history_retention,json=historyRetention,proto3,stdduration" json:"history_retention" yaml:"history_retention"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6ef822d8447cfff, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAbsentRewardPolicy() AbsentRewardPolicy {
+	if m != nil {
+		return m.AbsentRewardPolicy
+	}
+	return AbsentRewardPolicyUnspecified
+}
+
+func (m *Params) GetSponsorMinAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SponsorMinAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetention() time.Duration {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("mars.incentives.v1beta1.AbsentRewardPolicy", AbsentRewardPolicy_name, AbsentRewardPolicy_value)
+	proto.RegisterType((*Params)(nil), "mars.incentives.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("mars/incentives/v1beta1/params.proto", fileDescriptor_a6ef822d8447cfff)
+}
+
+var fileDescriptor_a6ef822d8447cfff = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xa6, 0xea, 0x60, 0x44, 0x95, 0x9a, 0x56, 0xa4, 0x46, 0xd8, 0x6e, 0xda, 0xa1,
+	0x02, 0xd5, 0xa7, 0x96, 0x01, 0x89, 0x2d, 0xff, 0x40, 0x96, 0xda, 0xc4, 0x72, 0x13, 0xa1, 0xb2,
+	0x58, 0x67, 0xe7, 0x9a, 0x9c, 0xb0, 0xef, 0x2c, 0xdf, 0xb9, 0xe0, 0x85, 0x19, 0x65, 0x62, 0x84,
+	0x21, 0x13, 0x1b, 0x3b, 0xdf, 0xa1, 0x63, 0x47, 0xa6, 0x16, 0x35, 0xdf, 0xa0, 0x0b, 0x2b, 0xca,
+	0xd9, 0x29, 0x45, 0x0e, 0x62, 0xf2, 0xf9, 0xd5, 0xfb, 0x7b, 0x9e, 0xf7, 0x9e, 0xbb, 0x93, 0x77,
+	0x42, 0x18, 0x33, 0x80, 0x89, 0x8f, 0x08, 0xc7, 0x67, 0x88, 0x81, 0xb3, 0x7d, 0x0f, 0x71, 0xb8,
+	0x0f, 0x22, 0x18, 0xc3, 0x90, 0x99, 0x51, 0x4c, 0x39, 0x55, 0x1e, 0xce, 0xba, 0xcc, 0x3f, 0x5d,
+	0x66, 0xde, 0xa5, 0x6a, 0x3e, 0x65, 0x21, 0x65, 0xc0, 0x83, 0x0c, 0xdd, 0xa2, 0x3e, 0xc5, 0x24,
+	0x03, 0xd5, 0xf5, 0x21, 0x1d, 0x52, 0xb1, 0x04, 0xb3, 0x55, 0x5e, 0xd5, 0x86, 0x94, 0x0e, 0x03,
+	0x04, 0xc4, 0x9f, 0x97, 0x9c, 0x82, 0x41, 0x12, 0x43, 0x8e, 0x69, 0x4e, 0xd5, 0x7e, 0x95, 0xe5,
+	0x15, 0x5b, 0xf8, 0x2b, 0x1f, 0xe4, 0x75, 0xe8, 0x31, 0x44, 0xb8, 0x1b, 0xa3, 0x77, 0x30, 0x1e,
+	0xb8, 0x11, 0x0d, 0xb0, 0x9f, 0x56, 0x25, 0x43, 0xda, 0x5d, 0x3d, 0x78, 0x6a, 0xfe, 0x63, 0x30,
+	0xb3, 0x2e, 0x20, 0x47, 0x30, 0xb6, 0x40, 0x1a, 0xfa, 0xcd, 0xa5, 0xfe, 0x28, 0x85, 0x61, 0xf0,
+	0xa2, 0xb6, 0x48, 0xb2, 0xe6, 0x28, 0xb0, 0x00, 0x29, 0x5f, 0x24, 0xf9, 0x01, 0x8b, 0x28, 0x61,
+	0x34, 0x76, 0x43, 0x4c, 0x5c, 0x18, 0xd2, 0x84, 0x70, 0x56, 0x5d, 0x32, 0xca, 0xbb, 0xf7, 0x0e,
+	0x36, 0xcd, 0x6c, 0xff, 0xe6, 0x6c, 0xff, 0xb7, 0xde, 0x4d, 0x8a, 0x49, 0xa3, 0x73, 0x7e, 0xa9,
+	0x97, 0x6e, 0x2e, 0x75, 0x35, 0x73, 0x5c, 0xa0, 0x51, 0xfb, 0x76, 0xa5, 0xef, 0x0e, 0x31, 0x1f,
+	0x25, 0x9e, 0xe9, 0xd3, 0x10, 0xe4, 0x51, 0x66, 0x9f, 0x3d, 0x36, 0x78, 0x0b, 0x78, 0x1a, 0x21,
+	0x26, 0xe4, 0x98, 0xb3, 0x96, 0x2b, 0x1c, 0x61, 0x52, 0xcf, 0x78, 0xc5, 0x97, 0x57, 0x51, 0x44,
+	0xfd, 0x91, 0x3b, 0x8f, 0xaf, 0x5a, 0x36, 0x24, 0x31, 0x55, 0x96, 0xaf, 0x39, 0xcf, 0xd7, 0x6c,
+	0xe5, 0x0d, 0x8d, 0xad, 0x7c, 0xaa, 0x8d, 0x6c, 0xaa, 0xbf, 0xf1, 0xda, 0xe7, 0x2b, 0x5d, 0x72,
+	0xee, 0x8b, 0xe2, 0x9c, 0x50, 0x02, 0x79, 0x6d, 0x84, 0x19, 0xa7, 0x71, 0xea, 0xc6, 0x88, 0xcf,
+	0x62, 0xa6, 0xa4, 0xba, 0xfc, 0x3f, 0x9f, 0x9d, 0xdc, 0xa7, 0x9a, 0xf9, 0x14, 0x14, 0x32, 0xab,
+	0x4a, 0x5e, 0x77, 0xe6, 0xe5, 0x27, 0xdf, 0x97, 0x64, 0xa5, 0x78, 0x74, 0xca, 0x2b, 0xd9, 0xa8,
+	0x37, 0x8e, 0xdb, 0x9d, 0x9e, 0xeb, 0xb4, 0x5f, 0xd7, 0x9d, 0x96, 0x6b, 0x77, 0x0f, 0xad, 0xe6,
+	0x89, 0xdb, 0xef, 0x1c, 0xdb, 0xed, 0xa6, 0xf5, 0xd2, 0x6a, 0xb7, 0x2a, 0x25, 0x75, 0x6b, 0x3c,
+	0x31, 0x1e, 0x17, 0xe9, 0x3e, 0x61, 0x11, 0xf2, 0xf1, 0x29, 0x46, 0x03, 0xe5, 0xb9, 0x5c, 0x5d,
+	0x28, 0x64, 0xd7, 0x4f, 0x2a, 0x92, 0xba, 0x39, 0x9e, 0x18, 0x1b, 0x45, 0x01, 0x1b, 0xa6, 0x8a,
+	0x25, 0x6f, 0x2d, 0x04, 0x9d, 0x76, 0xcb, 0x3a, 0xee, 0x39, 0x56, 0xa3, 0xdf, 0x6b, 0x57, 0x96,
+	0xd4, 0xda, 0x78, 0x62, 0x68, 0x45, 0x05, 0x07, 0x0d, 0x30, 0xe3, 0x31, 0xf6, 0x12, 0x8e, 0x94,
+	0x43, 0x79, 0x7b, 0xa1, 0x54, 0xb3, 0x7b, 0x74, 0xd4, 0xef, 0x58, 0xbd, 0x13, 0xd7, 0xee, 0x76,
+	0x0f, 0x2b, 0x65, 0x75, 0x7b, 0x3c, 0x31, 0xf4, 0xa2, 0x58, 0x93, 0x86, 0x61, 0x42, 0x30, 0x4f,
+	0x6d, 0x4a, 0x03, 0x75, 0xf9, 0xe3, 0x57, 0xad, 0xd4, 0xb0, 0xce, 0xaf, 0x35, 0xe9, 0xe2, 0x5a,
+	0x93, 0x7e, 0x5e, 0x6b, 0xd2, 0xa7, 0xa9, 0x56, 0xba, 0x98, 0x6a, 0xa5, 0x1f, 0x53, 0xad, 0xf4,
+	0x06, 0xdc, 0xb9, 0x61, 0xb3, 0xc7, 0xb2, 0x27, 0x0e, 0xcb, 0xa7, 0x01, 0x18, 0x25, 0x1e, 0x78,
+	0x7f, 0xf7, 0xe9, 0x8b, 0xeb, 0xe6, 0xad, 0x88, 0x86, 0x67, 0xbf, 0x07, 0x00, 0xce, 0xaa, 0xb6,
+	0xf5, 0x1a, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HistoryRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SponsorMinAmounts) > 0 {
+		for iNdEx := len(m.SponsorMinAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorMinAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AbsentRewardPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AbsentRewardPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AbsentRewardPolicy != 0 {
+		n += 1 + sovParams(uint64(m.AbsentRewardPolicy))
+	}
+	if len(m.SponsorMinAmounts) > 0 {
+		for _, e := range m.SponsorMinAmounts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HistoryRetention)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsentRewardPolicy", wireType)
+			}
+			m.AbsentRewardPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbsentRewardPolicy |= AbsentRewardPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorMinAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorMinAmounts = append(m.SponsorMinAmounts, types.Coin{})
+			if err := m.SponsorMinAmounts[len(m.SponsorMinAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,23 +6,31 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -209,11 +217,406 @@ func (m *QuerySchedulesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	// Params is the module's parameters
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method
+//
+// The projection window starts at the current block time, and ends either at
+// the given end time, or after the given number of blocks of the given average
+// block time.
+type QueryProjectionRequest struct {
+	// EndTime is the end of the projection window. Mutually exclusive with
+	// Blocks and BlockTime.
+	EndTime *time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// Blocks is the length of the projection window in blocks.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// BlockTime is the average block time used to convert Blocks into a
+	// duration.
+	BlockTime *time.Duration `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time,omitempty" yaml:"block_time"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{6}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+// ScheduleProjection is the amount of coins an incentives schedule will
+// release during the projection window
+type ScheduleProjection struct {
+	// Id is the identifier of the incentives schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Amount is the amount of coins the schedule will release
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ScheduleProjection) Reset()         { *m = ScheduleProjection{} }
+func (m *ScheduleProjection) String() string { return proto.CompactTextString(m) }
+func (*ScheduleProjection) ProtoMessage()    {}
+func (*ScheduleProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{7}
+}
+func (m *ScheduleProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleProjection.Merge(m, src)
+}
+func (m *ScheduleProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleProjection proto.InternalMessageInfo
+
+func (m *ScheduleProjection) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduleProjection) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method
+type QueryProjectionResponse struct {
+	// EndTime is the end of the projection window
+	EndTime time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// Schedules is the amount each active incentives schedule will release
+	Schedules []ScheduleProjection `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+	// Total is the amount all active incentives schedules will release, per
+	// denom
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// BondedTokens is the current amount of bonded stake
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+	// RewardPerBondedToken is the total divided by the bonded tokens, i.e. an
+	// estimate of the reward per unit of bonded stake, assuming the bonded
+	// tokens stay the same.
+	//
+	// Schedules with a recipient don't reward stakers, so they are not included
+	// in the estimate.
+	//
+	// NOTE: the estimate doesn't account for validator commissions, schedule
+	// targets or the absent reward policy, which change how the reward is split
+	// between stakers.
+	RewardPerBondedToken github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=reward_per_bonded_token,json=rewardPerBondedToken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_bonded_token" yaml:"reward_per_bonded_token"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{8}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryProjectionResponse) GetSchedules() []ScheduleProjection {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryProjectionResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *QueryProjectionResponse) GetRewardPerBondedToken() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerBondedToken
+	}
+	return nil
+}
+
+// QueryScheduleHistoryRequest is the request type for the Query/ScheduleHistory
+// RPC method
+type QueryScheduleHistoryRequest struct {
+	// ID is the identifier of the incentives schedule to be queried
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Time, if set, restricts the release records to that of the epoch
+	// containing this time, e.g. any time during day D for a 24-hour epoch. The
+	// pagination is then ignored, as there is at most one such record.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleHistoryRequest) Reset()         { *m = QueryScheduleHistoryRequest{} }
+func (m *QueryScheduleHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleHistoryRequest) ProtoMessage()    {}
+func (*QueryScheduleHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{9}
+}
+func (m *QueryScheduleHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleHistoryRequest.Merge(m, src)
+}
+func (m *QueryScheduleHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleHistoryRequest proto.InternalMessageInfo
+
+// QueryScheduleHistoryResponse is the response type for the
+// Query/ScheduleHistory RPC method
+type QueryScheduleHistoryResponse struct {
+	// ArchivedSchedule is the archive of the incentives schedule, if it has been
+	// fully released or terminated
+	ArchivedSchedule *ArchivedSchedule `protobuf:"bytes,1,opt,name=archived_schedule,json=archivedSchedule,proto3" json:"archived_schedule,omitempty" yaml:"archived_schedule"`
+	// ReleaseRecords is the amounts the schedule released per epoch, in order of
+	// the epochs
+	ReleaseRecords []ReleaseRecord `protobuf:"bytes,2,rep,name=release_records,json=releaseRecords,proto3" json:"release_records" yaml:"release_records"`
+	// Pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleHistoryResponse) Reset()         { *m = QueryScheduleHistoryResponse{} }
+func (m *QueryScheduleHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleHistoryResponse) ProtoMessage()    {}
+func (*QueryScheduleHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ec2e0b7bd49dfc, []int{10}
+}
+func (m *QueryScheduleHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleHistoryResponse.Merge(m, src)
+}
+func (m *QueryScheduleHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleHistoryResponse) GetArchivedSchedule() *ArchivedSchedule {
+	if m != nil {
+		return m.ArchivedSchedule
+	}
+	return nil
+}
+
+func (m *QueryScheduleHistoryResponse) GetReleaseRecords() []ReleaseRecord {
+	if m != nil {
+		return m.ReleaseRecords
+	}
+	return nil
+}
+
+func (m *QueryScheduleHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryScheduleRequest)(nil), "mars.incentives.v1beta1.QueryScheduleRequest")
 	proto.RegisterType((*QueryScheduleResponse)(nil), "mars.incentives.v1beta1.QueryScheduleResponse")
 	proto.RegisterType((*QuerySchedulesRequest)(nil), "mars.incentives.v1beta1.QuerySchedulesRequest")
 	proto.RegisterType((*QuerySchedulesResponse)(nil), "mars.incentives.v1beta1.QuerySchedulesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "mars.incentives.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mars.incentives.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "mars.incentives.v1beta1.QueryProjectionRequest")
+	proto.RegisterType((*ScheduleProjection)(nil), "mars.incentives.v1beta1.ScheduleProjection")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "mars.incentives.v1beta1.QueryProjectionResponse")
+	proto.RegisterType((*QueryScheduleHistoryRequest)(nil), "mars.incentives.v1beta1.QueryScheduleHistoryRequest")
+	proto.RegisterType((*QueryScheduleHistoryResponse)(nil), "mars.incentives.v1beta1.QueryScheduleHistoryResponse")
 }
 
 func init() {
@@ -221,36 +624,75 @@ func init() {
 }
 
 var fileDescriptor_e4ec2e0b7bd49dfc = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0x70, 0xa0, 0x9e, 0x91, 0x18, 0xac, 0x03, 0x4e, 0x11, 0x4a, 0x8f, 0x80, 0x8e,
-	0xd3, 0x49, 0xb5, 0x69, 0xd9, 0x18, 0x8b, 0x00, 0xb1, 0x41, 0xd8, 0x10, 0x8b, 0x93, 0x58, 0xa9,
-	0xa5, 0x36, 0x4e, 0x63, 0xa7, 0xa2, 0x42, 0x2c, 0x4c, 0x8c, 0x48, 0x2c, 0xb0, 0x75, 0xe4, 0x53,
-	0x30, 0x77, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xcb, 0xc0, 0xc7, 0x40, 0x71, 0x9c, 0x36, 0x54, 0x44,
-	0xed, 0x6d, 0x96, 0xfb, 0xff, 0xbd, 0xf7, 0x7b, 0xcf, 0x0d, 0xbc, 0x33, 0xa2, 0x99, 0x24, 0x3c,
-	0x09, 0x59, 0xa2, 0xf8, 0x84, 0x49, 0x32, 0xe9, 0x06, 0x4c, 0xd1, 0x2e, 0x19, 0xe7, 0x2c, 0x9b,
-	0xe2, 0x34, 0x13, 0x4a, 0xa0, 0x9b, 0x45, 0x08, 0x6f, 0x42, 0xd8, 0x84, 0x9c, 0xf3, 0x50, 0xc8,
-	0x91, 0x90, 0x24, 0xa0, 0x92, 0x95, 0xc4, 0x9a, 0x4f, 0x69, 0xcc, 0x13, 0xaa, 0xb8, 0x48, 0xca,
-	0x22, 0xce, 0x51, 0x2c, 0x62, 0xa1, 0x8f, 0xa4, 0x38, 0x99, 0xdb, 0x5b, 0xb1, 0x10, 0xf1, 0x90,
-	0x11, 0x9a, 0x72, 0x42, 0x93, 0x44, 0x28, 0x8d, 0x48, 0xf3, 0x6b, 0xa3, 0x9d, 0x54, 0x22, 0x63,
-	0x65, 0xc8, 0xbb, 0x0f, 0x8f, 0x5e, 0x14, 0xad, 0x5f, 0x86, 0x03, 0x16, 0xe5, 0x43, 0xe6, 0xb3,
-	0x71, 0xce, 0xa4, 0x42, 0xd7, 0xa0, 0xcd, 0xa3, 0x63, 0x70, 0x02, 0xce, 0x0e, 0x7c, 0x9b, 0x47,
-	0x0f, 0x5b, 0x1f, 0x66, 0x6d, 0xeb, 0xcf, 0xac, 0x6d, 0x79, 0xaf, 0xe1, 0xf5, 0x2d, 0x42, 0xa6,
-	0x22, 0x91, 0x0c, 0x3d, 0x82, 0x2d, 0x69, 0xee, 0x34, 0x78, 0xb5, 0x77, 0x1b, 0x37, 0xcc, 0x8e,
-	0x2b, 0xb8, 0x7f, 0x30, 0xff, 0xd9, 0xb6, 0xfc, 0x35, 0xe8, 0xf1, 0xad, 0xea, 0xb2, 0x12, 0x7a,
-	0x02, 0xe1, 0x66, 0x2b, 0xa6, 0xfe, 0x29, 0x2e, 0x57, 0x88, 0x8b, 0x15, 0xe2, 0x72, 0xe9, 0x55,
-	0x87, 0xe7, 0x34, 0xae, 0x86, 0xf1, 0x6b, 0x64, 0x6d, 0x90, 0xaf, 0x00, 0xde, 0xd8, 0xee, 0x65,
-	0x46, 0x79, 0x0c, 0x0f, 0x2b, 0x23, 0x79, 0x0c, 0x4e, 0x2e, 0x5d, 0x64, 0x96, 0x0d, 0x89, 0x9e,
-	0xfe, 0xe3, 0x6c, 0x6b, 0xe7, 0x7b, 0x3b, 0x9d, 0x4b, 0x87, 0xba, 0x74, 0xef, 0x9b, 0x0d, 0x2f,
-	0x6b, 0x55, 0xf4, 0x05, 0xc0, 0x56, 0xd5, 0x10, 0x75, 0x1a, 0x9d, 0xfe, 0xf7, 0xa6, 0x0e, 0xde,
-	0x37, 0x5e, 0x1a, 0x78, 0xf8, 0xfd, 0xf7, 0xdf, 0x9f, 0xec, 0x33, 0x74, 0x4a, 0x1a, 0xff, 0x49,
-	0x06, 0x21, 0x6f, 0x79, 0xf4, 0x0e, 0x7d, 0x06, 0xf0, 0x70, 0xbd, 0x4b, 0xb4, 0x67, 0xb7, 0xea,
-	0x81, 0x1d, 0xb2, 0x77, 0xde, 0xe8, 0x9d, 0x6b, 0xbd, 0xbb, 0xc8, 0xdb, 0xa9, 0x27, 0xfb, 0xcf,
-	0xe6, 0x4b, 0x17, 0x2c, 0x96, 0x2e, 0xf8, 0xb5, 0x74, 0xc1, 0xc7, 0x95, 0x6b, 0x2d, 0x56, 0xae,
-	0xf5, 0x63, 0xe5, 0x5a, 0xaf, 0x48, 0xcc, 0xd5, 0x20, 0x0f, 0x70, 0x28, 0x46, 0xba, 0x4e, 0x47,
-	0x7f, 0x17, 0xa1, 0x18, 0x92, 0x41, 0x1e, 0x90, 0x37, 0xf5, 0xb2, 0x6a, 0x9a, 0x32, 0x19, 0x5c,
-	0xd1, 0x81, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xb8, 0xb4, 0x6a, 0xfd, 0x03, 0x00,
-	0x00,
+	// 1076 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x49, 0x06, 0x68, 0xe8, 0x60, 0x12, 0xc7, 0x8d, 0x76, 0xd3, 0x6d, 0x49,
+	0x43, 0x43, 0x76, 0xd3, 0x52, 0x38, 0x54, 0xe2, 0x80, 0x5b, 0x7e, 0xe4, 0x02, 0x61, 0x13, 0x2e,
+	0x08, 0xc9, 0x1a, 0xef, 0x0e, 0xf6, 0x12, 0x7b, 0xc7, 0xdd, 0x19, 0x07, 0x2c, 0xc4, 0x85, 0x13,
+	0x48, 0x1c, 0x2a, 0x71, 0x29, 0x12, 0x87, 0x5e, 0x90, 0x50, 0xce, 0xc0, 0xdf, 0x50, 0x2e, 0xa8,
+	0x82, 0x0b, 0xe2, 0xe0, 0xa0, 0x84, 0x03, 0xe7, 0xfc, 0x05, 0x68, 0xe7, 0x87, 0xbd, 0x5e, 0x67,
+	0xeb, 0x0d, 0xca, 0xc9, 0xde, 0x99, 0xf7, 0xbd, 0xf7, 0xbd, 0xf7, 0xbe, 0x7d, 0x6f, 0xc1, 0x95,
+	0x0e, 0x0a, 0xa9, 0xed, 0x07, 0x2e, 0x0e, 0x98, 0xbf, 0x8f, 0xa9, 0xbd, 0x7f, 0xa3, 0x81, 0x19,
+	0xba, 0x61, 0xdf, 0xeb, 0xe1, 0xb0, 0x6f, 0x75, 0x43, 0xc2, 0x08, 0x5c, 0x8c, 0x8c, 0xac, 0x91,
+	0x91, 0x25, 0x8d, 0xaa, 0xd7, 0x5d, 0x42, 0x3b, 0x84, 0xda, 0x0d, 0x44, 0xb1, 0x40, 0x0c, 0xf1,
+	0x5d, 0xd4, 0xf4, 0x03, 0xc4, 0x7c, 0x12, 0x08, 0x27, 0x55, 0x3d, 0x6e, 0xab, 0xac, 0x5c, 0xe2,
+	0xab, 0xfb, 0x25, 0x71, 0x5f, 0xe7, 0x4f, 0xb6, 0x78, 0x90, 0x57, 0xe5, 0x26, 0x69, 0x12, 0x71,
+	0x1e, 0xfd, 0x93, 0xa7, 0xcb, 0x4d, 0x42, 0x9a, 0x6d, 0x6c, 0xa3, 0xae, 0x6f, 0xa3, 0x20, 0x20,
+	0x8c, 0x47, 0x53, 0x18, 0x5d, 0xde, 0xf2, 0xa7, 0x46, 0xef, 0x63, 0xdb, 0xeb, 0x85, 0x71, 0x3a,
+	0x46, 0xf2, 0x9e, 0xf9, 0x1d, 0x4c, 0x19, 0xea, 0x74, 0xa5, 0xc1, 0xd5, 0xb4, 0xca, 0x74, 0x51,
+	0x88, 0x3a, 0x2a, 0x4c, 0x6a, 0xfd, 0x28, 0x23, 0x21, 0x16, 0x46, 0xe6, 0x26, 0x28, 0xbf, 0x1f,
+	0x15, 0x67, 0xc7, 0x6d, 0x61, 0xaf, 0xd7, 0xc6, 0x0e, 0xbe, 0xd7, 0xc3, 0x94, 0xc1, 0x0b, 0x20,
+	0xef, 0x7b, 0x15, 0x6d, 0x45, 0x5b, 0x2b, 0x3a, 0x79, 0xdf, 0xbb, 0x3d, 0xfb, 0xd5, 0x43, 0x23,
+	0xf7, 0xef, 0x43, 0x23, 0x67, 0x7e, 0x04, 0x5e, 0x48, 0x20, 0x68, 0x97, 0x04, 0x14, 0xc3, 0x3b,
+	0x60, 0x96, 0xca, 0x33, 0x0e, 0x7c, 0xfa, 0xe6, 0x65, 0x2b, 0xa5, 0x3b, 0x96, 0x02, 0xd7, 0x8a,
+	0x8f, 0x06, 0x46, 0xce, 0x19, 0x02, 0x4d, 0x3f, 0xe1, 0x9d, 0x2a, 0x42, 0x6f, 0x01, 0x30, 0xea,
+	0x9b, 0xf4, 0xbf, 0x6a, 0xc9, 0x5e, 0x44, 0x8d, 0xb3, 0x84, 0x2c, 0x54, 0x84, 0x6d, 0xd4, 0x54,
+	0xc9, 0x38, 0x31, 0x64, 0x2c, 0x91, 0x1f, 0x35, 0xb0, 0x90, 0x8c, 0x25, 0x53, 0x79, 0x13, 0xcc,
+	0x29, 0x46, 0xb4, 0xa2, 0xad, 0x14, 0xce, 0x92, 0xcb, 0x08, 0x09, 0xdf, 0x1e, 0xe3, 0x9c, 0xe7,
+	0x9c, 0xaf, 0x4d, 0xe5, 0x2c, 0x38, 0xc4, 0x49, 0x9b, 0x65, 0x00, 0x39, 0xd3, 0x6d, 0xde, 0x5f,
+	0x99, 0x96, 0xb9, 0x0b, 0x9e, 0x1f, 0x3b, 0x95, 0xe4, 0x5f, 0x07, 0x25, 0xa1, 0x03, 0x59, 0x25,
+	0x23, 0x95, 0xb9, 0x00, 0x4a, 0xde, 0x12, 0x64, 0x1e, 0xaa, 0xb2, 0x6c, 0x87, 0xe4, 0x13, 0xec,
+	0x46, 0xf1, 0x55, 0x0f, 0xde, 0x05, 0xb3, 0x38, 0xf0, 0xea, 0x91, 0x1c, 0xa5, 0xef, 0xaa, 0x25,
+	0xb4, 0x6a, 0x29, 0xad, 0x5a, 0xbb, 0x4a, 0xab, 0xb5, 0xc5, 0x93, 0x81, 0x31, 0xdf, 0x47, 0x9d,
+	0xf6, 0x6d, 0x53, 0xa1, 0xcc, 0xfb, 0x87, 0x86, 0xe6, 0x3c, 0x85, 0x03, 0x2f, 0x32, 0x83, 0x0b,
+	0xa0, 0xd4, 0x68, 0x13, 0x77, 0x8f, 0xf2, 0xda, 0x14, 0x1d, 0xf9, 0x04, 0x77, 0x00, 0xe0, 0xff,
+	0x44, 0xa4, 0x02, 0x8f, 0xb4, 0x34, 0x11, 0xe9, 0xae, 0x7c, 0x6b, 0x6a, 0x4b, 0x27, 0x03, 0xe3,
+	0xa2, 0x08, 0x34, 0x82, 0x99, 0x0f, 0xa2, 0x50, 0x73, 0xfc, 0x20, 0x0a, 0x16, 0x6b, 0xfc, 0xd7,
+	0x1a, 0x80, 0xaa, 0x69, 0xa3, 0x24, 0x93, 0x92, 0x87, 0x2e, 0x28, 0xa1, 0x0e, 0xe9, 0x05, 0xac,
+	0x92, 0xe7, 0x0a, 0x58, 0x1a, 0xeb, 0x9c, 0xaa, 0xe1, 0x1d, 0xe2, 0x07, 0xb5, 0xcd, 0xa8, 0x82,
+	0x07, 0x87, 0xc6, 0x5a, 0xd3, 0x67, 0xad, 0x5e, 0xc3, 0x72, 0x49, 0x47, 0x8e, 0x09, 0xf9, 0xb3,
+	0x41, 0xbd, 0x3d, 0x9b, 0xf5, 0xbb, 0x98, 0x72, 0x00, 0x75, 0xa4, 0x6b, 0xf3, 0xd7, 0x22, 0x58,
+	0x9c, 0xa8, 0xb6, 0x6c, 0xa4, 0x73, 0xa6, 0x72, 0x5f, 0x8a, 0x38, 0x4c, 0x2d, 0xf9, 0x7b, 0x71,
+	0x65, 0x8b, 0xbc, 0xd6, 0xa7, 0x2a, 0x7b, 0xc4, 0x6d, 0x52, 0xe3, 0x08, 0xcc, 0x30, 0xc2, 0x50,
+	0xbb, 0x52, 0x38, 0xff, 0x22, 0x09, 0xcf, 0xb0, 0x0f, 0x9e, 0x6d, 0x90, 0xc0, 0xc3, 0x5e, 0x9d,
+	0x91, 0x3d, 0x1c, 0xd0, 0x4a, 0x71, 0x45, 0x5b, 0x9b, 0xab, 0xed, 0x46, 0xfe, 0xfe, 0x1a, 0x18,
+	0xab, 0x19, 0xfc, 0x6d, 0x05, 0xec, 0x64, 0x60, 0x94, 0xa5, 0x48, 0xe2, 0xce, 0xcc, 0xdf, 0x7f,
+	0xda, 0x00, 0x92, 0xf3, 0x56, 0xc0, 0x9c, 0x67, 0xc4, 0xed, 0x2e, 0xbf, 0x84, 0x07, 0x1a, 0x58,
+	0x0c, 0xf1, 0xa7, 0x28, 0xf4, 0xea, 0x5d, 0x1c, 0xd6, 0xe3, 0xc8, 0xca, 0x0c, 0x4f, 0x78, 0xf9,
+	0xd4, 0x84, 0xef, 0x62, 0x97, 0xe7, 0xfc, 0x81, 0x6c, 0x8a, 0x2e, 0x22, 0xa7, 0xb8, 0x32, 0x0f,
+	0x0e, 0x8d, 0xf5, 0x0c, 0x59, 0x48, 0xaf, 0xd4, 0x29, 0x0b, 0x47, 0xdb, 0x38, 0xac, 0x8d, 0xd8,
+	0x9a, 0x3f, 0x6b, 0xe0, 0xd2, 0xd8, 0x40, 0x7b, 0xc7, 0x8f, 0x46, 0x7d, 0x3f, 0x65, 0xa6, 0xc3,
+	0x5b, 0xa0, 0xc8, 0xb5, 0x95, 0x9f, 0xaa, 0xad, 0x22, 0x17, 0x11, 0xb7, 0x4e, 0x0c, 0xe2, 0xc2,
+	0x39, 0x0c, 0xe2, 0xdf, 0xf2, 0x60, 0xf9, 0x74, 0xde, 0xf2, 0x45, 0x60, 0xe0, 0x22, 0x0a, 0xdd,
+	0x96, 0xbf, 0x8f, 0xbd, 0x7a, 0x62, 0xc5, 0xbc, 0x94, 0x2a, 0xde, 0x37, 0x24, 0x62, 0x38, 0x9e,
+	0x97, 0x4f, 0x06, 0x46, 0x45, 0xf4, 0x61, 0xc2, 0x9b, 0xe9, 0x3c, 0x87, 0x12, 0xf6, 0x90, 0x80,
+	0xf9, 0x10, 0xb7, 0x31, 0xa2, 0xb8, 0x1e, 0x62, 0x97, 0x84, 0x9e, 0x7a, 0x61, 0x56, 0x53, 0x63,
+	0x3a, 0xc2, 0xde, 0xe1, 0xe6, 0x35, 0x5d, 0x36, 0x7f, 0x41, 0x35, 0x7f, 0xcc, 0x99, 0xe9, 0x5c,
+	0x08, 0xe3, 0xe6, 0xc9, 0x75, 0x51, 0xf8, 0xdf, 0xeb, 0xe2, 0xe6, 0x0f, 0x25, 0x30, 0xc3, 0x0b,
+	0x0a, 0xbf, 0xd3, 0xc0, 0xec, 0x30, 0xa1, 0x8d, 0x54, 0xde, 0xa7, 0x7d, 0x02, 0x54, 0xad, 0xac,
+	0xe6, 0x82, 0x81, 0x69, 0x7d, 0xf9, 0xc7, 0x3f, 0xdf, 0xe6, 0xd7, 0xe0, 0xaa, 0x9d, 0xfa, 0xe1,
+	0x21, 0x21, 0xf6, 0xe7, 0xbe, 0xf7, 0x05, 0x7c, 0xa0, 0x81, 0xb9, 0x9d, 0xe1, 0x1c, 0xc9, 0x18,
+	0x4d, 0x2d, 0xbf, 0xaa, 0x9d, 0xd9, 0x5e, 0xd2, 0xbb, 0xce, 0xe9, 0x5d, 0x85, 0xe6, 0x54, 0x7a,
+	0x14, 0x7e, 0xa3, 0x81, 0x92, 0x58, 0x8e, 0x70, 0xfd, 0xc9, 0x71, 0xc6, 0x36, 0x72, 0xf5, 0xe5,
+	0x6c, 0xc6, 0x92, 0xd1, 0x35, 0xce, 0xe8, 0x32, 0x34, 0xec, 0x27, 0x7f, 0xcf, 0xc1, 0xef, 0x35,
+	0x00, 0x62, 0x8b, 0x6a, 0x4a, 0xea, 0x13, 0x7b, 0xbb, 0xba, 0x99, 0x1d, 0x20, 0xa9, 0xad, 0x73,
+	0x6a, 0x2f, 0xc2, 0x2b, 0xe9, 0xd4, 0x46, 0x7c, 0x7e, 0xd1, 0xc0, 0x7c, 0xe2, 0xd5, 0x85, 0xb7,
+	0xb2, 0xb5, 0x67, 0x7c, 0x42, 0x55, 0x5f, 0x3d, 0x23, 0x4a, 0xb2, 0x7d, 0x8d, 0xb3, 0xdd, 0x84,
+	0x56, 0x36, 0xe5, 0xd9, 0x2d, 0x81, 0xaf, 0x6d, 0x3d, 0x3a, 0xd2, 0xb5, 0xc7, 0x47, 0xba, 0xf6,
+	0xf7, 0x91, 0xae, 0xdd, 0x3f, 0xd6, 0x73, 0x8f, 0x8f, 0xf5, 0xdc, 0x9f, 0xc7, 0x7a, 0xee, 0x43,
+	0x3b, 0x36, 0x8d, 0x23, 0x9f, 0x1b, 0x7c, 0x28, 0xba, 0xa4, 0x6d, 0xb7, 0x7a, 0x0d, 0xfb, 0xb3,
+	0x78, 0x08, 0x3e, 0x9a, 0x1b, 0x25, 0x6e, 0xf0, 0xca, 0x7f, 0x03, 0x00, 0xe0, 0x37, 0x94, 0x28,
+	0xb5, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -269,6 +711,16 @@ type QueryClient interface {
 	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
 	// Schedules queries all incentives schedules
 	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// Params queries the incentives module's parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Projection queries the amount of coins the active incentives schedules
+	// will release from now until a future time, and an estimate of the reward
+	// per unit of bonded stake
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
+	// ScheduleHistory queries the archive of a completed incentives schedule,
+	// and the amounts the schedule released per epoch within the history
+	// retention window
+	ScheduleHistory(ctx context.Context, in *QueryScheduleHistoryRequest, opts ...grpc.CallOption) (*QueryScheduleHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -297,12 +749,49 @@ func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest,
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduleHistory(ctx context.Context, in *QueryScheduleHistoryRequest, opts ...grpc.CallOption) (*QueryScheduleHistoryResponse, error) {
+	out := new(QueryScheduleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Query/ScheduleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Schedule queries an incentives schedule by identifier
 	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
 	// Schedules queries all incentives schedules
 	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// Params queries the incentives module's parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Projection queries the amount of coins the active incentives schedules
+	// will release from now until a future time, and an estimate of the reward
+	// per unit of bonded stake
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
+	// ScheduleHistory queries the archive of a completed incentives schedule,
+	// and the amounts the schedule released per epoch within the history
+	// retention window
+	ScheduleHistory(context.Context, *QueryScheduleHistoryRequest) (*QueryScheduleHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -315,6 +804,15 @@ func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QuerySchedul
 func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
+func (*UnimplementedQueryServer) ScheduleHistory(ctx context.Context, req *QueryScheduleHistoryRequest) (*QueryScheduleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -356,34 +854,100 @@ func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mars.incentives.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Schedule",
-			Handler:    _Query_Schedule_Handler,
-		},
-		{
-			MethodName: "Schedules",
-			Handler:    _Query_Schedules_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mars/incentives/v1beta1/query.proto",
-}
-
-func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Query/ScheduleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleHistory(ctx, req.(*QueryScheduleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mars.incentives.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "Schedules",
+			Handler:    _Query_Schedules_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
+		{
+			MethodName: "ScheduleHistory",
+			Handler:    _Query_ScheduleHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mars/incentives/v1beta1/query.proto",
+}
+
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -518,79 +1082,1183 @@ func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryScheduleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Schedule.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.BlockTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.BlockTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EndTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySchedulesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduleProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySchedulesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.RewardPerBondedToken) > 0 {
+		for iNdEx := len(m.RewardPerBondedToken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerBondedToken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Schedules) > 0 {
-		for _, e := range m.Schedules {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return n
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryScheduleHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryScheduleHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Time != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReleaseRecords) > 0 {
+		for iNdEx := len(m.ReleaseRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ArchivedSchedule != nil {
+		{
+			size, err := m.ArchivedSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.BlockTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.BlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RewardPerBondedToken) > 0 {
+		for _, e := range m.RewardPerBondedToken {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduleHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ArchivedSchedule != nil {
+		l = m.ArchivedSchedule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ReleaseRecords) > 0 {
+		for _, e := range m.ReleaseRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -613,10 +2281,10 @@ func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -638,6 +2306,40 @@ func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -659,7 +2361,7 @@ func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -682,15 +2384,15 @@ func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -717,7 +2419,143 @@ func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, ScheduleProjection{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBondedToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerBondedToken = append(m.RewardPerBondedToken, types.DecCoin{})
+			if err := m.RewardPerBondedToken[len(m.RewardPerBondedToken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -742,7 +2580,7 @@ func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -765,13 +2603,68 @@ func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySchedulesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -828,7 +2721,7 @@ func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -851,15 +2744,15 @@ func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySchedulesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -886,12 +2779,48 @@ func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedules = append(m.Schedules, Schedule{})
-			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ArchivedSchedule == nil {
+				m.ArchivedSchedule = &ArchivedSchedule{}
+			}
+			if err := m.ArchivedSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseRecords = append(m.ReleaseRecords, ReleaseRecord{})
+			if err := m.ReleaseRecords[len(m.ReleaseRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecipientSudoGasLimit is the amount of gas a recipient contract may consume
+// when handling the sudo call notifying it of a delivery. The call is made in
+// the BeginBlocker, where there is no gas limit otherwise.
+const RecipientSudoGasLimit uint64 = 1_000_000
+
+// Validate asserts that the recipient is valid:
+//
+//   - the address must be valid
+//   - the delivery mode must be specified
+func (r Recipient) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return ErrInvalidRecipient.Wrapf("invalid address `%s`: %s", r.Address, err)
+	}
+
+	switch r.DeliveryMode {
+	case DeliveryModeBlock, DeliveryModeEpoch:
+	default:
+		return ErrInvalidRecipient.Wrapf("unknown delivery mode %s", r.DeliveryMode)
+	}
+
+	return nil
+}
+
+// SudoMsg corresponding to the Rust enum that recipient contracts with sudo
+// notifications enabled must handle in their `sudo` entry point:
+//
+//	enum SudoMsg {
+//	    IncentivesReceived {
+//	        schedule_id: u64,
+//	        amount: Vec<Coin>,
+//	    },
+//	}
+//
+// NOTE: the contract may define other enum variants; they are not needed here.
+type SudoMsg struct {
+	IncentivesReceived *IncentivesReceivedMsg `json:"incentives_received,omitempty"`
+}
+
+// IncentivesReceivedMsg corresponding to the Rust enum variant
+// `SudoMsg::IncentivesReceived`. The coins have been sent to the contract
+// before the call is made.
+type IncentivesReceivedMsg struct {
+	ScheduleID uint64    `json:"schedule_id"`
+	Amount     sdk.Coins `json:"amount"`
+}
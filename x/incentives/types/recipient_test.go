@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+func TestValidateRecipient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		recipient types.Recipient
+		expPass   bool
+	}{
+		{
+			"delivered every block",
+			types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeBlock},
+			true,
+		},
+		{
+			"delivered every epoch with sudo calls",
+			types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeEpoch, Sudo: true},
+			true,
+		},
+		{
+			"invalid address",
+			types.Recipient{Address: "larry", DeliveryMode: types.DeliveryModeBlock},
+			false,
+		},
+		{
+			"unspecified delivery mode",
+			types.Recipient{Address: govModuleAccount},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recipient.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRecipient)
+			}
+		})
+	}
+}
+
+func TestSudoMsgJSON(t *testing.T) {
+	bz, err := json.Marshal(&types.SudoMsg{
+		IncentivesReceived: &types.IncentivesReceivedMsg{
+			ScheduleID: 1,
+			Amount:     sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345))),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"incentives_received":{"schedule_id":1,"amount":[{"denom":"umars","amount":"12345"}]}}`, string(bz))
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
 )
 
 func durationToSecondsDec(d time.Duration) sdk.Dec {
@@ -14,7 +16,7 @@ func durationToSecondsDec(d time.Duration) sdk.Dec {
 //   - if the current time is before the start time, no coin is to be released
 //   - if the current time is after the end time, all coins are to be released
 //   - if the current time is betweeen the start and end times, coins are to be
-//     released linearly
+//     released according to the schedule's curve
 func (s Schedule) GetBlockReward(currentTime time.Time) sdk.Coins {
 	if s.StartTime.After(currentTime) {
 		return sdk.NewCoins()
@@ -24,11 +26,12 @@ func (s Schedule) GetBlockReward(currentTime time.Time) sdk.Coins {
 		return s.TotalAmount.Sub(s.ReleasedAmount...)
 	}
 
-	timeTotal := durationToSecondsDec(s.EndTime.Sub(s.StartTime))
-	timeElapsed := durationToSecondsDec(currentTime.Sub(s.StartTime))
-
-	blockRewardDec := sdk.NewDecCoinsFromCoins(s.TotalAmount...).MulDec(timeElapsed).QuoDec(timeTotal)
-	blockReward, _ := blockRewardDec.TruncateDecimal()
+	// a schedule that has been re-based by an update releases the rest of its
+	// total amount on top of what it had released by then
+	unlocked := s.Curve.unlockedAmount(currentTime.Sub(s.StartTime), s.EndTime.Sub(s.StartTime), marsutils.SaturateSub(s.TotalAmount, s.BaseAmount))
+	unlocked = unlocked.Add(s.BaseAmount...)
 
-	return blockReward.Sub(s.ReleasedAmount...)
+	// the unlocked amount never decreases over time, but saturate anyway, so
+	// that a rounding error can't cause a panic in the BeginBlocker
+	return marsutils.SaturateSub(unlocked, s.ReleasedAmount)
 }
@@ -5,11 +5,13 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +31,89 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeliveryMode defines how often the rewards of an incentives schedule are
+// sent to its recipient
+type DeliveryMode int32
+
+const (
+	// DELIVERY_MODE_UNSPECIFIED is the default value. It is not a valid delivery
+	// mode.
+	DeliveryModeUnspecified DeliveryMode = 0
+	// DELIVERY_MODE_BLOCK sends the rewards to the recipient every block.
+	DeliveryModeBlock DeliveryMode = 1
+	// DELIVERY_MODE_EPOCH accrues the rewards, and sends them to the recipient
+	// once per epoch, as defined by the module's epoch duration param, to save
+	// gas.
+	DeliveryModeEpoch DeliveryMode = 2
+)
+
+var DeliveryMode_name = map[int32]string{
+	0: "DELIVERY_MODE_UNSPECIFIED",
+	1: "DELIVERY_MODE_BLOCK",
+	2: "DELIVERY_MODE_EPOCH",
+}
+
+var DeliveryMode_value = map[string]int32{
+	"DELIVERY_MODE_UNSPECIFIED": 0,
+	"DELIVERY_MODE_BLOCK":       1,
+	"DELIVERY_MODE_EPOCH":       2,
+}
+
+func (x DeliveryMode) String() string {
+	return proto.EnumName(DeliveryMode_name, int32(x))
+}
+
+func (DeliveryMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{0}
+}
+
+// CurveType is the shape of an incentives schedule's release curve
+type CurveType int32
+
+const (
+	// CURVE_TYPE_UNSPECIFIED is the default value. It is not a valid curve type.
+	CurveTypeUnspecified CurveType = 0
+	// CURVE_TYPE_LINEAR releases coins at a constant rate between the start and
+	// end times.
+	CurveTypeLinear CurveType = 1
+	// CURVE_TYPE_CLIFF_LINEAR releases nothing until the cliff has passed, at
+	// which point the coins that would have been released linearly so far are
+	// released at once. Afterwards coins are released linearly.
+	CurveTypeCliffLinear CurveType = 2
+	// CURVE_TYPE_STEP releases explicit amounts of coins at given offsets from
+	// the start time.
+	CurveTypeStep CurveType = 3
+	// CURVE_TYPE_EXPONENTIAL splits the schedule into periods, the release rate
+	// of each being that of the previous one multiplied by a decay factor, e.g.
+	// 0.5 for a halving every period. Within a period coins are released
+	// linearly.
+	CurveTypeExponential CurveType = 4
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_UNSPECIFIED",
+	1: "CURVE_TYPE_LINEAR",
+	2: "CURVE_TYPE_CLIFF_LINEAR",
+	3: "CURVE_TYPE_STEP",
+	4: "CURVE_TYPE_EXPONENTIAL",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_UNSPECIFIED":  0,
+	"CURVE_TYPE_LINEAR":       1,
+	"CURVE_TYPE_CLIFF_LINEAR": 2,
+	"CURVE_TYPE_STEP":         3,
+	"CURVE_TYPE_EXPONENTIAL":  4,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{1}
+}
+
 // Schedule defines the parameters of an incentives releasing schedule
 type Schedule struct {
 	// Id is the identifier of this incentives schedule
@@ -43,6 +128,33 @@ type Schedule struct {
 	// ReleasedAmount is the amount of coins that have already been released to
 	// the stakers as part of this incentives schedule
 	ReleasedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=released_amount,json=releasedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released_amount" yaml:"released_amount"`
+	// Curve defines how the total amount is released between the start and end
+	// times
+	Curve Curve `protobuf:"bytes,6,opt,name=curve,proto3" json:"curve"`
+	// Target restricts which validators are rewarded by this incentives
+	// schedule. If not set, all bonded validators are rewarded.
+	Target *Target `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	// Sponsor is the address of the account that funded this incentives schedule
+	// via Msg/SponsorSchedule, to which the unreleased coins are refunded if the
+	// schedule is terminated. Empty if the schedule was funded by the community
+	// pool.
+	Sponsor string `protobuf:"bytes,8,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// Recipient, if set, receives the rewards of this incentives schedule
+	// instead of the validators. Mutually exclusive with Target.
+	Recipient *Recipient `protobuf:"bytes,9,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// PendingAmount is the amount of coins that have been released, but not yet
+	// delivered to the recipient, either because they are batched until the end
+	// of the epoch, or because the delivery failed.
+	PendingAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=pending_amount,json=pendingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_amount" yaml:"pending_amount"`
+	// PendingSince is the block time at which the pending amount started to
+	// accrue, or at which its delivery last failed. Not set if there is no
+	// pending amount.
+	PendingSince *time.Time `protobuf:"bytes,11,opt,name=pending_since,json=pendingSince,proto3,stdtime" json:"pending_since,omitempty" yaml:"pending_since"`
+	// BaseAmount is the released amount at the time the schedule was last
+	// amended via Msg/UpdateSchedule, which reset its start time to then. The
+	// curve only releases the rest of the total amount from the start time on.
+	// Empty if the schedule was never amended after it started.
+	BaseAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=base_amount,json=baseAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_amount" yaml:"base_amount"`
 }
 
 func (m *Schedule) Reset()         { *m = Schedule{} }
@@ -113,162 +225,2340 @@ func (m *Schedule) GetReleasedAmount() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Schedule)(nil), "mars.incentives.v1beta1.Schedule")
+func (m *Schedule) GetCurve() Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return Curve{}
 }
 
-func init() {
-	proto.RegisterFile("mars/incentives/v1beta1/store.proto", fileDescriptor_e3cff25e394d7607)
+func (m *Schedule) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
 }
 
-var fileDescriptor_e3cff25e394d7607 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x8e, 0xda, 0x30,
-	0x00, 0x86, 0x63, 0xa0, 0x2d, 0x35, 0x15, 0xa8, 0x69, 0xd5, 0x52, 0xaa, 0x26, 0x28, 0x5d, 0xb2,
-	0x60, 0x0b, 0xba, 0x75, 0x2b, 0x1d, 0xaa, 0x76, 0x4c, 0x3b, 0x54, 0x5d, 0x90, 0x93, 0xb8, 0xc1,
-	0xba, 0x24, 0x46, 0xb1, 0x83, 0x8e, 0x07, 0xb8, 0xf5, 0xc4, 0x73, 0xdc, 0x93, 0x30, 0x32, 0xde,
-	0x04, 0x77, 0xf0, 0x06, 0xf7, 0x04, 0xa7, 0xd8, 0xc9, 0x81, 0x6e, 0x41, 0x37, 0x61, 0xac, 0xff,
-	0xfb, 0xf2, 0xeb, 0x97, 0xe1, 0xe7, 0x84, 0x64, 0x02, 0xb3, 0x34, 0xa0, 0xa9, 0x64, 0x73, 0x2a,
-	0xf0, 0x7c, 0xe8, 0x53, 0x49, 0x86, 0x58, 0x48, 0x9e, 0x51, 0x34, 0xcb, 0xb8, 0xe4, 0xe6, 0xfb,
-	0x22, 0x84, 0x0e, 0x21, 0x54, 0x86, 0x7a, 0x56, 0xc0, 0x45, 0xc2, 0x05, 0xf6, 0x89, 0xa0, 0x0f,
-	0x64, 0xc0, 0x59, 0xaa, 0xc1, 0xde, 0xdb, 0x88, 0x47, 0x5c, 0x1d, 0x71, 0x71, 0x2a, 0x6f, 0xed,
-	0x88, 0xf3, 0x28, 0xa6, 0x58, 0xfd, 0xf3, 0xf3, 0xff, 0x58, 0xb2, 0x84, 0x0a, 0x49, 0x92, 0x99,
-	0x0e, 0x38, 0xb7, 0x75, 0xd8, 0xfc, 0x1d, 0x4c, 0x69, 0x98, 0xc7, 0xd4, 0x6c, 0xc3, 0x1a, 0x0b,
-	0xbb, 0xa0, 0x0f, 0xdc, 0x86, 0x57, 0x63, 0xa1, 0xf9, 0x17, 0x42, 0x21, 0x49, 0x26, 0x27, 0x05,
-	0xd5, 0xad, 0xf5, 0x81, 0xdb, 0x1a, 0xf5, 0x90, 0x56, 0xa2, 0x4a, 0x89, 0xfe, 0x54, 0xca, 0xf1,
-	0xa7, 0xd5, 0xc6, 0x36, 0xee, 0x36, 0xf6, 0xeb, 0x05, 0x49, 0xe2, 0xaf, 0xce, 0x81, 0x75, 0x96,
-	0x5b, 0x1b, 0x78, 0x2f, 0xd5, 0x45, 0x11, 0x37, 0x3d, 0xd8, 0xa4, 0x69, 0xa8, 0xbd, 0xf5, 0x93,
-	0xde, 0x8f, 0xa5, 0xb7, 0xa3, 0xbd, 0x15, 0xa9, 0xad, 0x2f, 0x68, 0x1a, 0x2a, 0xe7, 0x05, 0x80,
-	0xaf, 0x24, 0x97, 0x24, 0x9e, 0x90, 0x84, 0xe7, 0xa9, 0xec, 0x36, 0xfa, 0x75, 0xb7, 0x35, 0xfa,
-	0x80, 0xf4, 0x72, 0xa8, 0x58, 0xae, 0x9a, 0x13, 0x7d, 0xe7, 0x2c, 0x1d, 0xff, 0x28, 0xbd, 0x6f,
-	0xb4, 0xf7, 0x18, 0x76, 0xae, 0xb6, 0xb6, 0x1b, 0x31, 0x39, 0xcd, 0x7d, 0x14, 0xf0, 0x04, 0x97,
-	0xeb, 0xeb, 0x9f, 0x81, 0x08, 0xcf, 0xb0, 0x5c, 0xcc, 0xa8, 0x50, 0x1e, 0xe1, 0xb5, 0x14, 0xfa,
-	0x4d, 0x91, 0xe6, 0x25, 0x80, 0x9d, 0x8c, 0xc6, 0x94, 0x08, 0x1a, 0x56, 0x55, 0x9e, 0x9d, 0xaa,
-	0xf2, 0xab, 0xac, 0xf2, 0x4e, 0x57, 0x79, 0xc4, 0x3f, 0xad, 0x4d, 0xbb, 0xa2, 0x75, 0xa1, 0xf1,
-	0xcf, 0xd5, 0xce, 0x02, 0xeb, 0x9d, 0x05, 0x6e, 0x76, 0x16, 0x58, 0xee, 0x2d, 0x63, 0xbd, 0xb7,
-	0x8c, 0xeb, 0xbd, 0x65, 0xfc, 0xc3, 0x47, 0xce, 0xe2, 0xe1, 0x0d, 0xd4, 0xf8, 0x01, 0x8f, 0xf1,
-	0x34, 0xf7, 0xf1, 0xf9, 0xf1, 0x63, 0x55, 0x1f, 0xf0, 0x9f, 0xab, 0xc0, 0x97, 0xfb, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xba, 0x41, 0xf3, 0xcb, 0xcc, 0x02, 0x00, 0x00,
+func (m *Schedule) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
 }
 
-func (m *Schedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Schedule) GetRecipient() *Recipient {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Schedule) GetPendingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingAmount
+	}
+	return nil
 }
 
-func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReleasedAmount) > 0 {
-		for iNdEx := len(m.ReleasedAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReleasedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (m *Schedule) GetPendingSince() *time.Time {
+	if m != nil {
+		return m.PendingSince
+	}
+	return nil
+}
+
+func (m *Schedule) GetBaseAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseAmount
+	}
+	return nil
+}
+
+// Target restricts the validators rewarded by an incentives schedule, e.g. to
+// those running relayers for the outposts
+//
+// The schedule's block reward is split between the eligible validators pro-rata
+// to their voting power. If no validator is eligible, or all of them have hit
+// the share cap, what can't be allocated is returned to the community pool, or
+// to the sponsor of a sponsored schedule.
+type Target struct {
+	// Validators is the operator addresses of the validators eligible for the
+	// schedule's rewards. If empty, all bonded validators are eligible.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// ExcludeJailed excludes validators that are jailed.
+	ExcludeJailed bool `protobuf:"varint,2,opt,name=exclude_jailed,json=excludeJailed,proto3" json:"exclude_jailed,omitempty" yaml:"exclude_jailed"`
+	// MaxCommissionRate, if set, excludes validators whose commission rate is
+	// higher.
+	MaxCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate,omitempty" yaml:"max_commission_rate"`
+	// MaxValidatorShare, if set, caps the share of the schedule's block reward
+	// that a single validator may receive. The excess is redistributed between
+	// the other eligible validators.
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty" yaml:"max_validator_share"`
+}
+
+func (m *Target) Reset()         { *m = Target{} }
+func (m *Target) String() string { return proto.CompactTextString(m) }
+func (*Target) ProtoMessage()    {}
+func (*Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{1}
+}
+func (m *Target) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Target.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Target.Merge(m, src)
+}
+func (m *Target) XXX_Size() int {
+	return m.Size()
+}
+func (m *Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Target proto.InternalMessageInfo
+
+func (m *Target) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *Target) GetExcludeJailed() bool {
+	if m != nil {
+		return m.ExcludeJailed
+	}
+	return false
+}
+
+// Recipient routes the rewards of an incentives schedule to an account, e.g.
+// a CosmWasm contract incentivizing liquidity providers, instead of the
+// validators
+type Recipient struct {
+	// Address is the account receiving the rewards
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// DeliveryMode defines how often the rewards are sent to the recipient
+	DeliveryMode DeliveryMode `protobuf:"varint,2,opt,name=delivery_mode,json=deliveryMode,proto3,enum=mars.incentives.v1beta1.DeliveryMode" json:"delivery_mode,omitempty" yaml:"delivery_mode"`
+	// Sudo, if true, notifies the recipient, which must be a CosmWasm contract,
+	// of each delivery with a sudo call carrying the amount delivered. If the
+	// call fails, the delivery is reverted and retried later.
+	Sudo bool `protobuf:"varint,3,opt,name=sudo,proto3" json:"sudo,omitempty"`
+}
+
+func (m *Recipient) Reset()         { *m = Recipient{} }
+func (m *Recipient) String() string { return proto.CompactTextString(m) }
+func (*Recipient) ProtoMessage()    {}
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{2}
+}
+func (m *Recipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recipient.Merge(m, src)
+}
+func (m *Recipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recipient proto.InternalMessageInfo
+
+func (m *Recipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Recipient) GetDeliveryMode() DeliveryMode {
+	if m != nil {
+		return m.DeliveryMode
+	}
+	return DeliveryModeUnspecified
+}
+
+func (m *Recipient) GetSudo() bool {
+	if m != nil {
+		return m.Sudo
+	}
+	return false
+}
+
+// Curve defines how the coins of an incentives schedule are released over time
+//
+// Only the fields relevant to the curve's type may be set.
+type Curve struct {
+	// Type is the shape of the curve
+	Type CurveType `protobuf:"varint,1,opt,name=type,proto3,enum=mars.incentives.v1beta1.CurveType" json:"type,omitempty"`
+	// Cliff is the duration after the start time during which no coin is
+	// released. Only for CURVE_TYPE_CLIFF_LINEAR.
+	Cliff *time.Duration `protobuf:"bytes,2,opt,name=cliff,proto3,stdduration" json:"cliff,omitempty"`
+	// Steps is the releases of a step-wise curve, in order of their offsets.
+	// Their amounts must add up to the schedule's total amount. Only for
+	// CURVE_TYPE_STEP.
+	Steps []Step `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps"`
+	// Period is the duration over which the release rate is constant. Only for
+	// CURVE_TYPE_EXPONENTIAL.
+	Period *time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// DecayFactor is the ratio between the release rates of two consecutive
+	// periods, strictly between zero and one. Only for CURVE_TYPE_EXPONENTIAL.
+	DecayFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor,omitempty" yaml:"decay_factor"`
+}
+
+func (m *Curve) Reset()         { *m = Curve{} }
+func (m *Curve) String() string { return proto.CompactTextString(m) }
+func (*Curve) ProtoMessage()    {}
+func (*Curve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{3}
+}
+func (m *Curve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Curve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Curve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Curve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Curve.Merge(m, src)
+}
+func (m *Curve) XXX_Size() int {
+	return m.Size()
+}
+func (m *Curve) XXX_DiscardUnknown() {
+	xxx_messageInfo_Curve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Curve proto.InternalMessageInfo
+
+func (m *Curve) GetType() CurveType {
+	if m != nil {
+		return m.Type
+	}
+	return CurveTypeUnspecified
+}
+
+func (m *Curve) GetCliff() *time.Duration {
+	if m != nil {
+		return m.Cliff
+	}
+	return nil
+}
+
+func (m *Curve) GetSteps() []Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *Curve) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+// Step is a single release of a step-wise incentives schedule
+type Step struct {
+	// Offset is the duration after the start time at which the coins are
+	// released
+	Offset time.Duration `protobuf:"bytes,1,opt,name=offset,proto3,stdduration" json:"offset"`
+	// Amount is the coins released at this step
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Step) Reset()         { *m = Step{} }
+func (m *Step) String() string { return proto.CompactTextString(m) }
+func (*Step) ProtoMessage()    {}
+func (*Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{4}
+}
+func (m *Step) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Step.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Step.Merge(m, src)
+}
+func (m *Step) XXX_Size() int {
+	return m.Size()
+}
+func (m *Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_Step.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Step proto.InternalMessageInfo
+
+func (m *Step) GetOffset() time.Duration {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Step) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ArchivedSchedule is an incentives schedule that has been fully released or
+// terminated, and thus removed from the active schedules
+type ArchivedSchedule struct {
+	// Schedule is the incentives schedule as it was when it was archived. Its
+	// released amount is the final amount the schedule released.
+	Schedule Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// ArchivedTime is the block time at which the schedule was archived
+	ArchivedTime time.Time `protobuf:"bytes,2,opt,name=archived_time,json=archivedTime,proto3,stdtime" json:"archived_time" yaml:"archived_time"`
+	// Terminated is whether the schedule was terminated by governance before it
+	// was fully released
+	Terminated bool `protobuf:"varint,3,opt,name=terminated,proto3" json:"terminated,omitempty"`
+}
+
+func (m *ArchivedSchedule) Reset()         { *m = ArchivedSchedule{} }
+func (m *ArchivedSchedule) String() string { return proto.CompactTextString(m) }
+func (*ArchivedSchedule) ProtoMessage()    {}
+func (*ArchivedSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{5}
+}
+func (m *ArchivedSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedSchedule.Merge(m, src)
+}
+func (m *ArchivedSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedSchedule proto.InternalMessageInfo
+
+func (m *ArchivedSchedule) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+func (m *ArchivedSchedule) GetArchivedTime() time.Time {
+	if m != nil {
+		return m.ArchivedTime
+	}
+	return time.Time{}
+}
+
+func (m *ArchivedSchedule) GetTerminated() bool {
+	if m != nil {
+		return m.Terminated
+	}
+	return false
+}
+
+// ReleaseRecord is the amount of coins an incentives schedule released during
+// an epoch
+type ReleaseRecord struct {
+	// ScheduleId is the identifier of the incentives schedule
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty" yaml:"schedule_id"`
+	// EpochStartTime is the start of the epoch, inclusive
+	EpochStartTime time.Time `protobuf:"bytes,2,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time" yaml:"epoch_start_time"`
+	// EpochEndTime is the end of the epoch, exclusive
+	EpochEndTime time.Time `protobuf:"bytes,3,opt,name=epoch_end_time,json=epochEndTime,proto3,stdtime" json:"epoch_end_time" yaml:"epoch_end_time"`
+	// Amount is the amount of coins the schedule released during the epoch
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ReleaseRecord) Reset()         { *m = ReleaseRecord{} }
+func (m *ReleaseRecord) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRecord) ProtoMessage()    {}
+func (*ReleaseRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3cff25e394d7607, []int{6}
+}
+func (m *ReleaseRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRecord.Merge(m, src)
+}
+func (m *ReleaseRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRecord proto.InternalMessageInfo
+
+func (m *ReleaseRecord) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *ReleaseRecord) GetEpochStartTime() time.Time {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseRecord) GetEpochEndTime() time.Time {
+	if m != nil {
+		return m.EpochEndTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("mars.incentives.v1beta1.DeliveryMode", DeliveryMode_name, DeliveryMode_value)
+	proto.RegisterEnum("mars.incentives.v1beta1.CurveType", CurveType_name, CurveType_value)
+	proto.RegisterType((*Schedule)(nil), "mars.incentives.v1beta1.Schedule")
+	proto.RegisterType((*Target)(nil), "mars.incentives.v1beta1.Target")
+	proto.RegisterType((*Recipient)(nil), "mars.incentives.v1beta1.Recipient")
+	proto.RegisterType((*Curve)(nil), "mars.incentives.v1beta1.Curve")
+	proto.RegisterType((*Step)(nil), "mars.incentives.v1beta1.Step")
+	proto.RegisterType((*ArchivedSchedule)(nil), "mars.incentives.v1beta1.ArchivedSchedule")
+	proto.RegisterType((*ReleaseRecord)(nil), "mars.incentives.v1beta1.ReleaseRecord")
+}
+
+func init() {
+	proto.RegisterFile("mars/incentives/v1beta1/store.proto", fileDescriptor_e3cff25e394d7607)
+}
+
+var fileDescriptor_e3cff25e394d7607 = []byte{
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0x3a, 0x4e, 0xb0, 0xc7, 0x89, 0xe3, 0x4c, 0x02, 0xd9, 0x98, 0x62, 0x1b, 0xa3, 0xa2,
+	0x08, 0x29, 0x76, 0x49, 0x4b, 0x69, 0xe9, 0x85, 0xd8, 0xde, 0xb4, 0xa6, 0x21, 0x89, 0xd6, 0x21,
+	0x82, 0x4a, 0xd5, 0x6a, 0xb2, 0x33, 0x76, 0xb6, 0xd8, 0x3b, 0xd6, 0xce, 0x38, 0x4a, 0xae, 0x95,
+	0x5a, 0xa1, 0x54, 0xaa, 0x38, 0x55, 0xbd, 0xe4, 0xd4, 0x4b, 0xd5, 0x53, 0x0f, 0x5c, 0x7a, 0xe9,
+	0x99, 0x23, 0xe2, 0x54, 0x71, 0x08, 0x15, 0xfc, 0x03, 0xee, 0x95, 0xaa, 0x9d, 0x99, 0xb5, 0xd7,
+	0x86, 0x60, 0xac, 0xaa, 0x27, 0xef, 0xbc, 0xf9, 0xbe, 0x6f, 0xde, 0x7b, 0xf3, 0xe6, 0xcd, 0x18,
+	0x5c, 0x6a, 0x21, 0x8f, 0x15, 0x1d, 0xd7, 0x26, 0x2e, 0x77, 0xf6, 0x09, 0x2b, 0xee, 0x5f, 0xdd,
+	0x25, 0x1c, 0x5d, 0x2d, 0x32, 0x4e, 0x3d, 0x52, 0x68, 0x7b, 0x94, 0x53, 0xb8, 0xe0, 0x83, 0x0a,
+	0x3d, 0x50, 0x41, 0x81, 0xd2, 0x19, 0x9b, 0xb2, 0x16, 0x65, 0xc5, 0x5d, 0xc4, 0x48, 0x97, 0x69,
+	0x53, 0xc7, 0x95, 0xc4, 0xf4, 0xa2, 0x9c, 0xb7, 0xc4, 0xa8, 0x28, 0x07, 0x6a, 0x6a, 0xbe, 0x41,
+	0x1b, 0x54, 0xda, 0xfd, 0x2f, 0x65, 0xcd, 0x34, 0x28, 0x6d, 0x34, 0x49, 0x51, 0x8c, 0x76, 0x3b,
+	0xf5, 0x22, 0xee, 0x78, 0x88, 0x3b, 0x34, 0x10, 0xcc, 0x0e, 0xce, 0x73, 0xa7, 0x45, 0x18, 0x47,
+	0xad, 0xb6, 0x04, 0xe4, 0xff, 0x8c, 0x81, 0x58, 0xcd, 0xde, 0x23, 0xb8, 0xd3, 0x24, 0x30, 0x09,
+	0x22, 0x0e, 0xd6, 0xb5, 0x9c, 0xb6, 0x14, 0x35, 0x23, 0x0e, 0x86, 0x77, 0x01, 0x60, 0x1c, 0x79,
+	0xdc, 0xf2, 0x59, 0x7a, 0x24, 0xa7, 0x2d, 0x25, 0x56, 0xd2, 0x05, 0x29, 0x59, 0x08, 0x24, 0x0b,
+	0xdb, 0x81, 0x64, 0xe9, 0xc2, 0xe3, 0x93, 0xec, 0xd8, 0xab, 0x93, 0xec, 0xec, 0x21, 0x6a, 0x35,
+	0x6f, 0xe4, 0x7b, 0xdc, 0xfc, 0xc3, 0xe7, 0x59, 0xcd, 0x8c, 0x0b, 0x83, 0x0f, 0x87, 0x26, 0x88,
+	0x11, 0x17, 0x4b, 0xdd, 0xf1, 0xa1, 0xba, 0xe7, 0x95, 0xee, 0x8c, 0xd4, 0x0d, 0x98, 0x52, 0xf5,
+	0x0c, 0x71, 0xb1, 0xd0, 0xfc, 0x4e, 0x03, 0x53, 0x9c, 0x72, 0xd4, 0xb4, 0x50, 0x8b, 0x76, 0x5c,
+	0xae, 0x47, 0x73, 0xe3, 0x4b, 0x89, 0x95, 0xc5, 0x82, 0xca, 0xa3, 0x9f, 0xf4, 0x60, 0x27, 0x0a,
+	0x65, 0xea, 0xb8, 0xa5, 0xcf, 0x95, 0xee, 0x9c, 0xd4, 0x0d, 0x93, 0xf3, 0xbf, 0x3d, 0xcf, 0x2e,
+	0x35, 0x1c, 0xbe, 0xd7, 0xd9, 0x2d, 0xd8, 0xb4, 0xa5, 0xf6, 0x42, 0xfd, 0x2c, 0x33, 0x7c, 0xbf,
+	0xc8, 0x0f, 0xdb, 0x84, 0x09, 0x1d, 0x66, 0x26, 0x04, 0x75, 0x55, 0x30, 0xe1, 0x8f, 0x1a, 0x98,
+	0xf1, 0x48, 0x93, 0x20, 0x46, 0x70, 0xe0, 0xca, 0xc4, 0x30, 0x57, 0x6e, 0x29, 0x57, 0xce, 0x49,
+	0x57, 0x06, 0xf8, 0xa3, 0x79, 0x93, 0x0c, 0xd8, 0xca, 0xa1, 0x1b, 0x60, 0xc2, 0xee, 0x78, 0xfb,
+	0x44, 0x9f, 0x14, 0x99, 0xce, 0x14, 0x4e, 0x29, 0xcf, 0x42, 0xd9, 0x47, 0x95, 0xa2, 0xbe, 0x2b,
+	0xa6, 0xa4, 0xc0, 0xeb, 0x60, 0x92, 0x23, 0xaf, 0x41, 0xb8, 0x7e, 0x46, 0x90, 0xb3, 0xa7, 0x92,
+	0xb7, 0x05, 0xcc, 0x54, 0x70, 0xb8, 0x02, 0xce, 0xb0, 0x36, 0x75, 0x19, 0xf5, 0xf4, 0x58, 0x4e,
+	0x5b, 0x8a, 0x97, 0xf4, 0xa7, 0x8f, 0x96, 0xe7, 0x55, 0xfc, 0xab, 0x18, 0x7b, 0x84, 0xb1, 0x1a,
+	0xf7, 0x1c, 0xb7, 0x61, 0x06, 0x40, 0x78, 0x13, 0xc4, 0x3d, 0x62, 0x3b, 0x6d, 0x87, 0xb8, 0x5c,
+	0x8f, 0x8b, 0xf5, 0xf2, 0xa7, 0xae, 0x67, 0x06, 0x48, 0xb3, 0x47, 0x82, 0x3f, 0x68, 0x20, 0xd9,
+	0x26, 0x2e, 0x76, 0xdc, 0x46, 0x90, 0x7a, 0x30, 0x2c, 0xf5, 0x55, 0x95, 0xfa, 0xb3, 0x32, 0xf5,
+	0xfd, 0xf4, 0xd1, 0x32, 0x3f, 0xad, 0xc8, 0x2a, 0xf1, 0x5f, 0x83, 0xc0, 0x60, 0x31, 0x3f, 0x02,
+	0x3d, 0x31, 0xb4, 0xd4, 0xdf, 0x7b, 0x75, 0x92, 0x9d, 0xef, 0x77, 0x44, 0x50, 0x65, 0xad, 0x4f,
+	0x29, 0x5b, 0xcd, 0x37, 0xc1, 0x6f, 0x35, 0x90, 0xf0, 0xc3, 0x09, 0x22, 0x9d, 0x1a, 0x16, 0xe9,
+	0x9a, 0x8a, 0x14, 0xca, 0x05, 0x42, 0xdc, 0xd1, 0xc2, 0x04, 0x3e, 0x53, 0xc6, 0x98, 0xff, 0x69,
+	0x1c, 0x4c, 0xca, 0xad, 0x87, 0x9f, 0x00, 0xb0, 0x8f, 0x9a, 0x0e, 0x46, 0x9c, 0x7a, 0x4c, 0xd7,
+	0x72, 0xe3, 0x6f, 0xdd, 0xf5, 0x10, 0x16, 0xde, 0x04, 0x49, 0x72, 0x60, 0x37, 0x3b, 0x98, 0x58,
+	0xdf, 0x20, 0xa7, 0x49, 0xb0, 0x68, 0x36, 0xb1, 0xd2, 0x62, 0x6f, 0x5b, 0xfa, 0xe7, 0xf3, 0xe6,
+	0xb4, 0x32, 0xdc, 0x12, 0x63, 0xf8, 0xbd, 0x06, 0xe6, 0x5a, 0xe8, 0xc0, 0xb2, 0x69, 0xab, 0xe5,
+	0x30, 0xe6, 0x50, 0xd7, 0xf2, 0x10, 0x97, 0xcd, 0x25, 0x5e, 0xda, 0x79, 0x76, 0x92, 0xbd, 0xfc,
+	0x0e, 0xe1, 0x55, 0x88, 0xfd, 0xea, 0x24, 0x9b, 0x96, 0x2b, 0xbe, 0x41, 0x2e, 0xff, 0xf4, 0xd1,
+	0x32, 0x50, 0xd1, 0x54, 0x88, 0x6d, 0xce, 0xb6, 0xd0, 0x41, 0xb9, 0x0b, 0x31, 0x11, 0x27, 0x5d,
+	0x47, 0xba, 0xd1, 0x59, 0x6c, 0x0f, 0x79, 0x44, 0x8f, 0xfe, 0x37, 0x47, 0x06, 0xe4, 0xde, 0xe4,
+	0xc8, 0x4e, 0x00, 0xa9, 0x09, 0xc4, 0x1f, 0x1a, 0x88, 0x77, 0xcf, 0x88, 0x7f, 0x1c, 0x91, 0x4c,
+	0xbf, 0xe8, 0xef, 0x6f, 0x3d, 0x8e, 0x0a, 0x08, 0x31, 0x98, 0xc6, 0xa4, 0xe9, 0xec, 0x13, 0xef,
+	0xd0, 0x6a, 0x51, 0x2c, 0x6f, 0x80, 0xe4, 0xca, 0xfb, 0xa7, 0x1e, 0xc9, 0x8a, 0x42, 0xdf, 0xa6,
+	0x98, 0x94, 0xf4, 0x5e, 0x25, 0xf7, 0xa9, 0xe4, 0xcd, 0x29, 0x1c, 0xc2, 0x41, 0x08, 0xa2, 0xac,
+	0x83, 0xa9, 0xd8, 0xa9, 0x98, 0x29, 0xbe, 0xf3, 0x27, 0x11, 0x30, 0x21, 0x9a, 0x11, 0xfc, 0x18,
+	0x44, 0xfd, 0x8c, 0x08, 0xa7, 0x93, 0x6f, 0xe9, 0x06, 0x02, 0xbd, 0x7d, 0xd8, 0x26, 0xa6, 0xc0,
+	0xc3, 0x6b, 0x60, 0xc2, 0x6e, 0x3a, 0xf5, 0xba, 0xba, 0xb5, 0x16, 0x5f, 0x3b, 0x72, 0x15, 0x75,
+	0x51, 0x96, 0xa2, 0x3f, 0xfb, 0x27, 0x4b, 0xa2, 0xe1, 0xa7, 0x60, 0x82, 0x71, 0xd2, 0x66, 0xfa,
+	0xb8, 0x38, 0x4b, 0x17, 0x4e, 0x5d, 0xaf, 0xc6, 0x49, 0x3b, 0xe8, 0x94, 0x82, 0xe1, 0x77, 0xca,
+	0x36, 0xf1, 0x1c, 0x8a, 0xf5, 0xe8, 0xbb, 0x2d, 0xa9, 0xe0, 0xd0, 0x03, 0x53, 0x98, 0xd8, 0xe8,
+	0xd0, 0xaa, 0x23, 0x9b, 0x53, 0x4f, 0x9f, 0x10, 0xfb, 0xb3, 0x39, 0x52, 0xa5, 0xcc, 0x05, 0x89,
+	0xee, 0xe9, 0x0c, 0x96, 0x48, 0x42, 0x4c, 0xae, 0xc9, 0xb9, 0x5f, 0x35, 0x10, 0xf5, 0x43, 0x80,
+	0x9f, 0x81, 0x49, 0x5a, 0xaf, 0x33, 0xc2, 0x75, 0x6d, 0x98, 0xd7, 0x31, 0x3f, 0x5a, 0xe9, 0xb9,
+	0xa4, 0x40, 0x1b, 0x4c, 0xaa, 0xd6, 0x13, 0x19, 0xd6, 0x7a, 0x3e, 0xf0, 0xc9, 0x23, 0x35, 0x19,
+	0x25, 0x9d, 0x7f, 0xa6, 0x81, 0xd4, 0xaa, 0x67, 0xef, 0x39, 0xfb, 0x04, 0x77, 0x5f, 0x2a, 0x65,
+	0x10, 0x63, 0xea, 0x5b, 0x39, 0x7e, 0xf1, 0xf4, 0xad, 0x52, 0x40, 0xb5, 0x5d, 0x5d, 0x22, 0x44,
+	0x60, 0x1a, 0x29, 0xe1, 0x77, 0x7d, 0xe1, 0xe4, 0x54, 0x07, 0x55, 0x85, 0xdd, 0x47, 0x57, 0x2d,
+	0x3a, 0xb0, 0xf9, 0x24, 0x98, 0x01, 0x80, 0x13, 0xaf, 0xe5, 0xb8, 0x88, 0x13, 0xac, 0x4a, 0x3c,
+	0x64, 0xc9, 0xff, 0x13, 0x01, 0xd3, 0xa6, 0xbc, 0xad, 0x4d, 0x62, 0x53, 0x0f, 0xc3, 0xeb, 0x20,
+	0x11, 0x38, 0x68, 0x05, 0x8f, 0xb1, 0xd2, 0xb9, 0x5e, 0xd3, 0x0e, 0x4d, 0xe6, 0x4d, 0x10, 0x8c,
+	0xaa, 0x18, 0x3a, 0x20, 0x45, 0xda, 0xd4, 0xde, 0xb3, 0x46, 0x7a, 0xb2, 0x5d, 0x52, 0x01, 0x2d,
+	0x48, 0xf5, 0x41, 0x05, 0x19, 0x53, 0x52, 0x98, 0x6b, 0xdd, 0xd7, 0x9b, 0x0d, 0xa4, 0xc5, 0x1a,
+	0xe1, 0x0d, 0x77, 0xb1, 0xff, 0x96, 0xed, 0xe7, 0xab, 0xd4, 0x09, 0xa3, 0xa1, 0x9e, 0x73, 0xbd,
+	0xe2, 0x8a, 0xfe, 0x6f, 0xc5, 0x75, 0xe5, 0x77, 0x0d, 0x4c, 0x85, 0xbb, 0x16, 0xbc, 0x01, 0x16,
+	0x2b, 0xc6, 0x7a, 0x75, 0xc7, 0x30, 0xef, 0x59, 0xb7, 0x37, 0x2b, 0x86, 0x75, 0x67, 0xa3, 0xb6,
+	0x65, 0x94, 0xab, 0x6b, 0x55, 0xa3, 0x92, 0x1a, 0x4b, 0x9f, 0x3f, 0x3a, 0xce, 0x2d, 0x84, 0x09,
+	0x77, 0x5c, 0xd6, 0x26, 0xb6, 0x53, 0x77, 0x08, 0x86, 0x05, 0x30, 0xd7, 0xcf, 0x2d, 0xad, 0x6f,
+	0x96, 0xbf, 0x4c, 0x69, 0xe9, 0xb3, 0x47, 0xc7, 0xb9, 0xd9, 0xbe, 0xe6, 0xd8, 0xa4, 0xf6, 0xfd,
+	0xd7, 0xf1, 0xc6, 0xd6, 0x66, 0xf9, 0x8b, 0x54, 0xe4, 0x75, 0xbc, 0xe1, 0x27, 0x26, 0x1d, 0x7d,
+	0xf0, 0x4b, 0x66, 0xec, 0xca, 0x83, 0x08, 0x88, 0x77, 0xbb, 0x1d, 0xfc, 0x08, 0x9c, 0x2b, 0xdf,
+	0x31, 0x77, 0x0c, 0x6b, 0xfb, 0xde, 0xd6, 0xa0, 0xb3, 0xfa, 0xd1, 0x71, 0x6e, 0xbe, 0x0b, 0x0d,
+	0x7b, 0x7a, 0x05, 0xcc, 0x86, 0x58, 0xeb, 0xd5, 0x0d, 0x63, 0xd5, 0x4c, 0x69, 0xe9, 0xb9, 0xa3,
+	0xe3, 0xdc, 0x4c, 0x97, 0xb0, 0xee, 0xb8, 0x04, 0x79, 0xf0, 0x1a, 0x58, 0x08, 0x61, 0xcb, 0xeb,
+	0xd5, 0xb5, 0xb5, 0x80, 0x11, 0x19, 0x58, 0xa2, 0xec, 0xf7, 0x50, 0x45, 0xbb, 0x0c, 0x66, 0x42,
+	0xb4, 0xda, 0xb6, 0xb1, 0x95, 0x1a, 0x4f, 0xcf, 0x1e, 0x1d, 0xe7, 0xa6, 0xbb, 0x70, 0xd1, 0x80,
+	0xfa, 0x03, 0x30, 0xee, 0x6e, 0x6d, 0x6e, 0x18, 0x1b, 0xdb, 0xd5, 0xd5, 0xf5, 0x54, 0x74, 0x40,
+	0xdd, 0x38, 0x68, 0x53, 0xd7, 0x3f, 0xdc, 0xa8, 0x29, 0x53, 0x51, 0xaa, 0x3e, 0x7e, 0x91, 0xd1,
+	0x9e, 0xbc, 0xc8, 0x68, 0x7f, 0xbf, 0xc8, 0x68, 0x0f, 0x5f, 0x66, 0xc6, 0x9e, 0xbc, 0xcc, 0x8c,
+	0xfd, 0xf5, 0x32, 0x33, 0xf6, 0x55, 0x31, 0x54, 0x09, 0x7e, 0x5f, 0x58, 0x16, 0x15, 0x69, 0xd3,
+	0x66, 0x71, 0xaf, 0xb3, 0x5b, 0x3c, 0x08, 0xff, 0x81, 0x13, 0x65, 0xb1, 0x3b, 0x29, 0x00, 0x1f,
+	0xfe, 0x3b, 0x00, 0x95, 0xa7, 0x67, 0xe4, 0xe0, 0x0d, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseAmount) > 0 {
+		for iNdEx := len(m.BaseAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PendingSince != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PendingSince, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PendingSince):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintStore(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PendingAmount) > 0 {
+		for iNdEx := len(m.PendingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Recipient != nil {
+		{
+			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Curve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReleasedAmount) > 0 {
+		for iNdEx := len(m.ReleasedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleasedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Target) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Target) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Target) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxCommissionRate != nil {
+		{
+			size := m.MaxCommissionRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExcludeJailed {
+		i--
+		if m.ExcludeJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sudo {
+		i--
+		if m.Sudo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeliveryMode != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.DeliveryMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Curve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Curve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Curve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecayFactor != nil {
+		{
+			size := m.DecayFactor.Size()
+			i -= size
+			if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Period != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintStore(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Cliff != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Cliff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Cliff):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintStore(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Step) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Step) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Step) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Offset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStore(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Terminated {
+		i--
+		if m.Terminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ArchivedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStore(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochEndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStore(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStore(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if m.ScheduleId != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStore(uint64(m.Id))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStore(uint64(l))
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if len(m.ReleasedAmount) > 0 {
+		for _, e := range m.ReleasedAmount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	l = m.Curve.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = m.Recipient.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.PendingAmount) > 0 {
+		for _, e := range m.PendingAmount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.PendingSince != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PendingSince)
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.BaseAmount) > 0 {
+		for _, e := range m.BaseAmount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Target) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.ExcludeJailed {
+		n += 2
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *Recipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.DeliveryMode != 0 {
+		n += 1 + sovStore(uint64(m.DeliveryMode))
+	}
+	if m.Sudo {
+		n += 2
+	}
+	return n
+}
+
+func (m *Curve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovStore(uint64(m.Type))
+	}
+	if m.Cliff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Cliff)
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Period != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.DecayFactor != nil {
+		l = m.DecayFactor.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *Step) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset)
+	n += 1 + l + sovStore(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchivedSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.Terminated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReleaseRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovStore(uint64(m.ScheduleId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochEndTime)
+	n += 1 + l + sovStore(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedAmount = append(m.ReleasedAmount, types.Coin{})
+			if err := m.ReleasedAmount[len(m.ReleasedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Curve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recipient == nil {
+				m.Recipient = &Recipient{}
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAmount = append(m.PendingAmount, types.Coin{})
+			if err := m.PendingAmount[len(m.PendingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingSince == nil {
+				m.PendingSince = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PendingSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAmount = append(m.BaseAmount, types.Coin{})
+			if err := m.BaseAmount[len(m.BaseAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Target) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Target: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Target: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeJailed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryMode", wireType)
+			}
+			m.DeliveryMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryMode |= DeliveryMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sudo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Curve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Curve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Curve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cliff == nil {
+				m.Cliff = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, Step{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DecayFactor = &v
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Step) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Step: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Step: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TotalAmount) > 0 {
-		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Offset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintStore(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintStore(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Schedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovStore(uint64(m.Id))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovStore(uint64(l))
-	if len(m.TotalAmount) > 0 {
-		for _, e := range m.TotalAmount {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
-		}
-	}
-	if len(m.ReleasedAmount) > 0 {
-		for _, e := range m.ReleasedAmount {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Schedule) Unmarshal(dAtA []byte) error {
+func (m *ArchivedSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -291,17 +2581,17 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -311,14 +2601,28 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -345,13 +2649,102 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ArchivedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -378,13 +2771,13 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -411,14 +2804,13 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAmount = append(m.TotalAmount, types.Coin{})
-			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -445,8 +2837,8 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleasedAmount = append(m.ReleasedAmount, types.Coin{})
-			if err := m.ReleasedAmount[len(m.ReleasedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
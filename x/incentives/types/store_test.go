@@ -17,6 +17,7 @@ var mockSchedule = types.Schedule{
 	EndTime:        time.Unix(20000, 0),
 	TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
 	ReleasedAmount: sdk.NewCoins(),
+	Curve:          types.LinearCurve(),
 }
 
 func TestGetBlockRewardBeforeStart(t *testing.T) {
@@ -47,3 +48,103 @@ func TestGetBlockRewardBetweenStartAndEnd(t *testing.T) {
 	expected = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6952)), sdk.NewCoin("uastro", sdk.NewInt(39091)))
 	require.Equal(t, expected, blockReward)
 }
+
+func TestGetBlockRewardCliffLinear(t *testing.T) {
+	cliff := 2500 * time.Second
+	schedule := types.Schedule{
+		Id:             1,
+		StartTime:      time.Unix(10000, 0),
+		EndTime:        time.Unix(20000, 0),
+		TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
+		ReleasedAmount: sdk.NewCoins(),
+		Curve:          types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &cliff},
+	}
+
+	// nothing is released before the cliff
+	blockReward := schedule.GetBlockReward(time.Unix(12499, 0))
+	require.Empty(t, blockReward)
+
+	// once the cliff has passed, the amount that would have been released
+	// linearly is released at once
+	blockReward = schedule.GetBlockReward(time.Unix(13333, 0))
+	expected := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(4114)), sdk.NewCoin("uastro", sdk.NewInt(23137)))
+	require.Equal(t, expected, blockReward)
+}
+
+func TestGetBlockRewardStep(t *testing.T) {
+	schedule := types.Schedule{
+		Id:             1,
+		StartTime:      time.Unix(10000, 0),
+		EndTime:        time.Unix(20000, 0),
+		TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
+		ReleasedAmount: sdk.NewCoins(),
+		Curve: types.Curve{
+			Type: types.CurveTypeStep,
+			Steps: []types.Step{{
+				Offset: 0,
+				Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))),
+			}, {
+				Offset: 5000 * time.Second,
+				Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5000)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
+			}, {
+				Offset: 10000 * time.Second,
+				Amount: sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6345))),
+			}},
+		},
+	}
+
+	// the first step is released at the start time
+	blockReward := schedule.GetBlockReward(time.Unix(10000, 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1000))), blockReward)
+
+	schedule.ReleasedAmount = blockReward
+
+	// nothing more is released until the next step
+	blockReward = schedule.GetBlockReward(time.Unix(14999, 0))
+	require.Empty(t, blockReward)
+
+	blockReward = schedule.GetBlockReward(time.Unix(15000, 0))
+	expected := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(5000)), sdk.NewCoin("uastro", sdk.NewInt(69420)))
+	require.Equal(t, expected, blockReward)
+
+	schedule.ReleasedAmount = schedule.ReleasedAmount.Add(blockReward...)
+
+	// the last step is released at the end time
+	blockReward = schedule.GetBlockReward(time.Unix(20000, 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6345))), blockReward)
+}
+
+func TestGetBlockRewardExponential(t *testing.T) {
+	period := 2500 * time.Second
+	decayFactor := sdk.NewDecWithPrec(5, 1)
+	schedule := types.Schedule{
+		Id:             1,
+		StartTime:      time.Unix(10000, 0),
+		EndTime:        time.Unix(20000, 0),
+		TotalAmount:    sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(12345)), sdk.NewCoin("uastro", sdk.NewInt(69420))),
+		ReleasedAmount: sdk.NewCoins(),
+		Curve:          types.Curve{Type: types.CurveTypeExponential, Period: &period, DecayFactor: &decayFactor},
+	}
+
+	// the four periods have weights 1, 0.5, 0.25 and 0.125, adding up to 1.875
+	// umars:  12345 * 1 / 1.875 = 6584
+	// uastro: 69420 * 1 / 1.875 = 37024
+	blockReward := schedule.GetBlockReward(time.Unix(12500, 0))
+	expected := sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(6584)), sdk.NewCoin("uastro", sdk.NewInt(37024)))
+	require.Equal(t, expected, blockReward)
+
+	schedule.ReleasedAmount = blockReward
+
+	// halfway through the second period, the rate having been halved
+	// umars:  12345 * 1.25 / 1.875 - 6584  = 1646
+	// uastro: 69420 * 1.25 / 1.875 - 37024 = 9256
+	blockReward = schedule.GetBlockReward(time.Unix(13750, 0))
+	expected = sdk.NewCoins(sdk.NewCoin("umars", sdk.NewInt(1646)), sdk.NewCoin("uastro", sdk.NewInt(9256)))
+	require.Equal(t, expected, blockReward)
+
+	schedule.ReleasedAmount = schedule.ReleasedAmount.Add(blockReward...)
+
+	// everything is released by the end time
+	blockReward = schedule.GetBlockReward(time.Unix(20000, 0))
+	require.Equal(t, schedule.TotalAmount, schedule.ReleasedAmount.Add(blockReward...))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	marsutils "github.com/mars-protocol/hub/v2/utils"
+)
+
+// Validate asserts that the target is valid:
+//
+//   - the validator addresses must be valid and not duplicate
+//   - the max commission rate, if set, must be between zero and one
+//   - the max validator share, if set, must be greater than zero and no
+//     greater than one
+func (t Target) Validate() error {
+	seen := map[string]bool{}
+	for _, validator := range t.Validators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return ErrInvalidTarget.Wrapf("invalid validator address `%s`: %s", validator, err)
+		}
+
+		if seen[validator] {
+			return ErrInvalidTarget.Wrapf("duplicate validator address `%s`", validator)
+		}
+
+		seen[validator] = true
+	}
+
+	if t.MaxCommissionRate != nil {
+		if t.MaxCommissionRate.IsNil() || t.MaxCommissionRate.IsNegative() || t.MaxCommissionRate.GT(sdk.OneDec()) {
+			return ErrInvalidTarget.Wrap("max commission rate must be between zero and one")
+		}
+	}
+
+	if t.MaxValidatorShare != nil {
+		if t.MaxValidatorShare.IsNil() || !t.MaxValidatorShare.IsPositive() || t.MaxValidatorShare.GT(sdk.OneDec()) {
+			return ErrInvalidTarget.Wrap("max validator share must be greater than zero and no greater than one")
+		}
+	}
+
+	return nil
+}
+
+// IsEligible returns whether the given validator passes the target's filters.
+func (t Target) IsEligible(validator stakingtypes.ValidatorI) bool {
+	if len(t.Validators) > 0 && !marsutils.Contains(t.Validators, validator.GetOperator().String()) {
+		return false
+	}
+
+	if t.ExcludeJailed && validator.IsJailed() {
+		return false
+	}
+
+	if t.MaxCommissionRate != nil && validator.GetCommission().GT(*t.MaxCommissionRate) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mars-protocol/hub/v2/x/incentives/types"
+)
+
+func TestValidateTarget(t *testing.T) {
+	validator1 := sdk.ValAddress([]byte("validator1")).String()
+	validator2 := sdk.ValAddress([]byte("validator2")).String()
+
+	zero := sdk.ZeroDec()
+	half := sdk.NewDecWithPrec(5, 1)
+	one := sdk.OneDec()
+	negative := sdk.NewDec(-1)
+	two := sdk.NewDec(2)
+
+	testCases := []struct {
+		name    string
+		target  types.Target
+		expPass bool
+	}{
+		{
+			"empty",
+			types.Target{},
+			true,
+		},
+		{
+			"all filters",
+			types.Target{
+				Validators:        []string{validator1, validator2},
+				ExcludeJailed:     true,
+				MaxCommissionRate: &half,
+				MaxValidatorShare: &half,
+			},
+			true,
+		},
+		{
+			"invalid validator address",
+			types.Target{Validators: []string{"larry"}},
+			false,
+		},
+		{
+			"duplicate validator address",
+			types.Target{Validators: []string{validator1, validator1}},
+			false,
+		},
+		{
+			"zero max commission rate",
+			types.Target{MaxCommissionRate: &zero},
+			true,
+		},
+		{
+			"negative max commission rate",
+			types.Target{MaxCommissionRate: &negative},
+			false,
+		},
+		{
+			"max commission rate greater than one",
+			types.Target{MaxCommissionRate: &two},
+			false,
+		},
+		{
+			"max validator share of one",
+			types.Target{MaxValidatorShare: &one},
+			true,
+		},
+		{
+			"zero max validator share",
+			types.Target{MaxValidatorShare: &zero},
+			false,
+		},
+		{
+			"max validator share greater than one",
+			types.Target{MaxValidatorShare: &two},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.target.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidTarget, tc.name)
+		}
+	}
+}
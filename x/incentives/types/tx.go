@@ -7,6 +7,9 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateSchedule{}
 	_ sdk.Msg = &MsgTerminateSchedules{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSponsorSchedule{}
+	_ sdk.Msg = &MsgUpdateSchedule{}
 )
 
 //------------------------------------------------------------------------------
@@ -36,9 +39,45 @@ func (m *MsgCreateSchedule) ValidateBasic() error {
 		return ErrInvalidProposalAmount.Wrap(err.Error())
 	}
 
+	// the curve, if provided, must be valid
+	if m.Curve != nil {
+		if err := m.Curve.Validate(m.EndTime.Sub(m.StartTime), m.Amount); err != nil {
+			return err
+		}
+	}
+
+	// the target, if provided, must be valid
+	if m.Target != nil {
+		if err := m.Target.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// the recipient, if provided, must be valid, and the rewards can't go to
+	// both a recipient and the validators
+	if m.Recipient != nil {
+		if m.Target != nil {
+			return ErrInvalidRecipient.Wrap("recipient and target are mutually exclusive")
+		}
+
+		if err := m.Recipient.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GetCurveOrDefault returns the curve of the schedule to be created, defaulting to a
+// linear one if not provided
+func (m *MsgCreateSchedule) GetCurveOrDefault() Curve {
+	if m.Curve != nil {
+		return *m.Curve
+	}
+
+	return LinearCurve()
+}
+
 // GetSigners returns the expected signers for the message
 func (m *MsgCreateSchedule) GetSigners() []sdk.AccAddress {
 	// we have already asserted that the authority address is valid in
@@ -73,3 +112,132 @@ func (m *MsgTerminateSchedules) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+//------------------------------------------------------------------------------
+// MsgUpdateParams
+//------------------------------------------------------------------------------
+
+// ValidateBasic does a sanity check on the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// the params must be valid
+	if err := m.Params.Validate(); err != nil {
+		return ErrInvalidParams.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for the message
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgSponsorSchedule
+//------------------------------------------------------------------------------
+
+// ValidateBasic does a sanity check on the provided data
+//
+// NOTE: whether the amount's denoms are allowed, and the amounts no less than
+// the minimums, depends on the module's params, so is checked by the msg
+// server instead
+func (m *MsgSponsorSchedule) ValidateBasic() error {
+	// the sponsor address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return ErrInvalidSponsorship.Wrapf("invalid sponsor address: %s", err)
+	}
+
+	// The start time must be earlier (strictly less than) the end time
+	if !m.StartTime.Before(m.EndTime) {
+		return ErrInvalidProposalStartEndTimes
+	}
+
+	// amount cannot be empty
+	if m.Amount.Empty() {
+		return ErrInvalidProposalAmount.Wrap("amount cannot be empty")
+	}
+
+	// the coins must be valid (unique denoms, non-zero amount, and sorted
+	// alphabetically)
+	if err := m.Amount.Validate(); err != nil {
+		return ErrInvalidProposalAmount.Wrap(err.Error())
+	}
+
+	// the curve, if provided, must be valid
+	if m.Curve != nil {
+		if err := m.Curve.Validate(m.EndTime.Sub(m.StartTime), m.Amount); err != nil {
+			return err
+		}
+	}
+
+	// the target, if provided, must be valid
+	if m.Target != nil {
+		if err := m.Target.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCurveOrDefault returns the curve of the schedule to be created, defaulting to a
+// linear one if not provided
+func (m *MsgSponsorSchedule) GetCurveOrDefault() Curve {
+	if m.Curve != nil {
+		return *m.Curve
+	}
+
+	return LinearCurve()
+}
+
+// GetSigners returns the expected signers for the message
+func (m *MsgSponsorSchedule) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the sponsor address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+//------------------------------------------------------------------------------
+// MsgUpdateSchedule
+//------------------------------------------------------------------------------
+
+// ValidateBasic does a sanity check on the provided data
+//
+// NOTE: whether the new end time and total amount are valid for the schedule
+// depends on its current state, so is checked by the msg server instead
+func (m *MsgUpdateSchedule) ValidateBasic() error {
+	// the authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidProposalAuthority.Wrap(err.Error())
+	}
+
+	// there must be something to update
+	if m.EndTime == nil && m.TotalAmount.Empty() {
+		return ErrInvalidScheduleUpdate.Wrap("either end time or total amount must be provided")
+	}
+
+	// the coins must be valid (unique denoms, non-zero amount, and sorted
+	// alphabetically)
+	if err := m.TotalAmount.Validate(); err != nil {
+		return ErrInvalidProposalAmount.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for the message
+func (m *MsgUpdateSchedule) GetSigners() []sdk.AccAddress {
+	// we have already asserted that the authority address is valid in
+	// ValidateBasic, so can ignore the error here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -51,6 +51,15 @@ type MsgCreateSchedule struct {
 	// Amount is the total amount of coins that shall be released to stakers
 	// throughout the span of this incentives schedule.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Curve defines how the coins are released between the start and end times.
+	// If not provided, they are released linearly.
+	Curve *Curve `protobuf:"bytes,5,opt,name=curve,proto3" json:"curve,omitempty"`
+	// Target restricts which validators are rewarded by the schedule. If not
+	// provided, all bonded validators are rewarded.
+	Target *Target `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	// Recipient, if provided, receives the rewards of the schedule instead of
+	// the validators. Mutually exclusive with Target.
+	Recipient *Recipient `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *MsgCreateSchedule) Reset()         { *m = MsgCreateSchedule{} }
@@ -114,6 +123,27 @@ func (m *MsgCreateSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *MsgCreateSchedule) GetCurve() *Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+func (m *MsgCreateSchedule) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *MsgCreateSchedule) GetRecipient() *Recipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
 // MsgCreateScheduleResponse defines the response to executing a
 // MsgCreateSchedule message.
 type MsgCreateScheduleResponse struct {
@@ -217,7 +247,7 @@ func (m *MsgTerminateSchedules) GetIds() []uint64 {
 // MsgTerminateSchedules message.
 type MsgTerminateSchedulesResponse struct {
 	// RefundedAmount is the unreleased incentives that were refunded to the
-	// community pool.
+	// community pool, or to the sponsors of sponsored schedules.
 	RefundedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=refunded_amount,json=refundedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_amount"`
 }
 
@@ -261,52 +291,477 @@ func (m *MsgTerminateSchedulesResponse) GetRefundedAmount() github_com_cosmos_co
 	return nil
 }
 
+// MsgUpdateParams defines the message for updating the incentives module's
+// parameters.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgUpdateParams struct {
+	// Authority is the account executing the params update.
+	// It should be the gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params is the module's new parameters
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response to executing a MsgUpdateParams
+// message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSponsorSchedule defines the message for creating a new incentives
+// schedule funded by the sender, e.g. a partner protocol incentivizing Mars Hub
+// stakers in its own tokens.
+//
+// Each coin of the amount must be of a denom allowed by the module's params,
+// and no less than the minimum amount for that denom.
+type MsgSponsorSchedule struct {
+	// Sponsor is the account funding the incentives schedule. The unreleased
+	// coins are refunded to it if the schedule is terminated.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// StartTime is the timestamp at which this incentives schedule shall begin.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// EndTime is the timestamp at which this incentives schedule shall finish.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// Amount is the total amount of coins that shall be released to stakers
+	// throughout the span of this incentives schedule.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Curve defines how the coins are released between the start and end times.
+	// If not provided, they are released linearly.
+	Curve *Curve `protobuf:"bytes,5,opt,name=curve,proto3" json:"curve,omitempty"`
+	// Target restricts which validators are rewarded by the schedule. If not
+	// provided, all bonded validators are rewarded.
+	Target *Target `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *MsgSponsorSchedule) Reset()         { *m = MsgSponsorSchedule{} }
+func (m *MsgSponsorSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorSchedule) ProtoMessage()    {}
+func (*MsgSponsorSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{6}
+}
+func (m *MsgSponsorSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorSchedule.Merge(m, src)
+}
+func (m *MsgSponsorSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorSchedule proto.InternalMessageInfo
+
+func (m *MsgSponsorSchedule) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgSponsorSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgSponsorSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgSponsorSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgSponsorSchedule) GetCurve() *Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+func (m *MsgSponsorSchedule) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+// MsgSponsorScheduleResponse defines the response to executing a
+// MsgSponsorSchedule message.
+type MsgSponsorScheduleResponse struct {
+	// Id is the identifier of the incentives schedule that was created.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgSponsorScheduleResponse) Reset()         { *m = MsgSponsorScheduleResponse{} }
+func (m *MsgSponsorScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorScheduleResponse) ProtoMessage()    {}
+func (*MsgSponsorScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{7}
+}
+func (m *MsgSponsorScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorScheduleResponse.Merge(m, src)
+}
+func (m *MsgSponsorScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgSponsorScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgUpdateSchedule defines the message for amending an existing incentives
+// schedule.
+//
+// If the schedule has already started, it is re-based at the block time of the
+// update: its start time is reset to then, and its curve releases the coins
+// not yet released under the new total amount over the time left until the new
+// end time. The release rate thus changes from the next block on, without
+// paying out a backlog or pausing. A cliff not yet reached keeps its end time.
+// Coins already released are never clawed back.
+//
+// Schedules with a step curve can't be updated, as their steps are fixed, and
+// neither can those with an exponential curve, as re-basing would restart the
+// decay.
+//
+// This message is typically executed via a governance proposal with the gov
+// module being the executing authority.
+type MsgUpdateSchedule struct {
+	// Authority is the account executing the schedule update.
+	// It should be the gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id is the identifier of the incentives schedule to be updated.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// EndTime, if provided, is the new end time of the schedule. It must not be
+	// earlier than the current end time.
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// TotalAmount, if not empty, is the new total amount of coins to be released
+	// by the schedule. It must not be less than the amount already released.
+	//
+	// Coins in excess of the current total amount are withdrawn from the
+	// community pool; this is not possible for sponsored schedules. Conversely,
+	// the unreleased coins no longer needed are refunded to the community pool,
+	// or the sponsor.
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_amount,json=totalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_amount" yaml:"total_amount"`
+}
+
+func (m *MsgUpdateSchedule) Reset()         { *m = MsgUpdateSchedule{} }
+func (m *MsgUpdateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSchedule) ProtoMessage()    {}
+func (*MsgUpdateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{8}
+}
+func (m *MsgUpdateSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSchedule.Merge(m, src)
+}
+func (m *MsgUpdateSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpdateSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *MsgUpdateSchedule) GetTotalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return nil
+}
+
+// MsgUpdateScheduleResponse defines the response to executing a
+// MsgUpdateSchedule message.
+type MsgUpdateScheduleResponse struct {
+	// ToppedUpAmount is the coins that were withdrawn from the community pool.
+	ToppedUpAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=topped_up_amount,json=toppedUpAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"topped_up_amount"`
+	// RefundedAmount is the coins that were refunded to the community pool, or
+	// to the sponsor of a sponsored schedule.
+	RefundedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refunded_amount,json=refundedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_amount"`
+}
+
+func (m *MsgUpdateScheduleResponse) Reset()         { *m = MsgUpdateScheduleResponse{} }
+func (m *MsgUpdateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f12e2863b3b90bf0, []int{9}
+}
+func (m *MsgUpdateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateScheduleResponse) GetToppedUpAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToppedUpAmount
+	}
+	return nil
+}
+
+func (m *MsgUpdateScheduleResponse) GetRefundedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedAmount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateSchedule)(nil), "mars.incentives.v1beta1.MsgCreateSchedule")
 	proto.RegisterType((*MsgCreateScheduleResponse)(nil), "mars.incentives.v1beta1.MsgCreateScheduleResponse")
 	proto.RegisterType((*MsgTerminateSchedules)(nil), "mars.incentives.v1beta1.MsgTerminateSchedules")
 	proto.RegisterType((*MsgTerminateSchedulesResponse)(nil), "mars.incentives.v1beta1.MsgTerminateSchedulesResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "mars.incentives.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mars.incentives.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSponsorSchedule)(nil), "mars.incentives.v1beta1.MsgSponsorSchedule")
+	proto.RegisterType((*MsgSponsorScheduleResponse)(nil), "mars.incentives.v1beta1.MsgSponsorScheduleResponse")
+	proto.RegisterType((*MsgUpdateSchedule)(nil), "mars.incentives.v1beta1.MsgUpdateSchedule")
+	proto.RegisterType((*MsgUpdateScheduleResponse)(nil), "mars.incentives.v1beta1.MsgUpdateScheduleResponse")
 }
 
 func init() { proto.RegisterFile("mars/incentives/v1beta1/tx.proto", fileDescriptor_f12e2863b3b90bf0) }
 
 var fileDescriptor_f12e2863b3b90bf0 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xdb, 0x30,
-	0x10, 0xb5, 0xe2, 0x24, 0xad, 0x19, 0xc0, 0x69, 0x84, 0x14, 0xb1, 0x15, 0x44, 0x36, 0x3c, 0x19,
-	0x06, 0x4c, 0xd6, 0x2e, 0x90, 0x21, 0x5b, 0x9c, 0xa9, 0x83, 0x17, 0x25, 0x43, 0xd1, 0xc5, 0xd0,
-	0x07, 0x23, 0x13, 0xb5, 0x48, 0x55, 0x47, 0x19, 0x31, 0xd0, 0xa1, 0xe8, 0x2f, 0xc8, 0xd2, 0x3f,
-	0xd1, 0x29, 0x43, 0x7f, 0x44, 0xc6, 0xa0, 0x53, 0xa7, 0xa4, 0xb0, 0x87, 0x6c, 0x1d, 0x3a, 0x77,
-	0x28, 0x24, 0x51, 0xb1, 0x51, 0xa7, 0x9f, 0xe8, 0x44, 0xf2, 0xee, 0xdd, 0x7b, 0xc7, 0x77, 0x94,
-	0x50, 0x3d, 0xb0, 0x23, 0x20, 0x8c, 0xbb, 0x94, 0x4b, 0x36, 0xa6, 0x40, 0xc6, 0x1d, 0x87, 0x4a,
-	0xbb, 0x43, 0xe4, 0x19, 0x0e, 0x23, 0x21, 0x85, 0xbe, 0x93, 0x20, 0xf0, 0x1c, 0x81, 0x15, 0xc2,
-	0x30, 0x5d, 0x01, 0x81, 0x00, 0xe2, 0xd8, 0x40, 0xef, 0xca, 0x5c, 0xc1, 0x78, 0x56, 0x68, 0xec,
-	0xa8, 0x7c, 0x00, 0x3e, 0x19, 0x77, 0x92, 0x45, 0x25, 0xaa, 0x59, 0x62, 0x90, 0x9e, 0x48, 0x76,
-	0x50, 0xa9, 0x6d, 0x5f, 0xf8, 0x22, 0x8b, 0x27, 0x3b, 0x15, 0xad, 0xf9, 0x42, 0xf8, 0x23, 0x4a,
-	0xd2, 0x93, 0x13, 0x9f, 0x12, 0xc9, 0x02, 0x0a, 0xd2, 0x0e, 0xc2, 0x0c, 0xd0, 0xf8, 0xb2, 0x82,
-	0xb6, 0xfa, 0xe0, 0x1f, 0x45, 0xd4, 0x96, 0xf4, 0xd8, 0x1d, 0x52, 0x2f, 0x1e, 0x51, 0x7d, 0x1f,
-	0x95, 0xec, 0x58, 0x0e, 0x45, 0xc4, 0xe4, 0xa4, 0xa2, 0xd5, 0xb5, 0x66, 0xa9, 0x57, 0xf9, 0xf8,
-	0xa1, 0xbd, 0xad, 0x14, 0x0f, 0x3d, 0x2f, 0xa2, 0x00, 0xc7, 0x32, 0x62, 0xdc, 0xb7, 0xe6, 0x50,
-	0xfd, 0x39, 0x42, 0x20, 0xed, 0x48, 0x0e, 0x12, 0x99, 0xca, 0x4a, 0x5d, 0x6b, 0x6e, 0x74, 0x0d,
-	0x9c, 0xf5, 0x80, 0xf3, 0x1e, 0xf0, 0x49, 0xde, 0x43, 0x6f, 0xef, 0xf2, 0xba, 0x56, 0xf8, 0x7a,
-	0x5d, 0xdb, 0x9a, 0xd8, 0xc1, 0xe8, 0xa0, 0x31, 0xaf, 0x6d, 0x9c, 0xdf, 0xd4, 0x34, 0xab, 0x94,
-	0x06, 0x12, 0xb8, 0x6e, 0xa1, 0x87, 0x94, 0x7b, 0x19, 0x6f, 0xf1, 0xb7, 0xbc, 0xbb, 0x8a, 0x77,
-	0x33, 0xe3, 0xcd, 0x2b, 0x33, 0xd6, 0x07, 0x94, 0x7b, 0x29, 0xa7, 0x8b, 0xd6, 0xed, 0x40, 0xc4,
-	0x5c, 0x56, 0x56, 0xeb, 0xc5, 0xe6, 0x46, 0xb7, 0x8a, 0xd5, 0xfd, 0x92, 0xb9, 0xe4, 0xc3, 0xc2,
-	0x47, 0x82, 0xf1, 0xde, 0x93, 0x84, 0xf0, 0xfd, 0x4d, 0xad, 0xe9, 0x33, 0x39, 0x8c, 0x1d, 0xec,
-	0x8a, 0x40, 0xd9, 0xaf, 0x96, 0x36, 0x78, 0x2f, 0x89, 0x9c, 0x84, 0x14, 0xd2, 0x02, 0xb0, 0x14,
-	0xf5, 0x41, 0xf9, 0xed, 0xed, 0x45, 0x6b, 0x6e, 0x51, 0x63, 0x17, 0x55, 0x97, 0xfc, 0xb6, 0x28,
-	0x84, 0x82, 0x03, 0x6d, 0xbc, 0x42, 0x8f, 0xfb, 0xe0, 0x9f, 0xd0, 0x28, 0x60, 0x7c, 0x21, 0x0f,
-	0xff, 0x3c, 0x90, 0x47, 0xa8, 0xc8, 0x3c, 0xa8, 0xac, 0xd4, 0x8b, 0xcd, 0x55, 0x2b, 0xd9, 0x2e,
-	0xf5, 0xf3, 0x4e, 0x43, 0x7b, 0xf7, 0x6a, 0xe6, 0x4d, 0xe9, 0x12, 0x6d, 0x46, 0xf4, 0x34, 0xe6,
-	0x1e, 0xf5, 0x06, 0xca, 0xaf, 0xb5, 0xff, 0xef, 0x57, 0x39, 0xd7, 0x38, 0x4c, 0x25, 0xba, 0xdf,
-	0x34, 0x54, 0xec, 0x83, 0xaf, 0x87, 0xa8, 0xfc, 0xc3, 0xe3, 0x6c, 0xe1, 0x9f, 0x7c, 0x57, 0x78,
-	0xc9, 0x58, 0xa3, 0xfb, 0xe7, 0xd8, 0xbb, 0xfb, 0xbe, 0x46, 0xfa, 0x3d, 0x13, 0xc0, 0xbf, 0x62,
-	0x5a, 0xc6, 0x1b, 0xfb, 0x7f, 0x87, 0xcf, 0xd5, 0x8d, 0xb5, 0x37, 0xb7, 0x17, 0x2d, 0xad, 0xf7,
-	0xec, 0x72, 0x6a, 0x6a, 0x57, 0x53, 0x53, 0xfb, 0x3c, 0x35, 0xb5, 0xf3, 0x99, 0x59, 0xb8, 0x9a,
-	0x99, 0x85, 0x4f, 0x33, 0xb3, 0xf0, 0x82, 0x2c, 0x58, 0x9a, 0x48, 0xb4, 0xd3, 0xf7, 0xef, 0x8a,
-	0x11, 0x19, 0xc6, 0x0e, 0x39, 0x5b, 0xfc, 0x23, 0xa5, 0xfe, 0x3a, 0xeb, 0x29, 0xe0, 0xe9, 0xf7,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x7d, 0x84, 0x28, 0xb1, 0x04, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0x3b, 0xd9, 0x2c, 0xfb, 0x76, 0x95, 0xed, 0x9a, 0xa2, 0x75, 0x5c, 0xd5, 0x89, 0x0c,
+	0x87, 0x68, 0x61, 0xed, 0x6e, 0x8a, 0x8a, 0xb4, 0x12, 0x12, 0xcd, 0x1e, 0x10, 0x87, 0x20, 0xe4,
+	0xdd, 0x4a, 0x88, 0x4b, 0xe4, 0xd8, 0x53, 0xaf, 0x21, 0xf6, 0x18, 0xcf, 0x38, 0xea, 0x4a, 0x1c,
+	0x10, 0x12, 0x12, 0xc7, 0x5e, 0x90, 0xb8, 0x71, 0xe7, 0xd4, 0x03, 0xff, 0x80, 0x4b, 0x6f, 0x54,
+	0x9c, 0x7a, 0x6a, 0xd1, 0xee, 0xa1, 0x77, 0x7e, 0x01, 0xf2, 0xcc, 0xd8, 0x09, 0x71, 0xb2, 0x49,
+	0x0a, 0x15, 0x97, 0x9e, 0x92, 0x99, 0xf9, 0xbe, 0xef, 0xbd, 0x99, 0xef, 0xbd, 0x19, 0x43, 0x2b,
+	0x74, 0x12, 0x62, 0x05, 0x91, 0x8b, 0x22, 0x1a, 0x8c, 0x10, 0xb1, 0x46, 0x87, 0x03, 0x44, 0x9d,
+	0x43, 0x8b, 0x3e, 0x30, 0xe3, 0x04, 0x53, 0xac, 0xec, 0x65, 0x08, 0x73, 0x8c, 0x30, 0x05, 0x42,
+	0xd3, 0x5d, 0x4c, 0x42, 0x4c, 0xac, 0x81, 0x43, 0x50, 0x41, 0x73, 0x71, 0x10, 0x71, 0xa2, 0xb6,
+	0x27, 0xd6, 0x43, 0xe2, 0x5b, 0xa3, 0xc3, 0xec, 0x47, 0x2c, 0x34, 0xf8, 0x42, 0x9f, 0x8d, 0x2c,
+	0x3e, 0x10, 0x4b, 0xd7, 0x7d, 0xec, 0x63, 0x3e, 0x9f, 0xfd, 0x13, 0xb3, 0x4d, 0x1f, 0x63, 0x7f,
+	0x88, 0x2c, 0x36, 0x1a, 0xa4, 0xf7, 0x2d, 0x1a, 0x84, 0x88, 0x50, 0x27, 0x8c, 0x05, 0xe0, 0x9d,
+	0x79, 0xbb, 0x88, 0x9d, 0xc4, 0x09, 0x73, 0xf1, 0xb7, 0xe7, 0xa1, 0x08, 0xc5, 0x09, 0xe2, 0x20,
+	0xe3, 0xe7, 0x2a, 0xec, 0xf6, 0x88, 0x7f, 0x9c, 0x20, 0x87, 0xa2, 0x13, 0xf7, 0x0c, 0x79, 0xe9,
+	0x10, 0x29, 0x77, 0x60, 0xd3, 0x49, 0xe9, 0x19, 0x4e, 0x02, 0x7a, 0xae, 0x4a, 0x2d, 0xa9, 0xbd,
+	0xd9, 0x55, 0xff, 0xf8, 0xf5, 0xe0, 0xba, 0x48, 0xfe, 0xae, 0xe7, 0x25, 0x88, 0x90, 0x13, 0x9a,
+	0x04, 0x91, 0x6f, 0x8f, 0xa1, 0xca, 0xe7, 0x00, 0x84, 0x3a, 0x09, 0xed, 0x67, 0x19, 0xab, 0x72,
+	0x4b, 0x6a, 0x6f, 0x75, 0x34, 0x93, 0x6f, 0xc7, 0xcc, 0xb7, 0x63, 0x9e, 0xe6, 0xdb, 0xe9, 0xde,
+	0x7c, 0xfc, 0xac, 0xb9, 0xf6, 0xd7, 0xb3, 0xe6, 0xee, 0xb9, 0x13, 0x0e, 0x8f, 0x8c, 0x31, 0xd7,
+	0x78, 0xf8, 0xbc, 0x29, 0xd9, 0x9b, 0x6c, 0x22, 0x83, 0x2b, 0x36, 0xbc, 0x81, 0x22, 0x8f, 0xeb,
+	0x56, 0x16, 0xea, 0xde, 0x10, 0xba, 0x3b, 0x5c, 0x37, 0x67, 0x72, 0xd5, 0x0d, 0x14, 0x79, 0x4c,
+	0xd3, 0x85, 0x9a, 0x13, 0xe2, 0x34, 0xa2, 0x6a, 0xb5, 0x55, 0x69, 0x6f, 0x75, 0x1a, 0xa6, 0xd8,
+	0x5f, 0x66, 0x71, 0xee, 0xbb, 0x79, 0x8c, 0x83, 0xa8, 0x7b, 0x2b, 0x13, 0xfc, 0xe5, 0x79, 0xb3,
+	0xed, 0x07, 0xf4, 0x2c, 0x1d, 0x98, 0x2e, 0x0e, 0x85, 0x93, 0xe2, 0xe7, 0x80, 0x78, 0x5f, 0x59,
+	0xf4, 0x3c, 0x46, 0x84, 0x11, 0x88, 0x2d, 0xa4, 0x95, 0xf7, 0x61, 0xdd, 0x4d, 0x93, 0x11, 0x52,
+	0xd7, 0x59, 0xd6, 0xba, 0x39, 0xa7, 0xbe, 0xcc, 0xe3, 0x0c, 0x65, 0x73, 0xb0, 0xf2, 0x01, 0xd4,
+	0xa8, 0x93, 0xf8, 0x88, 0xaa, 0x35, 0x46, 0x6b, 0xce, 0xa5, 0x9d, 0x32, 0x98, 0x2d, 0xe0, 0xca,
+	0x47, 0xb0, 0x99, 0x20, 0x37, 0x88, 0x03, 0x14, 0x51, 0x75, 0x83, 0x71, 0x8d, 0xb9, 0x5c, 0x3b,
+	0x47, 0xda, 0x63, 0xd2, 0x51, 0xfd, 0xbb, 0x17, 0x8f, 0xf6, 0xc7, 0x9e, 0x1a, 0x37, 0xa0, 0x51,
+	0x2a, 0x10, 0x1b, 0x91, 0x18, 0x47, 0x04, 0x19, 0x5f, 0xc3, 0x5b, 0x3d, 0xe2, 0x9f, 0xa2, 0x24,
+	0x0c, 0xa2, 0x89, 0x75, 0xf2, 0xd2, 0x15, 0x74, 0x0d, 0x2a, 0x81, 0x47, 0x54, 0xb9, 0x55, 0x69,
+	0x57, 0xed, 0xec, 0x6f, 0x29, 0x9f, 0x1f, 0x25, 0xb8, 0x39, 0x33, 0x66, 0x9e, 0x94, 0x42, 0x61,
+	0x27, 0x41, 0xf7, 0xd3, 0xc8, 0x43, 0x5e, 0x5f, 0x18, 0xbc, 0xfe, 0xdf, 0x1b, 0x5c, 0xcf, 0x63,
+	0xdc, 0x65, 0x21, 0x8c, 0x9f, 0x24, 0xd8, 0xe9, 0x11, 0xff, 0x5e, 0xec, 0x39, 0x14, 0x7d, 0xc6,
+	0x1a, 0xf1, 0xa5, 0x4f, 0xe1, 0x43, 0xa8, 0xf1, 0x56, 0x56, 0xe5, 0x05, 0xf6, 0xf3, 0x40, 0xdd,
+	0x6a, 0x96, 0xbe, 0x2d, 0x48, 0xa5, 0x23, 0x6b, 0xc0, 0xde, 0x54, 0x66, 0x85, 0x81, 0x4f, 0x2b,
+	0xa0, 0xf4, 0x88, 0x7f, 0x92, 0x8d, 0x70, 0x52, 0x5c, 0x00, 0x1d, 0xd8, 0x20, 0x7c, 0x6a, 0x61,
+	0xda, 0x39, 0xf0, 0x75, 0xf3, 0xff, 0xdf, 0xcd, 0x7f, 0xb4, 0x9d, 0xf9, 0x9e, 0xfb, 0x61, 0xbc,
+	0x07, 0x5a, 0xd9, 0xd9, 0xa2, 0x49, 0xea, 0x20, 0x07, 0x1e, 0x33, 0xb7, 0x6a, 0xcb, 0x81, 0x67,
+	0xfc, 0x26, 0xc3, 0x6e, 0x51, 0x24, 0xff, 0xfa, 0x21, 0xe0, 0xea, 0x72, 0xae, 0xae, 0x7c, 0xba,
+	0x92, 0x83, 0x7b, 0x0b, 0xdd, 0xfb, 0x5e, 0x82, 0x6d, 0x8a, 0xa9, 0x33, 0xec, 0x2f, 0x6b, 0xe2,
+	0xc7, 0xa2, 0x2a, 0xde, 0xe4, 0xba, 0x93, 0x64, 0x63, 0x25, 0x6f, 0xb7, 0x18, 0x95, 0x37, 0x7d,
+	0xa9, 0xd3, 0x7e, 0x90, 0xa1, 0x51, 0x3a, 0xc5, 0xe2, 0xcc, 0x53, 0xb8, 0x46, 0x71, 0x1c, 0x23,
+	0xaf, 0x9f, 0xc6, 0x79, 0xe2, 0xd2, 0x2b, 0xb8, 0x99, 0x78, 0x90, 0x7b, 0x31, 0x4f, 0x72, 0xd6,
+	0x7d, 0x28, 0xbf, 0xf2, 0xfb, 0xb0, 0xf3, 0x7b, 0x15, 0x2a, 0x3d, 0xe2, 0x2b, 0x31, 0xd4, 0xa7,
+	0xbe, 0x2e, 0xf6, 0xe7, 0xd6, 0x73, 0xe9, 0xa1, 0xd1, 0x3a, 0xcb, 0x63, 0x8b, 0x63, 0xfe, 0x06,
+	0x94, 0x19, 0x2f, 0x92, 0x79, 0x95, 0x52, 0x19, 0xaf, 0xdd, 0x59, 0x0d, 0x5f, 0x44, 0xff, 0x12,
+	0xb6, 0xff, 0xf1, 0x06, 0xb4, 0xaf, 0xd2, 0x99, 0x44, 0x6a, 0xb7, 0x96, 0x45, 0x16, 0xb1, 0x08,
+	0xec, 0x4c, 0xdf, 0xdc, 0xef, 0x5e, 0x25, 0x32, 0x05, 0xd6, 0x6e, 0xaf, 0x00, 0x2e, 0x82, 0xc6,
+	0x50, 0x9f, 0xba, 0x25, 0xf6, 0x17, 0x27, 0xbe, 0x9c, 0xa1, 0xb3, 0xfb, 0x46, 0x5b, 0xff, 0xf6,
+	0xc5, 0xa3, 0x7d, 0xa9, 0xfb, 0xc9, 0xe3, 0x0b, 0x5d, 0x7a, 0x72, 0xa1, 0x4b, 0x7f, 0x5e, 0xe8,
+	0xd2, 0xc3, 0x4b, 0x7d, 0xed, 0xc9, 0xa5, 0xbe, 0xf6, 0xf4, 0x52, 0x5f, 0xfb, 0xc2, 0x9a, 0xa8,
+	0xd2, 0x4c, 0xfe, 0x80, 0x5d, 0x2a, 0x2e, 0x1e, 0x5a, 0x67, 0xe9, 0xc0, 0x7a, 0x30, 0xf9, 0x11,
+	0xcc, 0x4a, 0x76, 0x50, 0x63, 0x80, 0xdb, 0x7f, 0x0f, 0x00, 0xf4, 0xf6, 0x9c, 0x2f, 0x10, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -327,6 +782,15 @@ type MsgClient interface {
 	// TerminateSchedule is a governance operation for terminating one or more
 	// existing incentives schedules.
 	TerminateSchedules(ctx context.Context, in *MsgTerminateSchedules, opts ...grpc.CallOption) (*MsgTerminateSchedulesResponse, error)
+	// UpdateParams is a governance operation for updating the module's
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SponsorSchedule is a permissionless operation for creating a new incentives
+	// schedule funded by the sender.
+	SponsorSchedule(ctx context.Context, in *MsgSponsorSchedule, opts ...grpc.CallOption) (*MsgSponsorScheduleResponse, error)
+	// UpdateSchedule is a governance operation for amending the end time or the
+	// total amount of an existing incentives schedule.
+	UpdateSchedule(ctx context.Context, in *MsgUpdateSchedule, opts ...grpc.CallOption) (*MsgUpdateScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -355,18 +819,54 @@ func (c *msgClient) TerminateSchedules(ctx context.Context, in *MsgTerminateSche
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateSchedule is a governance operation for creating a new incentives
-	// schedule.
-	CreateSchedule(context.Context, *MsgCreateSchedule) (*MsgCreateScheduleResponse, error)
-	// TerminateSchedule is a governance operation for terminating one or more
-	// existing incentives schedules.
-	TerminateSchedules(context.Context, *MsgTerminateSchedules) (*MsgTerminateSchedulesResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SponsorSchedule(ctx context.Context, in *MsgSponsorSchedule, opts ...grpc.CallOption) (*MsgSponsorScheduleResponse, error) {
+	out := new(MsgSponsorScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Msg/SponsorSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSchedule(ctx context.Context, in *MsgUpdateSchedule, opts ...grpc.CallOption) (*MsgUpdateScheduleResponse, error) {
+	out := new(MsgUpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mars.incentives.v1beta1.Msg/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateSchedule is a governance operation for creating a new incentives
+	// schedule.
+	CreateSchedule(context.Context, *MsgCreateSchedule) (*MsgCreateScheduleResponse, error)
+	// TerminateSchedule is a governance operation for terminating one or more
+	// existing incentives schedules.
+	TerminateSchedules(context.Context, *MsgTerminateSchedules) (*MsgTerminateSchedulesResponse, error)
+	// UpdateParams is a governance operation for updating the module's
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SponsorSchedule is a permissionless operation for creating a new incentives
+	// schedule funded by the sender.
+	SponsorSchedule(context.Context, *MsgSponsorSchedule) (*MsgSponsorScheduleResponse, error)
+	// UpdateSchedule is a governance operation for amending the end time or the
+	// total amount of an existing incentives schedule.
+	UpdateSchedule(context.Context, *MsgUpdateSchedule) (*MsgUpdateScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
 func (*UnimplementedMsgServer) CreateSchedule(ctx context.Context, req *MsgCreateSchedule) (*MsgCreateScheduleResponse, error) {
@@ -375,6 +875,15 @@ func (*UnimplementedMsgServer) CreateSchedule(ctx context.Context, req *MsgCreat
 func (*UnimplementedMsgServer) TerminateSchedules(ctx context.Context, req *MsgTerminateSchedules) (*MsgTerminateSchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateSchedules not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SponsorSchedule(ctx context.Context, req *MsgSponsorSchedule) (*MsgSponsorScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorSchedule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSchedule(ctx context.Context, req *MsgUpdateSchedule) (*MsgUpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -416,6 +925,60 @@ func _Msg_TerminateSchedules_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SponsorSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSponsorSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SponsorSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Msg/SponsorSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SponsorSchedule(ctx, req.(*MsgSponsorSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mars.incentives.v1beta1.Msg/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSchedule(ctx, req.(*MsgUpdateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mars.incentives.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -428,6 +991,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateSchedules",
 			Handler:    _Msg_TerminateSchedules_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SponsorSchedule",
+			Handler:    _Msg_SponsorSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _Msg_UpdateSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mars/incentives/v1beta1/tx.proto",
@@ -453,6 +1028,42 @@ func (m *MsgCreateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Recipient != nil {
+		{
+			size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Curve != nil {
+		{
+			size, err := m.Curve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -467,20 +1078,20 @@ func (m *MsgCreateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Authority) > 0 {
@@ -537,20 +1148,20 @@ func (m *MsgTerminateSchedules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j6++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -601,91 +1212,1000 @@ func (m *MsgTerminateSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTerminateSchedules) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSponsorSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
+	if m.Curve != nil {
+		{
+			size, err := m.Curve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTerminateSchedulesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSponsorScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RefundedAmount) > 0 {
-		for _, e := range m.RefundedAmount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalAmount) > 0 {
+		for iNdEx := len(m.TotalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EndTime != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedAmount) > 0 {
+		for iNdEx := len(m.RefundedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToppedUpAmount) > 0 {
+		for iNdEx := len(m.ToppedUpAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToppedUpAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Curve != nil {
+		l = m.Curve.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = m.Recipient.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTerminateSchedules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgTerminateSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedAmount) > 0 {
+		for _, e := range m.RefundedAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSponsorSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Curve != nil {
+		l = m.Curve.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSponsorScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TotalAmount) > 0 {
+		for _, e := range m.TotalAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ToppedUpAmount) > 0 {
+		for _, e := range m.ToppedUpAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RefundedAmount) > 0 {
+		for _, e := range m.RefundedAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Curve == nil {
+				m.Curve = &Curve{}
+			}
+			if err := m.Curve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recipient == nil {
+				m.Recipient = &Recipient{}
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTerminateSchedules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTerminateSchedules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminateSchedules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -708,15 +2228,264 @@ func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminateSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedAmount = append(m.RefundedAmount, types.Coin{})
+			if err := m.RefundedAmount[len(m.RefundedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSponsorSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSponsorSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -744,7 +2513,7 @@ func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -846,6 +2615,78 @@ func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Curve == nil {
+				m.Curve = &Curve{}
+			}
+			if err := m.Curve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -867,7 +2708,7 @@ func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSponsorScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,12 +2731,31 @@ func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSponsorScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSponsorScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -917,7 +2777,7 @@ func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTerminateSchedules) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,10 +2800,10 @@ func (m *MsgTerminateSchedules) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTerminateSchedules: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTerminateSchedules: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -979,81 +2839,94 @@ func (m *MsgTerminateSchedules) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1075,7 +2948,7 @@ func (m *MsgTerminateSchedules) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTerminateSchedulesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,13 +2971,47 @@ func (m *MsgTerminateSchedulesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTerminateSchedulesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTerminateSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToppedUpAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToppedUpAmount = append(m.ToppedUpAmount, types.Coin{})
+			if err := m.ToppedUpAmount[len(m.ToppedUpAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
 			}
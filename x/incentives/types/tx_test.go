@@ -44,6 +44,59 @@ func TestValidateCreateScheduleProposal(t *testing.T) {
 			func() {},
 			nil,
 		},
+		{
+			"succeed - with a curve",
+			func() {
+				cliff := time.Hour
+				msg.Curve = &types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &cliff}
+			},
+			nil,
+		},
+		{
+			"fail - invalid curve",
+			func() {
+				cliff := 10000 * time.Second
+				msg.Curve = &types.Curve{Type: types.CurveTypeCliffLinear, Cliff: &cliff}
+			},
+			types.ErrInvalidCurve,
+		},
+		{
+			"succeed - with a target",
+			func() {
+				maxShare := sdk.NewDecWithPrec(1, 1)
+				msg.Target = &types.Target{ExcludeJailed: true, MaxValidatorShare: &maxShare}
+			},
+			nil,
+		},
+		{
+			"fail - invalid target",
+			func() {
+				msg.Target = &types.Target{Validators: []string{"larry"}}
+			},
+			types.ErrInvalidTarget,
+		},
+		{
+			"succeed - with a recipient",
+			func() {
+				msg.Recipient = &types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeEpoch, Sudo: true}
+			},
+			nil,
+		},
+		{
+			"fail - invalid recipient",
+			func() {
+				msg.Recipient = &types.Recipient{Address: govModuleAccount}
+			},
+			types.ErrInvalidRecipient,
+		},
+		{
+			"fail - both a recipient and a target",
+			func() {
+				msg.Recipient = &types.Recipient{Address: govModuleAccount, DeliveryMode: types.DeliveryModeBlock}
+				msg.Target = &types.Target{ExcludeJailed: true}
+			},
+			types.ErrInvalidRecipient,
+		},
 		{
 			"fail - end time is earlier than start time",
 			func() {
@@ -133,3 +186,177 @@ func TestValidateTerminateScheduleProposal(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateUpdateParamsProposal(t *testing.T) {
+	var msg types.MsgUpdateParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"succeed",
+			func() {},
+			nil,
+		},
+		{
+			"fail - invalid authority",
+			func() {
+				msg.Authority = "larry"
+			},
+			types.ErrInvalidProposalAuthority,
+		},
+		{
+			"fail - sponsor min amounts are invalid",
+			func() {
+				msg.Params.SponsorMinAmounts = []sdk.Coin{{Denom: "umars", Amount: sdk.NewInt(0)}}
+			},
+			types.ErrInvalidParams,
+		},
+		{
+			"fail - absent reward policy is unspecified",
+			func() {
+				msg.Params.AbsentRewardPolicy = types.AbsentRewardPolicyUnspecified
+			},
+			types.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.MsgUpdateParams{
+			Authority: govModuleAccount,
+			Params:    types.DefaultParams(),
+		}
+		tc.malleate()
+
+		if tc.expError != nil {
+			require.Error(t, msg.ValidateBasic(), tc.expError.Error(), tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestValidateSponsorSchedule(t *testing.T) {
+	var msg types.MsgSponsorSchedule
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"succeed",
+			func() {},
+			nil,
+		},
+		{
+			"fail - invalid sponsor",
+			func() {
+				msg.Sponsor = "larry"
+			},
+			types.ErrInvalidSponsorship,
+		},
+		{
+			"fail - end time is earlier than start time",
+			func() {
+				msg.EndTime = time.Unix(9999, 0)
+			},
+			types.ErrInvalidProposalStartEndTimes,
+		},
+		{
+			"fail - amount is empty",
+			func() {
+				msg.Amount = sdk.NewCoins()
+			},
+			types.ErrInvalidProposalAmount,
+		},
+		{
+			"fail - invalid curve",
+			func() {
+				msg.Curve = &types.Curve{}
+			},
+			types.ErrInvalidCurve,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.MsgSponsorSchedule{
+			Sponsor:   govModuleAccount,
+			StartTime: mockMsgCreateSchedule.StartTime,
+			EndTime:   mockMsgCreateSchedule.EndTime,
+			Amount:    mockMsgCreateSchedule.Amount,
+		}
+		tc.malleate()
+
+		if tc.expError != nil {
+			require.Error(t, msg.ValidateBasic(), tc.expError.Error(), tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestValidateUpdateScheduleProposal(t *testing.T) {
+	var msg types.MsgUpdateSchedule
+
+	endTime := time.Unix(30000, 0)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"succeed",
+			func() {},
+			nil,
+		},
+		{
+			"succeed - end time only",
+			func() {
+				msg.TotalAmount = nil
+			},
+			nil,
+		},
+		{
+			"fail - invalid authority",
+			func() {
+				msg.Authority = "larry"
+			},
+			types.ErrInvalidProposalAuthority,
+		},
+		{
+			"fail - nothing to update",
+			func() {
+				msg.EndTime = nil
+				msg.TotalAmount = nil
+			},
+			types.ErrInvalidScheduleUpdate,
+		},
+		{
+			"fail - total amount contains zero coin",
+			func() {
+				msg.TotalAmount = []sdk.Coin{{Denom: "umars", Amount: sdk.NewInt(0)}}
+			},
+			types.ErrInvalidProposalAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.MsgUpdateSchedule{
+			Authority:   govModuleAccount,
+			Id:          1,
+			EndTime:     &endTime,
+			TotalAmount: mockMsgCreateSchedule.Amount,
+		}
+		tc.malleate()
+
+		if tc.expError != nil {
+			require.Error(t, msg.ValidateBasic(), tc.expError.Error(), tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+		}
+	}
+}